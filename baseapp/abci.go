@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -181,6 +182,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	// call the hooks with the BeginBlock messages
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+
 	return res
 }
 
@@ -197,10 +206,19 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
+	emitBlockGasMetrics(app.deliverState.ctx.BlockGasMeter())
+
 	if cp := app.GetConsensusParams(app.deliverState.ctx); cp != nil {
 		res.ConsensusParamUpdates = cp
 	}
 
+	// call the streaming service hooks with the EndBlock messages
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
 	return res
 }
 
@@ -210,6 +228,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // internal CheckTx state if the AnteHandler passes. Otherwise, the ResponseCheckTx
 // will contain releveant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
+//
+// The priority set on the Context by the AnteHandler, if not zero, is returned
+// as the priority attribute of a tx event, since ResponseCheckTx has no field
+// for it yet.
 func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	defer telemetry.MeasureSince(time.Now(), "abci", "check_tx")
 
@@ -226,17 +248,25 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx)
+	gInfo, result, priority, err := app.runTx(mode, req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
+	events := result.Events
+	if priority != 0 {
+		events = append(events, abci.Event(sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+		)))
+	}
+
 	return abci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    sdk.MarkEventsToIndex(events, app.indexEvents),
 	}
 }
 
@@ -246,6 +276,18 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	return app.deliverTx(req, func() (sdk.GasInfo, *sdk.Result, error) {
+		gInfo, result, _, err := app.runTx(runTxModeDeliver, req.Tx)
+		return gInfo, result, err
+	})
+}
+
+// deliverTx returns the ResponseDeliverTx of the tx of req, executed in
+// DeliverTx mode by exec, and reports it to the telemetry and the streaming
+// listeners.
+func (app *BaseApp) deliverTx(
+	req abci.RequestDeliverTx, exec func() (sdk.GasInfo, *sdk.Result, error),
+) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
 	gInfo := sdk.GasInfo{}
@@ -258,7 +300,16 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx)
+	defer func() {
+		// call the streaming service hooks with the DeliverTx messages
+		for _, streamingListener := range app.abciListeners {
+			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.logger.Error("DeliverTx listening hook failed", "err", err)
+			}
+		}
+	}()
+
+	gInfo, result, err := exec()
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
@@ -554,10 +605,11 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
+	defer release()
 
 	res, err := handler(ctx, req)
 	if err != nil {
@@ -600,11 +652,24 @@ func checkNegativeHeight(height int64) error {
 	return nil
 }
 
+// queryMultiStore returns the multi-store to serve queries at height from:
+// the one restored from the state sync snapshot taken at height if height was
+// pruned and snapshot queries are enabled, the live one otherwise. The returned
+// function must be called once the multi-store is no longer used.
+func (app *BaseApp) queryMultiStore(height int64) (sdk.CommitMultiStore, func(), error) {
+	if app.snapshotQueries == nil || !app.snapshotQueries.serves(height) {
+		return app.cms, func() {}, nil
+	}
+
+	return app.snapshotQueries.acquire(height)
+}
+
 // createQueryContext creates a new sdk.Context for a query, taking as args
-// the block height and whether the query needs a proof or not.
-func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+// the block height and whether the query needs a proof or not. The returned
+// function must be called once the context is no longer used.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, func(), error) {
 	if err := checkNegativeHeight(height); err != nil {
-		return sdk.Context{}, err
+		return sdk.Context{}, nil, err
 	}
 
 	// when a client did not provide a query height, manually inject the latest
@@ -613,16 +678,26 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	if height <= 1 && prove {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	ms, release, err := app.queryMultiStore(height)
+	if err != nil {
+		return sdk.Context{}, nil,
+			sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
+			)
+	}
+
+	cacheMS, err := ms.CacheMultiStoreWithVersion(height)
 	if err != nil {
-		return sdk.Context{},
+		release()
+		return sdk.Context{}, nil,
 			sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
@@ -634,7 +709,7 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
 	).WithMinGasPrices(app.minGasPrices)
 
-	return ctx, nil
+	return ctx, release, nil
 }
 
 // GetBlockRetentionHeight returns the height for which all blocks below this height
@@ -773,8 +848,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
-	queryable, ok := app.cms.(sdk.Queryable)
-	if !ok {
+	if _, ok := app.cms.(sdk.Queryable); !ok {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support queries"))
 	}
 
@@ -789,7 +863,15 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.R
 		)
 	}
 
-	resp := queryable.Query(req)
+	ms, release, err := app.queryMultiStore(req.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(
+			sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to load state at height %d; %s", req.Height, err),
+		)
+	}
+	defer release()
+
+	resp := ms.(sdk.Queryable).Query(req)
 	resp.Height = req.Height
 
 	return resp
@@ -836,10 +918,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
+	defer release()
 
 	// Passes the rest of the path as an argument to the querier.
 	//
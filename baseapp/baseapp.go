@@ -65,12 +65,23 @@ type BaseApp struct { // nolint: maligned
 	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	storeKeys      []sdk.StoreKey   // keys of the mounted stores
+
+	// workers executing the txs of DeliverTxs concurrently; txs are executed
+	// serially if it is less than 2
+	parallelTxWorkers int
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 
+	// serves queries at pruned heights from state sync snapshots, if enabled
+	snapshotStore          *snapshots.Store
+	snapshotQueries        *snapshotQueries
+	snapshotQueryCacheSize uint32 // restored snapshots to keep; 0 disables snapshot queries
+	snapshotQueryDir       string // directory to restore snapshots in; in memory if empty
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -128,6 +139,10 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -183,6 +198,15 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// CommitMultiStore returns the root multi-store of the BaseApp. It must not be
+// written to, as the BaseApp alone commits its state; it is meant to read the
+// state committed at past heights.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore { return app.cms }
+
+// SnapshotManager returns the snapshot manager of the BaseApp, or nil if no
+// snapshot store is set. Snapshot extensions are registered with it.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
@@ -240,6 +264,7 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+	app.storeKeys = append(app.storeKeys, key)
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -302,6 +327,24 @@ func (app *BaseApp) init() error {
 		}
 	}
 
+	if app.snapshotQueryCacheSize > 0 {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("snapshot queries require a rootmulti store")
+		}
+		if app.snapshotStore == nil {
+			return errors.New("snapshot queries require a snapshot store")
+		}
+
+		snapshotQueries, err := newSnapshotQueries(
+			app.snapshotStore, rms, app.snapshotQueryDir, int(app.snapshotQueryCacheSize), app.logger,
+		)
+		if err != nil {
+			return err
+		}
+		app.snapshotQueries = snapshotQueries
+	}
+
 	return nil
 }
 
@@ -362,7 +405,9 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // provided header, and minimum gas prices set. It is set on InitChain and reset
 // on Commit.
 func (app *BaseApp) setCheckState(header tmproto.Header) {
-	ms := app.cms.CacheMultiStore()
+	// The CheckTx state is nested in a second cache, which is never written, so
+	// that its writes are not reported to the store listeners.
+	ms := app.cms.CacheMultiStore().CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
@@ -556,20 +601,45 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+// and execute successfully. An error is returned otherwise. The priority of the
+// tx is the one set on the Context by the AnteHandler, if any.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
+	var txm *txMetrics
+	if mode == runTxModeDeliver {
+		txm = &txMetrics{}
+		defer txm.emit()
+	}
+
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, txm)
+}
+
+// runTxWithContext processes a transaction like runTx, within the given Context
+// of the execution mode. The metrics of the execution are collected in txm, if
+// not nil.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, txm *txMetrics,
+) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	// the failures of txs are reported by stage, codespace and code
+	stage := txStageValidate
+	if txm != nil {
+		defer func() {
+			if err != nil {
+				txm.fail(stage, err)
+			}
+		}()
+	}
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -605,12 +675,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	tx, err := app.txDecoder(txBytes)
 	if err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	var events sdk.Events
@@ -629,6 +699,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		stage = txStageAnte
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
 
 		if !newCtx.IsZero() {
@@ -643,12 +714,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		events = ctx.EventManager().Events()
+		priority = ctx.Priority()
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, priority, err
 		}
 
 		msCache.Write()
@@ -662,7 +734,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// Attempt to execute all messages and only update state if all messages pass
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
-	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+	stage = txStageMsg
+	result, err = app.runMsgs(runMsgCtx, msgs, mode, txm)
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 
@@ -672,7 +745,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, priority, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -680,7 +753,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 // and DeliverTx. An error is returned if any single message fails or if a
 // Handler does not exist for a given message route. Otherwise, a reference to a
 // Result is returned. The caller must not commit state if an error is returned.
-func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*sdk.Result, error) {
+// The gas used by each message is collected in txm, if not nil.
+func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txm *txMetrics) (*sdk.Result, error) {
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	events := sdk.EmptyEvents()
 	txMsgData := &sdk.TxMsgData{
@@ -701,6 +775,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err       error
 		)
 
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
 			msgFqName = svcMsg.MethodName
 			handler := app.msgServiceRouter.Handler(msgFqName)
@@ -720,6 +796,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			msgResult, err = handler(ctx, msg)
 		}
 
+		// the gas used by failed messages is accounted too
+		if txm != nil {
+			txm.addMsg(msg, ctx.GasMeter().GasConsumed()-gasBefore)
+		}
+
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -142,11 +143,12 @@ func setupBaseAppWithSnapshots(t *testing.T, blocks uint, blockTxs int, options
 		os.RemoveAll(snapshotDir)
 	}
 
-	app := setupBaseApp(t, append(options,
+	// the given options are applied last, so that they override the defaults
+	app := setupBaseApp(t, append([]func(*BaseApp){
 		SetSnapshotStore(snapshotStore),
 		SetSnapshotInterval(snapshotInterval),
 		SetPruning(sdk.PruningOptions{KeepEvery: 1}),
-		routerOpt)...)
+		routerOpt}, options...)...)
 
 	app.InitChain(abci.RequestInitChain{})
 
@@ -959,6 +961,77 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// Test that the priority set by the AnteHandler is returned by CheckTx, both
+// for new txs and on recheck.
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			txTest := tx.(txTest)
+			if txTest.FailOnAnte {
+				return ctx.WithPriority(100), sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			// rechecked txs get a lower priority, e.g. as their fee is now too low
+			priority := txTest.Counter * 10
+			if ctx.IsReCheckTx() {
+				priority = txTest.Counter
+			}
+
+			return ctx.WithPriority(priority), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	priorityEvent := func(priority string) []abci.Event {
+		return []abci.Event{{
+			Type: sdk.EventTypeTx,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(sdk.AttributeKeyPriority), Value: []byte(priority), Index: true},
+			},
+		}}
+	}
+
+	testCases := []struct {
+		counter  int64
+		reqType  abci.CheckTxType
+		expected []abci.Event
+	}{
+		{0, abci.CheckTxType_New, []abci.Event{}},
+		{5, abci.CheckTxType_New, priorityEvent("50")},
+		{5, abci.CheckTxType_Recheck, priorityEvent("5")},
+		{0, abci.CheckTxType_Recheck, []abci.Event{}},
+	}
+
+	for _, tc := range testCases {
+		txBytes, err := codec.MarshalBinaryBare(newTxCounter(tc.counter, 0))
+		require.NoError(t, err)
+
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: tc.reqType})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+		require.Equal(t, tc.expected, r.GetEvents(), "counter: %d, type: %s", tc.counter, tc.reqType)
+	}
+
+	// the priority of failed txs is not returned
+	tx := newTxCounter(5, 0)
+	tx.FailOnAnte = true
+	txBytes, err := codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.False(t, r.IsOK())
+	require.Empty(t, r.GetEvents())
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1794,8 +1867,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: 1, Chunks: 2},
-		{Height: 2, Format: 1, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
@@ -1809,13 +1882,13 @@ func TestLoadSnapshotChunk(t *testing.T) {
 		chunk       uint32
 		expectEmpty bool
 	}{
-		"Existing snapshot": {2, 1, 1, false},
-		"Missing height":    {100, 1, 1, true},
-		"Missing format":    {2, 2, 1, true},
-		"Missing chunk":     {2, 1, 9, true},
-		"Zero height":       {0, 1, 1, true},
+		"Existing snapshot": {2, snapshottypes.CurrentFormat, 1, false},
+		"Missing height":    {100, snapshottypes.CurrentFormat, 1, true},
+		"Missing format":    {2, snapshottypes.CurrentFormat + 1, 1, true},
+		"Missing chunk":     {2, snapshottypes.CurrentFormat, 9, true},
+		"Zero height":       {0, snapshottypes.CurrentFormat, 1, true},
 		"Zero format":       {2, 0, 1, true},
-		"Zero chunk":        {2, 1, 0, false},
+		"Zero chunk":        {2, snapshottypes.CurrentFormat, 0, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -1942,6 +2015,135 @@ func TestApplySnapshotChunk(t *testing.T) {
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 }
 
+func TestSnapshotQueries(t *testing.T) {
+	queryDir, err := ioutil.TempDir("", "baseapp-snapshot-queries")
+	require.NoError(t, err)
+	defer os.RemoveAll(queryDir)
+
+	testCases := map[string]struct {
+		dir string
+	}{
+		"in memory": {""},
+		"on disk":   {queryDir},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			// snapshots are taken at heights 2, 4 and 6, and heights up to 5 are pruned
+			app, teardown := setupBaseAppWithSnapshots(t, 7, 1,
+				SetPruning(store.NewPruningOptions(1, 0, 1)),
+				SetSnapshotQueryCacheSize(1),
+				SetSnapshotQueryDir(tc.dir),
+			)
+			defer teardown()
+
+			liveStore := app.cms.GetCommitKVStore(capKey2)
+			queryStore := func(height int64, key string) []byte {
+				res := app.Query(abci.RequestQuery{Path: "/store/key2/key", Data: []byte(key), Height: height})
+				require.True(t, res.IsOK(), res.Log)
+				return res.Value
+			}
+
+			// heights without a snapshot or not pruned are not served from snapshots
+			for _, height := range []int64{0, 1, 3, 6, 7, 8} {
+				require.False(t, app.snapshotQueries.serves(height), "height %d", height)
+			}
+			require.Nil(t, queryStore(1, "50"))
+
+			// each block sets 100 new keys
+			require.True(t, app.snapshotQueries.serves(2))
+			require.Equal(t, liveStore.Get([]byte("150")), queryStore(2, "150"))
+			require.Nil(t, queryStore(2, "250"))
+			require.Len(t, app.snapshotQueries.restored, 1)
+			require.Contains(t, app.snapshotQueries.restored, int64(2))
+			if tc.dir != "" {
+				require.DirExists(t, filepath.Join(tc.dir, "2"))
+			}
+
+			// the least recently used snapshot is evicted
+			ctx, release, err := app.createQueryContext(4, false)
+			require.NoError(t, err)
+			require.Equal(t, liveStore.Get([]byte("350")), ctx.KVStore(capKey2).Get([]byte("350")))
+			require.Nil(t, ctx.KVStore(capKey2).Get([]byte("450")))
+			require.Len(t, app.snapshotQueries.restored, 1)
+			require.Contains(t, app.snapshotQueries.restored, int64(4))
+			if tc.dir != "" {
+				require.NoDirExists(t, filepath.Join(tc.dir, "2"))
+			}
+
+			// but it is only closed once no longer used
+			_, release2, err := app.createQueryContext(2, false)
+			require.NoError(t, err)
+			require.NotContains(t, app.snapshotQueries.restored, int64(4))
+			require.Equal(t, liveStore.Get([]byte("350")), ctx.KVStore(capKey2).Get([]byte("350")))
+			if tc.dir != "" {
+				require.DirExists(t, filepath.Join(tc.dir, "4"))
+			}
+			release()
+			release2()
+			if tc.dir != "" {
+				require.NoDirExists(t, filepath.Join(tc.dir, "4"))
+			}
+
+			// the live state is still served from the live store
+			require.Equal(t, liveStore.Get([]byte("650")), queryStore(7, "650"))
+		})
+	}
+}
+
+func TestSnapshotQueries_FormatZlib(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 5, 1,
+		SetPruning(store.NewPruningOptions(1, 0, 1)),
+		SetSnapshotQueryCacheSize(1),
+	)
+	defer teardown()
+
+	// the snapshot at height 2 is replaced by one in the format used before
+	// upgrading, taken from the multi-store it restores
+	snapshotStore := app.snapshotQueries.store
+	snapshot, chunks, err := snapshotStore.Load(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+
+	cms := app.snapshotQueries.cms.CopyMounts(dbm.NewMemDB())
+	require.NoError(t, cms.LoadLatestVersion())
+	require.NoError(t, cms.Restore(2, snapshot.Format, chunks, nil))
+
+	chunks, err = cms.Snapshot(2, snapshottypes.FormatZlib)
+	require.NoError(t, err)
+	_, err = snapshotStore.Save(2, snapshottypes.FormatZlib, chunks)
+	require.NoError(t, err)
+	require.NoError(t, snapshotStore.Delete(2, snapshottypes.CurrentFormat))
+
+	require.True(t, app.snapshotQueries.serves(2))
+	res := app.Query(abci.RequestQuery{Path: "/store/key2/key", Data: []byte("150"), Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, app.cms.GetCommitKVStore(capKey2).Get([]byte("150")), res.Value)
+
+	res = app.Query(abci.RequestQuery{Path: "/store/key2/key", Data: []byte("250"), Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.Value)
+}
+
+func TestSnapshotQueries_Disabled(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 4, 1, SetPruning(store.NewPruningOptions(1, 0, 1)))
+	defer teardown()
+
+	require.Nil(t, app.snapshotQueries)
+
+	res := app.Query(abci.RequestQuery{Path: "/store/key2/key", Data: []byte("150"), Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.Value)
+}
+
+func TestSnapshotQueries_RequireSnapshotStore(t *testing.T) {
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), nil, SetSnapshotQueryCacheSize(1))
+	app.MountStores(capKey1)
+	require.Error(t, app.LoadLatestVersion())
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
@@ -0,0 +1,132 @@
+package baseapp
+
+import (
+	"strconv"
+	"strings"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Stages of the processing of a tx in which it may fail, as reported by the
+// tx_errors metric.
+const (
+	txStageValidate = "validate" // decoding, basic validation and block gas
+	txStageAnte     = "ante"
+	txStageMsg      = "msg"
+)
+
+// txMetrics collects the metrics of the execution of a tx in DeliverTx mode,
+// which are only emitted once the execution is final, as txs executed
+// speculatively may be executed again.
+type txMetrics struct {
+	msgs   []sdk.Msg
+	msgGas []uint64
+	stage  string
+	err    error
+}
+
+// addMsg collects the gas used by the handler of msg.
+func (m *txMetrics) addMsg(msg sdk.Msg, gasUsed uint64) {
+	m.msgs = append(m.msgs, msg)
+	m.msgGas = append(m.msgGas, gasUsed)
+}
+
+// fail collects the failure of the tx at the given stage.
+func (m *txMetrics) fail(stage string, err error) {
+	m.stage, m.err = stage, err
+}
+
+// emit emits the collected metrics.
+func (m *txMetrics) emit() {
+	for i, msg := range m.msgs {
+		emitMsgGasMetrics(msg, m.msgGas[i])
+	}
+
+	if m.err != nil {
+		emitTxErrorMetrics(m.stage, m.err)
+	}
+}
+
+// emitTxErrorMetrics counts a tx failing at the given stage by the codespace
+// and code of err.
+func emitTxErrorMetrics(stage string, err error) {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"tx", "errors"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(telemetry.MetricLabelNameStage, stage),
+			telemetry.NewLabel(telemetry.MetricLabelNameCodespace, codespace),
+			telemetry.NewLabel(telemetry.MetricLabelNameCode, strconv.FormatUint(uint64(code), 10)),
+		},
+	)
+}
+
+// emitMsgGasMetrics adds the gas used by the handler of msg to the gas used by
+// its type and module, and counts the execution.
+func emitMsgGasMetrics(msg sdk.Msg, gasUsed uint64) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(telemetry.MetricLabelNameMsgType, msgTypeURL(msg)),
+		telemetry.NewLabel(telemetry.MetricLabelNameModule, msgModule(msg)),
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"tx", "msg", "count"}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{"tx", "msg", "gas_used"}, float32(gasUsed), labels)
+}
+
+// emitBlockGasMetrics reports the gas used by the block, and, if the block gas
+// is limited, its limit and the fraction of it which is used.
+func emitBlockGasMetrics(meter sdk.GasMeter) {
+	if meter == nil {
+		return
+	}
+
+	telemetry.SetGauge(float32(meter.GasConsumed()), "block", "gas", "used")
+
+	if limit := meter.Limit(); limit > 0 {
+		telemetry.SetGauge(float32(limit), "block", "gas", "limit")
+		telemetry.SetGauge(float32(meter.GasConsumedToLimit())/float32(limit), "block", "gas", "utilization")
+	}
+}
+
+// msgTypeURL returns the type URL of msg, or of the request of a ServiceMsg.
+// Messages which are not registered protobuf types are reported by their type.
+func msgTypeURL(msg sdk.Msg) string {
+	var pm proto.Message = msg
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		pm = svcMsg.Request
+	}
+
+	if name := proto.MessageName(pm); name != "" {
+		return "/" + name
+	}
+
+	return msg.Type()
+}
+
+// msgModule returns the module handling msg, i.e. the route of legacy messages
+// and of the requests of a ServiceMsg, or else the name of its service.
+func msgModule(msg sdk.Msg) string {
+	svcMsg, ok := msg.(sdk.ServiceMsg)
+	if !ok {
+		return msg.Route()
+	}
+
+	if legacyMsg, ok := svcMsg.Request.(sdk.Msg); ok {
+		return legacyMsg.Route()
+	}
+
+	// method names are of the form /package.Service/Method
+	service := strings.TrimPrefix(svcMsg.MethodName, "/")
+	if i := strings.Index(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+
+	return service
+}
@@ -0,0 +1,108 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgTypeURLAndModule(t *testing.T) {
+	testCases := []struct {
+		msg     sdk.Msg
+		typeURL string
+		module  string
+	}{
+		{msgCounter{}, "counter1", routeMsgCounter},
+		{testdata.NewTestMsg(), "/testdata.TestMsg", "TestMsg"},
+		{sdk.ServiceMsg{MethodName: "/testdata.Msg/CreateDog", Request: &testdata.MsgCreateDog{}}, "/testdata.MsgCreateDog", "testdata.Msg"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.typeURL, msgTypeURL(tc.msg))
+		require.Equal(t, tc.module, msgModule(tc.msg))
+	}
+}
+
+func TestGasAndErrorMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) })
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if tx.(txTest).FailOnAnte {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+			return ctx.WithGasMeter(sdk.NewGasMeter(1000)), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(*msgCounter)
+			ctx.GasMeter().ConsumeGas(uint64(m.Counter), "counter-handler")
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: 100},
+		},
+	})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	_, _, err = app.Deliver(aminoTxEncoder(), newTxCounter(0, 5, 7))
+	require.NoError(t, err)
+
+	anteFailTx := newTxCounter(1, 3)
+	anteFailTx.FailOnAnte = true
+	_, _, err = app.Deliver(aminoTxEncoder(), anteFailTx)
+	require.Error(t, err)
+
+	msgFailTx := newTxCounter(2, 4)
+	msgFailTx.setFailOnHandler(true)
+	_, _, err = app.Deliver(aminoTxEncoder(), msgFailTx)
+	require.Error(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("garbage")})
+	require.False(t, res.IsOK())
+
+	// txs are not counted in CheckTx
+	_, _, err = app.Check(aminoTxEncoder(), anteFailTx)
+	require.Error(t, err)
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+
+	counters := data[0].Counters
+	msgLabels := ";msg_type=counter1;module=" + routeMsgCounter
+	require.Equal(t, 3, counters["tx.msg.count"+msgLabels].Count)
+	require.Equal(t, float64(16), counters["tx.msg.gas_used"+msgLabels].Sum)
+
+	require.Equal(t, 1, counters["tx.errors;stage=ante;codespace=sdk;code=4"].Count)
+	require.Equal(t, 1, counters["tx.errors;stage=msg;codespace=sdk;code=18"].Count)
+	require.Equal(t, 1, counters["tx.errors;stage=validate;codespace=sdk;code=2"].Count)
+
+	gauges := data[0].Gauges
+	require.Equal(t, float32(16), gauges["block.gas.used"].Value)
+	require.Equal(t, float32(100), gauges["block.gas.limit"].Value)
+	require.Equal(t, float32(0.16), gauges["block.gas.utilization"].Value)
+}
@@ -73,6 +73,24 @@ func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetSnapshotQueryCacheSize sets the number of snapshots restored to serve
+// queries at pruned heights to keep.
+func SetSnapshotQueryCacheSize(size uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotQueryCacheSize(size) }
+}
+
+// SetSnapshotQueryDir sets the directory to restore snapshots in to serve
+// queries at pruned heights.
+func SetSnapshotQueryDir(dir string) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotQueryDir(dir) }
+}
+
+// SetParallelTxWorkers sets the number of workers executing the txs of
+// DeliverTxs concurrently.
+func SetParallelTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxWorkers(workers) }
+}
+
 // SetSnapshotStore sets the snapshot store.
 func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
@@ -204,6 +222,7 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 	if app.sealed {
 		panic("SetSnapshotStore() on sealed BaseApp")
 	}
+	app.snapshotStore = snapshotStore
 	if snapshotStore == nil {
 		app.snapshotManager = nil
 		return
@@ -227,6 +246,36 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetSnapshotQueryCacheSize sets the number of snapshots restored to serve
+// queries at pruned heights to keep. Queries at pruned heights are served from
+// the state sync snapshots taken at these heights only if it is positive.
+func (app *BaseApp) SetSnapshotQueryCacheSize(size uint32) {
+	if app.sealed {
+		panic("SetSnapshotQueryCacheSize() on sealed BaseApp")
+	}
+	app.snapshotQueryCacheSize = size
+}
+
+// SetSnapshotQueryDir sets the directory to restore snapshots in to serve
+// queries at pruned heights. Its contents are deleted when the application
+// starts. The snapshots are restored in memory if it is empty.
+func (app *BaseApp) SetSnapshotQueryDir(dir string) {
+	if app.sealed {
+		panic("SetSnapshotQueryDir() on sealed BaseApp")
+	}
+	app.snapshotQueryDir = dir
+}
+
+// SetParallelTxWorkers sets the number of workers executing the txs of
+// DeliverTxs concurrently. The txs are executed serially if it is less than 2,
+// which is the default.
+func (app *BaseApp) SetParallelTxWorkers(workers int) {
+	if app.sealed {
+		panic("SetParallelTxWorkers() on sealed BaseApp")
+	}
+	app.parallelTxWorkers = workers
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
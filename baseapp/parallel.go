@@ -0,0 +1,250 @@
+package baseapp
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeliverTxs executes the txs of a block in DeliverTx mode and returns their
+// responses, as calling DeliverTx with each of them in order does.
+//
+// If parallel tx workers are set (see SetParallelTxWorkers), the txs are first
+// executed speculatively and concurrently, each on its own branch of the state
+// at the start of the batch, recording the keys they read and write. The
+// branches are then written in the order of the txs, and the txs which read
+// keys written by the txs before them are executed again, so that the state,
+// and the responses, gas and events of the txs, are the ones of the serial
+// execution. This requires the AnteHandler and the message handlers to keep
+// their state in the multistore only, and to not use the block gas meter.
+// The txs are executed serially if tracing is enabled.
+//
+// NOTE: Tendermint v0.34 delivers the txs of a block one at a time with
+// DeliverTx, so that DeliverTxs can only be used where all the txs of a block
+// are known beforehand, e.g. when replaying blocks or running simulations.
+func (app *BaseApp) DeliverTxs(txs [][]byte) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, len(txs))
+
+	if app.parallelTxWorkers < 2 || app.deliverState.ms.TracingEnabled() {
+		for i, tx := range txs {
+			res[i] = app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		}
+
+		return res
+	}
+
+	branches := app.speculateTxs(txs)
+
+	// the keys written by the txs delivered so far, by store
+	written := make(map[sdk.StoreKey]map[string]struct{})
+
+	for i, tx := range txs {
+		branch := branches[i]
+		res[i] = app.deliverTx(abci.RequestDeliverTx{Tx: tx}, func() (sdk.GasInfo, *sdk.Result, error) {
+			if !app.acceptSpeculation(branch, written) {
+				branch = app.runTxOnBranch(tx, false)
+			}
+
+			branch.write(written)
+			branch.metrics.emit()
+
+			return branch.gInfo, branch.result, branch.err
+		})
+	}
+
+	return res
+}
+
+// speculateTxs executes txs concurrently with the parallel tx workers, each on
+// its own branch of the deliverState multistore.
+func (app *BaseApp) speculateTxs(txs [][]byte) []*txBranch {
+	branches := make([]*txBranch, len(txs))
+
+	indices := make(chan int, len(txs))
+	for i := range txs {
+		indices <- i
+	}
+	close(indices)
+
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallelTxWorkers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				branches[i] = app.runTxOnBranch(txs[i], true)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return branches
+}
+
+// acceptSpeculation reports whether the speculative execution of a tx is the
+// one of its serial execution, after the txs which wrote the written keys, and
+// then consumes its gas from the block gas meter.
+func (app *BaseApp) acceptSpeculation(branch *txBranch, written map[sdk.StoreKey]map[string]struct{}) bool {
+	// the tx used the gas meter of deliverState, i.e. it was not replaced by
+	// the AnteHandler
+	if branch.gasMeter.used {
+		return false
+	}
+
+	for key, store := range branch.stores {
+		if keys := written[key]; len(keys) > 0 && store.ReadsAny(keys) {
+			return false
+		}
+	}
+
+	// the tx would fail for lack of block gas, or exceed the block gas limit,
+	// which is left to its serial execution
+	meter := app.deliverState.ctx.BlockGasMeter()
+	consumed := meter.GasConsumed()
+	total := consumed + branch.blockGas
+
+	if meter.IsOutOfGas() || total < consumed || (meter.Limit() > 0 && total > meter.Limit()) {
+		return false
+	}
+
+	meter.ConsumeGas(branch.blockGas, "block gas meter")
+
+	// the gas of reading the consensus params is consumed from the gas meter of
+	// deliverState in the serial execution
+	app.deliverState.ctx.GasMeter().ConsumeGas(branch.paramsGas, "consensus params")
+
+	return true
+}
+
+// txBranch is the execution of a tx in DeliverTx mode on a branch of the
+// deliverState multistore, whose stores record the keys read and written.
+type txBranch struct {
+	gInfo   sdk.GasInfo
+	result  *sdk.Result
+	err     error
+	metrics *txMetrics
+
+	ms     sdk.CacheMultiStore
+	stores map[sdk.StoreKey]*rwset.Store
+
+	// set for speculative executions only
+	gasMeter  *probeGasMeter
+	blockGas  uint64
+	paramsGas uint64
+}
+
+// runTxOnBranch executes tx on a new branch of the deliverState multistore.
+// The writes of the tx are only applied to deliverState once the branch is
+// written. A speculative execution has its own gas meters and event manager,
+// so that it can run concurrently with other txs.
+func (app *BaseApp) runTxOnBranch(tx []byte, speculative bool) *txBranch {
+	branch := &txBranch{
+		metrics: &txMetrics{},
+		stores:  make(map[sdk.StoreKey]*rwset.Store, len(app.storeKeys)),
+	}
+
+	stores := make(map[sdk.StoreKey]sdk.CacheWrapper, len(app.storeKeys))
+	for _, key := range app.storeKeys {
+		store := rwset.NewStore(app.deliverState.ms.GetKVStore(key))
+		branch.stores[key] = store
+		stores[key] = store
+	}
+
+	branch.ms = cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, nil, nil, nil, nil)
+
+	// NOTE: the context is the one of getContextForTx, on the branch
+	ctx := app.deliverState.ctx
+	var blockGasMeter sdk.GasMeter
+	if speculative {
+		branch.gasMeter = &probeGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+		blockGasMeter = sdk.NewInfiniteGasMeter()
+		ctx = ctx.
+			WithGasMeter(branch.gasMeter).
+			WithBlockGasMeter(blockGasMeter).
+			WithEventManager(sdk.NewEventManager())
+	}
+
+	ctx = ctx.
+		WithMultiStore(branch.ms).
+		WithTxBytes(tx).
+		WithVoteInfos(app.voteInfos)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	if speculative {
+		branch.paramsGas = branch.gasMeter.GasMeter.GasConsumed()
+		branch.gasMeter.used = false
+	}
+
+	branch.gInfo, branch.result, _, branch.err = app.runTxWithContext(ctx, runTxModeDeliver, tx, branch.metrics)
+
+	if speculative {
+		branch.blockGas = blockGasMeter.GasConsumed()
+	}
+
+	return branch
+}
+
+// write applies the writes of the tx to the deliverState multistore, and adds
+// the written keys to written.
+func (branch *txBranch) write(written map[sdk.StoreKey]map[string]struct{}) {
+	branch.ms.Write()
+
+	for key, store := range branch.stores {
+		if len(store.Writes()) == 0 {
+			continue
+		}
+
+		if written[key] == nil {
+			written[key] = make(map[string]struct{})
+		}
+
+		for k := range store.Writes() {
+			written[key][k] = struct{}{}
+		}
+	}
+}
+
+// probeGasMeter is a GasMeter recording whether it is used.
+type probeGasMeter struct {
+	sdk.GasMeter
+	used bool
+}
+
+func (m *probeGasMeter) GasConsumed() sdk.Gas {
+	m.used = true
+	return m.GasMeter.GasConsumed()
+}
+
+func (m *probeGasMeter) GasConsumedToLimit() sdk.Gas {
+	m.used = true
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+func (m *probeGasMeter) Limit() sdk.Gas {
+	m.used = true
+	return m.GasMeter.Limit()
+}
+
+func (m *probeGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	m.used = true
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (m *probeGasMeter) IsPastLimit() bool {
+	m.used = true
+	return m.GasMeter.IsPastLimit()
+}
+
+func (m *probeGasMeter) IsOutOfGas() bool {
+	m.used = true
+	return m.GasMeter.IsOutOfGas()
+}
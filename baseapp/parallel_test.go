@@ -0,0 +1,150 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelTestOpts returns the options of an app whose msgCounter handler
+// increments one of three shared counters, and whose msgCounter2 handler sums
+// them with an iterator. Both consume gas depending on the counters, so that
+// the results of txs executed on a stale state differ from the serial ones.
+func parallelTestOpts(setGasMeter bool, executions *int64) []func(*BaseApp) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if setGasMeter {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+			}
+
+			txTest := tx.(txTest)
+			if txTest.FailOnAnte {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			setIntOnStore(ctx.KVStore(capKey2), []byte(fmt.Sprintf("ante-%d", txTest.Counter)), txTest.Counter)
+			ctx.EventManager().EmitEvents(counterEvent("ante_handler", txTest.Counter))
+
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			atomic.AddInt64(executions, 1)
+
+			m := msg.(*msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			key := []byte(fmt.Sprintf("counter-%d", m.Counter%3))
+			value := getIntFromStore(store, key) + 1
+			setIntOnStore(store, key, value)
+			ctx.GasMeter().ConsumeGas(uint64(value), "counter")
+
+			return &sdk.Result{Events: counterEvent(sdk.EventTypeMessage, value).ToABCIEvents()}, nil
+		}))
+
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter2, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			atomic.AddInt64(executions, 1)
+
+			m := msg.(*msgCounter2)
+			store := ctx.KVStore(capKey1)
+
+			var sum int64
+			iter := sdk.KVStorePrefixIterator(store, []byte("counter-"))
+			for ; iter.Valid(); iter.Next() {
+				sum += getIntFromStore(store, iter.Key())
+			}
+			iter.Close()
+
+			setIntOnStore(store, []byte(fmt.Sprintf("sum-%d", m.Counter)), sum)
+			ctx.GasMeter().ConsumeGas(uint64(sum), "sum")
+
+			return &sdk.Result{Events: counterEvent(sdk.EventTypeMessage, sum).ToABCIEvents()}, nil
+		}))
+	}
+
+	return []func(*BaseApp){anteOpt, routerOpt}
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setGasMeter bool
+		maxGas      int64
+	}{
+		{"ante handler sets the gas meter", true, 0},
+		{"ante handler uses the deliverState gas meter", false, 0},
+		{"block gas limit", true, 50000},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var serialExecutions, parallelExecutions int64
+			serialApp := setupBaseApp(t, parallelTestOpts(tc.setGasMeter, &serialExecutions)...)
+			parallelApp := setupBaseApp(t, append(parallelTestOpts(tc.setGasMeter, &parallelExecutions), SetParallelTxWorkers(4))...)
+
+			for _, app := range []*BaseApp{serialApp, parallelApp} {
+				app.InitChain(abci.RequestInitChain{
+					ConsensusParams: &abci.ConsensusParams{
+						Block: &abci.BlockParams{MaxGas: tc.maxGas},
+					},
+				})
+			}
+
+			for height := int64(1); height <= 3; height++ {
+				var txs [][]byte
+				for i := int64(0); i < 12; i++ {
+					counter := height*100 + i
+
+					var tx *txTest
+					switch i % 4 {
+					case 0:
+						tx = &txTest{Msgs: []sdk.Msg{msgCounter2{counter}}, Counter: counter}
+					case 1:
+						tx = newTxCounter(counter, counter, counter+1)
+					default:
+						tx = newTxCounter(counter, counter)
+					}
+
+					if i == 5 {
+						tx.setFailOnAnte(true)
+					}
+					if i == 6 {
+						tx.setFailOnHandler(true)
+					}
+
+					txBytes, err := aminoTxEncoder()(tx)
+					require.NoError(t, err)
+					txs = append(txs, txBytes)
+				}
+				txs = append(txs, []byte("garbage"))
+
+				header := tmproto.Header{Height: height}
+				serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+				parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+				serialRes := serialApp.DeliverTxs(txs)
+				parallelRes := parallelApp.DeliverTxs(txs)
+				require.Equal(t, serialRes, parallelRes)
+
+				require.Equal(t, serialApp.EndBlock(abci.RequestEndBlock{Height: height}), parallelApp.EndBlock(abci.RequestEndBlock{Height: height}))
+				require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data)
+			}
+
+			// the conflicting txs are executed again
+			require.Greater(t, parallelExecutions, serialExecutions)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package baseapp
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// snapshotQueries serves queries at heights which were pruned from the live
+// multi-store, but for which a state sync snapshot is available. The snapshot
+// is restored into a read-only multi-store, either in memory or on disk, and
+// the most recently used ones are kept around for the next queries.
+type snapshotQueries struct {
+	store  *snapshots.Store
+	cms    *rootmulti.Store
+	dir    string // if empty, the snapshots are restored in memory
+	size   int    // maximum number of restored snapshots to keep
+	logger log.Logger
+
+	mtx      sync.Mutex
+	restored map[int64]*list.Element // by height
+	lru      *list.List              // of *restoredSnapshot, most recently used first
+}
+
+// restoredSnapshot is a snapshot restored into a multi-store. It is closed once
+// evicted from the cache and no longer used by any query.
+type restoredSnapshot struct {
+	height int64
+	cms    *rootmulti.Store
+	db     dbm.DB
+	dir    string
+	done   chan struct{} // closed once the restore completed
+	err    error         // restore error, set before done is closed
+
+	refs    int
+	evicted bool
+}
+
+// newSnapshotQueries creates a snapshotQueries restoring the snapshots of store
+// with the stores mounted by cms, and keeping up to size of them. If dir is not
+// empty, the snapshots are restored on disk under dir, whose current contents
+// are deleted.
+func newSnapshotQueries(
+	store *snapshots.Store, cms *rootmulti.Store, dir string, size int, logger log.Logger,
+) (*snapshotQueries, error) {
+	if dir != "" {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("failed to clear snapshot query directory: %w", err)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create snapshot query directory: %w", err)
+		}
+	}
+
+	return &snapshotQueries{
+		store:    store,
+		cms:      cms,
+		dir:      dir,
+		size:     size,
+		logger:   logger.With("module", "snapshot-queries"),
+		restored: make(map[int64]*list.Element),
+		lru:      list.New(),
+	}, nil
+}
+
+// serves returns whether queries at height must be served from a snapshot,
+// i.e. whether height was committed and then pruned, and a snapshot was taken
+// at that height.
+func (q *snapshotQueries) serves(height int64) bool {
+	if height < 1 || height > q.cms.LastCommitID().Version || q.cms.VersionExists(height) {
+		return false
+	}
+
+	snapshot, err := q.get(height)
+	return err == nil && snapshot != nil
+}
+
+// snapshotQueryFormats are the formats of the snapshots which queries are served
+// from, by order of preference. Snapshots taken in a format older than the
+// current one, before upgrading, are still served.
+var snapshotQueryFormats = []uint32{snapshottypes.FormatZstdSections, snapshottypes.FormatZlib}
+
+// get returns the snapshot taken at height, in the first of the
+// snapshotQueryFormats it was taken in, or nil if there is none.
+func (q *snapshotQueries) get(height int64) (*snapshottypes.Snapshot, error) {
+	for _, format := range snapshotQueryFormats {
+		snapshot, err := q.store.Get(uint64(height), format)
+		if err != nil || snapshot != nil {
+			return snapshot, err
+		}
+	}
+
+	return nil, nil
+}
+
+// acquire returns the multi-store restored from the snapshot taken at height,
+// restoring it if needed. The returned function must be called once the
+// multi-store is no longer used.
+func (q *snapshotQueries) acquire(height int64) (*rootmulti.Store, func(), error) {
+	q.mtx.Lock()
+	elem, ok := q.restored[height]
+	if ok {
+		q.lru.MoveToFront(elem)
+	} else {
+		elem = q.lru.PushFront(&restoredSnapshot{height: height, done: make(chan struct{})})
+		q.restored[height] = elem
+	}
+	restored := elem.Value.(*restoredSnapshot)
+	restored.refs++
+	q.evict()
+	q.mtx.Unlock()
+
+	// the first query at height restores the snapshot, the other ones wait for it
+	if !ok {
+		restored.err = q.restore(restored)
+		if restored.err != nil {
+			q.mtx.Lock()
+			q.remove(restored)
+			q.mtx.Unlock()
+		}
+		close(restored.done)
+	}
+	<-restored.done
+
+	release := func() {
+		q.mtx.Lock()
+		defer q.mtx.Unlock()
+		q.release(restored)
+	}
+
+	if restored.err != nil {
+		release()
+		return nil, nil, restored.err
+	}
+
+	return restored.cms, release, nil
+}
+
+// restore restores the snapshot taken at the height of restored.
+func (q *snapshotQueries) restore(restored *restoredSnapshot) error {
+	height := restored.height
+	q.logger.Info("restoring snapshot for queries", "height", height)
+
+	snapshot, err := q.get(height)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot at height %d", height)
+	}
+
+	snapshot, chunks, err := q.store.Load(uint64(height), snapshot.Format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot at height %d", height)
+	}
+
+	if q.dir == "" {
+		restored.db = dbm.NewMemDB()
+	} else {
+		restored.dir = filepath.Join(q.dir, fmt.Sprintf("%d", height))
+		restored.db, err = sdk.NewLevelDB("application", restored.dir)
+		if err != nil {
+			snapshots.NewChunkReader(chunks).Close()
+			return err
+		}
+	}
+
+	// the state of snapshot extensions lives outside of the multi-store, so it is not restored
+	restored.cms = q.cms.CopyMounts(restored.db)
+	restored.cms.SetSkipSnapshotExtensions(true)
+	if err = restored.cms.LoadLatestVersion(); err == nil {
+		err = restored.cms.Restore(uint64(height), snapshot.Format, chunks, nil)
+	} else {
+		snapshots.NewChunkReader(chunks).Close()
+	}
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to restore snapshot at height %d", height)
+	}
+
+	return nil
+}
+
+// evict evicts the least recently used snapshots in excess of the cache size.
+func (q *snapshotQueries) evict() {
+	for q.lru.Len() > q.size {
+		q.remove(q.lru.Back().Value.(*restoredSnapshot))
+	}
+}
+
+// remove evicts restored from the cache, closing it if no query uses it.
+func (q *snapshotQueries) remove(restored *restoredSnapshot) {
+	if restored.evicted {
+		return
+	}
+
+	q.lru.Remove(q.restored[restored.height])
+	delete(q.restored, restored.height)
+	restored.evicted = true
+
+	if restored.refs == 0 {
+		q.close(restored)
+	}
+}
+
+// release releases a reference to restored, closing it if it was evicted and
+// no other query uses it.
+func (q *snapshotQueries) release(restored *restoredSnapshot) {
+	restored.refs--
+	if restored.evicted && restored.refs == 0 {
+		q.close(restored)
+	}
+}
+
+// close closes the database of restored, and deletes it if on disk.
+func (q *snapshotQueries) close(restored *restoredSnapshot) {
+	if restored.db != nil {
+		if err := restored.db.Close(); err != nil {
+			q.logger.Error("failed to close restored snapshot", "height", restored.height, "err", err)
+		}
+	}
+
+	if restored.dir != "" {
+		if err := os.RemoveAll(restored.dir); err != nil {
+			q.logger.Error("failed to delete restored snapshot", "height", restored.height, "err", err)
+		}
+	}
+}
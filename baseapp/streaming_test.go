@@ -0,0 +1,117 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = &mockStreamingService{}
+
+// mockStreamingService records the state changes reported with each ABCI
+// hook.
+type mockStreamingService struct {
+	buf   bytes.Buffer
+	hooks []string
+	pairs [][]*storetypes.StoreKVPair
+}
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		capKey1: {storetypes.NewStoreKVPairWriteListener(&m.buf)},
+	}
+}
+
+func (m *mockStreamingService) listen(hook string) error {
+	var pairs []*storetypes.StoreKVPair
+	for m.buf.Len() > 0 {
+		size, err := binary.ReadUvarint(&m.buf)
+		if err != nil {
+			return err
+		}
+
+		kvPair := new(storetypes.StoreKVPair)
+		if err := kvPair.Unmarshal(m.buf.Next(int(size))); err != nil {
+			return err
+		}
+		pairs = append(pairs, kvPair)
+	}
+
+	m.hooks = append(m.hooks, hook)
+	m.pairs = append(m.pairs, pairs)
+	return nil
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return m.listen("begin")
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return m.listen("end")
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return m.listen("tx")
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	streamingService := &mockStreamingService{}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(streamingService) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// CheckTx writes are never reported
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the writes of a failed tx are discarded and not reported
+	failTx := newTxCounter(1, 1)
+	failTx.FailOnAnte = true
+	failTxBytes, err := cdc.MarshalBinaryBare(failTx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: failTxBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Equal(t, []string{"begin", "tx", "tx", "end"}, streamingService.hooks)
+	require.Empty(t, streamingService.pairs[0])
+	require.Len(t, streamingService.pairs[1], 2)
+	require.Equal(t, anteKey, streamingService.pairs[1][0].Key)
+	require.Equal(t, capKey1.Name(), streamingService.pairs[1][0].StoreKey)
+	require.Equal(t, deliverKey, streamingService.pairs[1][1].Key)
+	require.Empty(t, streamingService.pairs[2])
+	require.Empty(t, streamingService.pairs[3])
+
+	// Commit does not report the writes of the block again
+	require.Zero(t, streamingService.buf.Len())
+}
@@ -64,6 +64,8 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
 	json, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 	if err != nil {
 		return err
@@ -100,6 +102,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
 	if !clientCtx.SkipConfirm {
 		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 		if err != nil {
@@ -373,14 +377,18 @@ func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
 		len(tx.GetSigners()) > 1 {
 		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
 	}
+	if mode == signing.SignMode_SIGN_MODE_TEXTUAL &&
+		len(tx.GetSigners()) > 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in TEXTUAL mode is only supported for transactions with one signer only")
+	}
 	return nil
 }
 
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
-// Signing a transaction with mutltiple signers in the DIRECT mode is not supprted and will
-// return an error.
+// Signing a transaction with mutltiple signers in the DIRECT or TEXTUAL mode is not supprted
+// and will return an error.
 // An error is returned upon failure.
 func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
 	if txf.keybase == nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -16,6 +17,7 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -158,6 +160,12 @@ func TestSign(t *testing.T) {
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
 	txfAmino := txfDirect.
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	txfTextual := txfDirect.
+		WithTxConfig(authtx.NewTxConfig(
+			codec.NewProtoCodec(simapp.MakeTestEncodingConfig().InterfaceRegistry),
+			[]signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		)).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	msg1 := banktypes.NewMsgSend(info1.GetAddress(), sdk.AccAddress("to"), nil)
 	msg2 := banktypes.NewMsgSend(info2.GetAddress(), sdk.AccAddress("to"), nil)
 	txb, err := tx.BuildUnsignedTx(txfNoKeybase, msg1, msg2)
@@ -184,6 +192,8 @@ func TestSign(t *testing.T) {
 			txfAmino, txbSimple, from1, true, []cryptotypes.PubKey{pubKey1}, nil},
 		{"direct: should succeed with keyring",
 			txfDirect, txbSimple, from1, true, []cryptotypes.PubKey{pubKey1}, nil},
+		{"textual: should succeed with keyring",
+			txfTextual, txbSimple, from1, true, []cryptotypes.PubKey{pubKey1}, nil},
 
 		/**** test double sign Amino mode ****/
 		{"amino: should sign multi-signers tx",
@@ -201,6 +211,10 @@ func TestSign(t *testing.T) {
 			txfDirect, txb2, from1, false, []cryptotypes.PubKey{}, nil},
 		{"direct: should fail to overwrite multi-signers tx",
 			txfDirect, txb2, from1, true, []cryptotypes.PubKey{}, nil},
+
+		/**** signing transaction with more than 2 signers should fail in TEXTUAL mode ****/
+		{"textual: should fail to sign multi-signers tx",
+			txfTextual, txb2, from1, true, []cryptotypes.PubKey{}, nil},
 	}
 	var prevSigs []signingtypes.SignatureV2
 	for _, tc := range testCases {
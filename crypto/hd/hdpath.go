@@ -1,6 +1,7 @@
 package hd
 
 import (
+	"crypto/elliptic"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/binary"
@@ -174,9 +175,40 @@ func ComputeMastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
 	return
 }
 
+// ComputeP256MastersFromSeed returns the master secret key's, and chain code, of the
+// NIST P-256 curve, as specified by https://github.com/satoshilabs/slips/blob/master/slip-0010.md.
+func ComputeP256MastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	curveIdentifier := []byte("Nist256p1 seed")
+	data := seed
+	for {
+		secret, chainCode = i64(curveIdentifier, data)
+		if isValidP256Key(secret[:]) {
+			return
+		}
+
+		// the master key is invalid, retry with the whole HMAC output
+		data = append(secret[:], chainCode[:]...)
+	}
+}
+
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	return derivePrivateKeyForPath(privKeyBytes, chainCode, path, derivePrivateKey)
+}
+
+// DeriveP256PrivateKeyForPath derives the NIST P-256 private key by following the BIP 32/44 path
+// from privKeyBytes, using the given chainCode, as specified by SLIP-0010.
+func DeriveP256PrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	return derivePrivateKeyForPath(privKeyBytes, chainCode, path, deriveP256PrivateKey)
+}
+
+// derivePrivateKeyForPath follows the BIP 32/44 path from privKeyBytes, deriving each child key
+// with deriveFn.
+func derivePrivateKeyForPath(
+	privKeyBytes, chainCode [32]byte, path string,
+	deriveFn func(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte),
+) ([]byte, error) {
 	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
@@ -203,7 +235,7 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
+		data, chainCode = deriveFn(data, chainCode, uint32(idx), harden)
 	}
 
 	derivedKey := make([]byte, 32)
@@ -248,6 +280,53 @@ func derivePrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, h
 	return x, chainCode2
 }
 
+// deriveP256PrivateKey derives the NIST P-256 private key with index and chainCode, as specified
+// by SLIP-0010. If harden is true, the derivation is 'hardened'.
+// It returns the new private key and new chain code.
+func deriveP256PrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	var data []byte
+
+	if harden {
+		index |= 0x80000000
+
+		data = append([]byte{byte(0)}, privKeyBytes[:]...)
+	} else {
+		curve := elliptic.P256()
+		x, y := curve.ScalarBaseMult(privKeyBytes[:])
+		data = elliptic.MarshalCompressed(curve, x, y)
+	}
+
+	data = append(data, uint32ToBytes(index)...)
+	for {
+		data2, chainCode2 := i64(chainCode[:], data)
+		if isValidP256Key(data2[:]) {
+			x := addP256Scalars(privKeyBytes[:], data2[:])
+			if isValidP256Key(x[:]) {
+				return x, chainCode2
+			}
+		}
+
+		// the child key is invalid, retry with the next candidate
+		data = append([]byte{byte(1)}, chainCode2[:]...)
+		data = append(data, uint32ToBytes(index)...)
+	}
+}
+
+// isValidP256Key returns whether key is a valid NIST P-256 private key, i.e. in [1, n-1].
+func isValidP256Key(key []byte) bool {
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() > 0 && k.Cmp(elliptic.P256().Params().N) < 0
+}
+
+// modular big endian addition over the NIST P-256 curve order
+func addP256Scalars(a []byte, b []byte) [32]byte {
+	sInt := new(big.Int).Add(new(big.Int).SetBytes(a), new(big.Int).SetBytes(b))
+	x2 := [32]byte{}
+	sInt.Mod(sInt, elliptic.P256().Params().N).FillBytes(x2[:])
+
+	return x2
+}
+
 // modular big endian addition
 func addScalars(a []byte, b []byte) [32]byte {
 	aInt := new(big.Int).SetBytes(a)
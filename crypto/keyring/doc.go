@@ -43,4 +43,19 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+//
+// Keyring plugins
+//
+// The "plugin:<path>" backend delegates key storage and signing to an external process, such
+// as an HSM bridge or a cloud KMS proxy. The keyring runs the plugin executable at path once
+// per operation, writes a JSON encoded PluginRequest to its standard input, and reads a JSON
+// encoded PluginResponse from its standard output. The operations are:
+// 	list	{"method":"list"} returns {"keys":[{"name":...,"algo":...,"pub_key":...}]}.
+// 	pubkey	{"method":"pubkey","name":...} returns {"key":{"name":...,"algo":...,"pub_key":...}}.
+// 	sign	{"method":"sign","name":...,"msg":...} returns {"signature":...}.
+// Byte fields are base64 encoded, and failed operations return {"error":...}. Keys use the
+// secp256k1 or secp256r1 algorithm, and signatures are checked against the public key of the
+// key before being returned. The keys are managed by the plugin itself, so that the keyring
+// cannot create, import, export or delete them. Plugins written in Go can implement
+// PluginHandler and call ServePlugin from their main function.
 package keyring
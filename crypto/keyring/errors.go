@@ -10,4 +10,8 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrPluginUnsupported is raised when the caller tries to manage the keys
+	// of a keyring plugin, which only lists keys and signs with them.
+	ErrPluginUnsupported = errors.New("operation not supported by keyring plugins")
 )
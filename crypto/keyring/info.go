@@ -32,6 +32,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &pluginInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -177,6 +178,56 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// pluginInfo is the public information about a key held by a keyring plugin
+type pluginInfo struct {
+	Name   string             `json:"name"`
+	PubKey cryptotypes.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType      `json:"algo"`
+}
+
+func newPluginInfo(key PluginKey) (Info, error) {
+	pub, err := key.pubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pluginInfo{
+		Name:   key.Name,
+		PubKey: pub,
+		Algo:   key.Algo,
+	}, nil
+}
+
+// GetType implements Info interface
+func (i pluginInfo) GetType() KeyType {
+	return TypePlugin
+}
+
+// GetName implements Info interface
+func (i pluginInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i pluginInfo) GetPubKey() cryptotypes.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo returns the signing algorithm for the key
+func (i pluginInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetAddress implements Info interface
+func (i pluginInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetPath implements Info interface
+func (i pluginInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 type multisigPubKeyInfo struct {
 	PubKey cryptotypes.PubKey `json:"pubkey"`
 	Weight uint               `json:"weight"`
@@ -151,7 +151,8 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test",
+// and "plugin:<path>" for the keyring plugin executable at path.
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -160,6 +161,15 @@ func New(
 		err error
 	)
 
+	if strings.HasPrefix(backend, BackendPluginPrefix) {
+		path := strings.TrimPrefix(backend, BackendPluginPrefix)
+		if path == "" {
+			return nil, fmt.Errorf("missing keyring plugin path in backend %v", backend)
+		}
+
+		return newPluginKeystore(path, opts...), nil
+	}
+
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
@@ -192,7 +202,7 @@ type keystore struct {
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -795,7 +805,12 @@ type unsafeKeystore struct {
 
 // NewUnsafe returns a new keyring that provides support for unsafe operations.
 func NewUnsafe(kr Keyring) UnsafeKeyring {
-	// The type assertion is against the only keystore
+	// keyring plugins never export private keys
+	if ks, ok := kr.(pluginKeystore); ok {
+		return ks
+	}
+
+	// The type assertion is against the only other keystore
 	// implementation that is currently provided.
 	ks := kr.(keystore)
 
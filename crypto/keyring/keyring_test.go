@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -996,6 +997,44 @@ func TestAltKeyring_SignByAddress(t *testing.T) {
 	require.True(t, key.VerifySignature(msg, sign))
 }
 
+func TestAltKeyring_Secp256r1(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	uid := "jack"
+	info, _, err := keyring.NewMnemonic(uid, English, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	require.Equal(t, hd.Secp256r1Type, info.GetAlgo())
+	require.IsType(t, &secp256r1.PubKey{}, info.GetPubKey())
+
+	msg := []byte("some message")
+	sign, key, err := keyring.Sign(uid, msg)
+	require.NoError(t, err)
+	require.True(t, key.VerifySignature(msg, sign))
+
+	// the private key survives an export/import round trip
+	armor, err := keyring.ExportPrivKeyArmor(uid, "password")
+	require.NoError(t, err)
+	require.NoError(t, keyring.Delete(uid))
+	require.NoError(t, keyring.ImportPrivKey("imported", armor, "password"))
+	imported, err := keyring.Key("imported")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+	require.Equal(t, hd.Secp256r1Type, imported.GetAlgo())
+
+	// so does the public key, e.g. of a key kept in a secure enclave
+	pub := secp256r1.GenPrivKey().PubKey()
+	_, err = keyring.SavePubKey("enclave", pub, hd.Secp256r1Type)
+	require.NoError(t, err)
+	armor, err = keyring.ExportPubKeyArmor("enclave")
+	require.NoError(t, err)
+	require.NoError(t, keyring.Delete("enclave"))
+	require.NoError(t, keyring.ImportPubKey("enclave2", armor))
+	imported, err = keyring.Key("enclave2")
+	require.NoError(t, err)
+	require.Equal(t, pub, imported.GetPubKey())
+}
+
 func TestAltKeyring_ImportExportPrivKey(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
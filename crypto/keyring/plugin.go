@@ -0,0 +1,325 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BackendPluginPrefix prefixes the path of the executable of a keyring plugin
+// in the backend name, e.g. "plugin:/usr/local/bin/hsm-bridge".
+const BackendPluginPrefix = "plugin:"
+
+// Methods of the keyring plugin protocol.
+const (
+	// PluginMethodList lists the keys of the plugin, in PluginResponse.Keys.
+	PluginMethodList = "list"
+	// PluginMethodPubKey returns the key named PluginRequest.Name, in PluginResponse.Key.
+	PluginMethodPubKey = "pubkey"
+	// PluginMethodSign signs PluginRequest.Msg with the key named PluginRequest.Name,
+	// and returns the signature in PluginResponse.Signature.
+	PluginMethodSign = "sign"
+)
+
+// PluginRequest is a request of the keyring plugin protocol. The keyring runs
+// the plugin executable once per request, writes the JSON encoded request to
+// its standard input, and reads a JSON encoded PluginResponse from its standard
+// output.
+type PluginRequest struct {
+	Method string `json:"method"`
+	Name   string `json:"name,omitempty"`
+	Msg    []byte `json:"msg,omitempty"`
+}
+
+// PluginResponse is a response of the keyring plugin protocol. If Error is not
+// empty, the request failed and the other fields are ignored.
+type PluginResponse struct {
+	Keys      []PluginKey `json:"keys,omitempty"`
+	Key       *PluginKey  `json:"key,omitempty"`
+	Signature []byte      `json:"signature,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// PluginKey is a key held by a keyring plugin. PubKey is the compressed public
+// key of the secp256k1 or secp256r1 algorithm, and signatures must have the
+// form R || S, in lower-S form.
+type PluginKey struct {
+	Name   string        `json:"name"`
+	Algo   hd.PubKeyType `json:"algo"`
+	PubKey []byte        `json:"pub_key"`
+}
+
+// PluginHandler is implemented by keyring plugins written in Go, and served by
+// ServePlugin.
+type PluginHandler interface {
+	List() ([]PluginKey, error)
+	PubKey(name string) (PluginKey, error)
+	Sign(name string, msg []byte) ([]byte, error)
+}
+
+// ServePlugin reads a PluginRequest from in, handles it with handler, and
+// writes the PluginResponse to out. It is meant to be called by the main
+// function of a keyring plugin, with the standard input and output.
+func ServePlugin(handler PluginHandler, in io.Reader, out io.Writer) error {
+	var (
+		req  PluginRequest
+		resp PluginResponse
+		err  error
+	)
+
+	if err = json.NewDecoder(in).Decode(&req); err != nil {
+		return err
+	}
+
+	switch req.Method {
+	case PluginMethodList:
+		resp.Keys, err = handler.List()
+	case PluginMethodPubKey:
+		var key PluginKey
+		key, err = handler.PubKey(req.Name)
+		resp.Key = &key
+	case PluginMethodSign:
+		resp.Signature, err = handler.Sign(req.Name, req.Msg)
+	default:
+		err = fmt.Errorf("unknown method %q", req.Method)
+	}
+
+	if err != nil {
+		resp = PluginResponse{Error: err.Error()}
+	}
+
+	return json.NewEncoder(out).Encode(resp)
+}
+
+// pluginKeystore is a read-only Keyring whose keys are held, and used to sign,
+// by a keyring plugin.
+type pluginKeystore struct {
+	path    string
+	options Options
+}
+
+var _ UnsafeKeyring = pluginKeystore{}
+
+func newPluginKeystore(path string, opts ...Option) pluginKeystore {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{},
+		SupportedAlgosLedger: SigningAlgoList{},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	return pluginKeystore{path, options}
+}
+
+// call runs the plugin with req.
+func (ks pluginKeystore) call(req PluginRequest) (PluginResponse, error) {
+	var resp PluginResponse
+
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return resp, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(ks.path)
+	cmd.Stdin = bytes.NewReader(bz)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return resp, fmt.Errorf("keyring plugin %s failed: %w: %s", ks.path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return resp, fmt.Errorf("invalid response from keyring plugin %s: %w", ks.path, err)
+	}
+
+	if resp.Error != "" {
+		return resp, fmt.Errorf("keyring plugin %s: %s", ks.path, resp.Error)
+	}
+
+	return resp, nil
+}
+
+func (ks pluginKeystore) List() ([]Info, error) {
+	resp, err := ks.call(PluginRequest{Method: PluginMethodList})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Info, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		info, err := newPluginInfo(key)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, info)
+	}
+
+	return res, nil
+}
+
+func (ks pluginKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks pluginKeystore) Key(uid string) (Info, error) {
+	resp, err := ks.call(PluginRequest{Method: PluginMethodPubKey, Name: uid})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Key == nil {
+		return nil, fmt.Errorf("invalid response from keyring plugin %s: missing key", ks.path)
+	}
+
+	return newPluginInfo(*resp.Key)
+}
+
+func (ks pluginKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	infos, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.GetAddress().Equals(address) {
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key with address %s not found", address)
+}
+
+func (ks pluginKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := ks.call(PluginRequest{Method: PluginMethodSign, Name: uid, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// catch misbehaving plugins before the signature is broadcasted
+	if !info.GetPubKey().VerifySignature(msg, resp.Signature) {
+		return nil, nil, fmt.Errorf("keyring plugin %s returned an invalid signature for key %s", ks.path, uid)
+	}
+
+	return resp.Signature, info.GetPubKey(), nil
+}
+
+func (ks pluginKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	key, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(key.GetName(), msg)
+}
+
+func (ks pluginKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshalBinaryBare(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks pluginKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(info.GetName())
+}
+
+// The keys of a plugin are managed by the plugin itself, so that the following
+// operations are not supported.
+
+func (ks pluginKeystore) Delete(string) error {
+	return ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) ImportPrivKey(string, string, string) error {
+	return ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) ImportPubKey(string, string) error {
+	return ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrPluginUnsupported
+}
+
+func (ks pluginKeystore) UnsafeExportPrivKeyHex(string) (string, error) {
+	return "", ErrPluginUnsupported
+}
+
+// pubKey returns the public key of key.
+func (key PluginKey) pubKey() (types.PubKey, error) {
+	switch key.Algo {
+	case hd.Secp256k1Type:
+		if len(key.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("invalid %s public key size for key %s", key.Algo, key.Name)
+		}
+		return &secp256k1.PubKey{Key: key.PubKey}, nil
+
+	case hd.Secp256r1Type:
+		if len(key.PubKey) != secp256r1.PubKeySize {
+			return nil, fmt.Errorf("invalid %s public key size for key %s", key.Algo, key.Name)
+		}
+		return &secp256r1.PubKey{Key: key.PubKey}, nil
+
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedSigningAlgo, "key %s: %s", key.Name, key.Algo)
+	}
+}
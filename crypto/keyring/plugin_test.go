@@ -0,0 +1,184 @@
+package keyring
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockPluginEnv makes the test binary run as the mock keyring plugin.
+const mockPluginEnv = "COSMOS_SDK_KEYRING_MOCK_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(mockPluginEnv) != "" {
+		if err := ServePlugin(mockPlugin{}, os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// mockPluginKeys are the keys of the mock plugin. As the plugin runs once per
+// request, they are derived from their names.
+var mockPluginKeys = map[string]types.PrivKey{
+	"alice":   secp256k1.GenPrivKeyFromSecret([]byte("alice")),
+	"bob":     secp256r1.GenPrivKeyFromSecret([]byte("bob")),
+	"mallory": secp256k1.GenPrivKeyFromSecret([]byte("mallory")),
+}
+
+// mockPlugin is a keyring plugin serving mockPluginKeys. It signs with the
+// wrong key for mallory.
+type mockPlugin struct{}
+
+func (p mockPlugin) List() ([]PluginKey, error) {
+	keys := []PluginKey{}
+	for _, name := range []string{"alice", "bob", "mallory"} {
+		key, err := p.PubKey(name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (p mockPlugin) PubKey(name string) (PluginKey, error) {
+	priv, ok := mockPluginKeys[name]
+	if !ok {
+		return PluginKey{}, fmt.Errorf("key %s not found", name)
+	}
+	return PluginKey{Name: name, Algo: hd.PubKeyType(priv.Type()), PubKey: priv.PubKey().Bytes()}, nil
+}
+
+func (p mockPlugin) Sign(name string, msg []byte) ([]byte, error) {
+	priv, ok := mockPluginKeys[name]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", name)
+	}
+	if name == "mallory" {
+		priv = mockPluginKeys["alice"]
+	}
+	return priv.Sign(msg)
+}
+
+// newMockPluginKeyring returns a keyring backed by the mock plugin.
+func newMockPluginKeyring(t *testing.T) Keyring {
+	require.NoError(t, os.Setenv(mockPluginEnv, "1"))
+	t.Cleanup(func() { os.Unsetenv(mockPluginEnv) })
+
+	kr, err := New(t.Name(), BackendPluginPrefix+os.Args[0], t.TempDir(), nil)
+	require.NoError(t, err)
+	return kr
+}
+
+func TestPluginKeyring_Keys(t *testing.T) {
+	kr := newMockPluginKeyring(t)
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	for i, name := range []string{"alice", "bob", "mallory"} {
+		require.Equal(t, name, list[i].GetName())
+		require.Equal(t, TypePlugin, list[i].GetType())
+		require.Equal(t, mockPluginKeys[name].PubKey(), list[i].GetPubKey())
+	}
+	require.Equal(t, hd.Secp256k1Type, list[0].GetAlgo())
+	require.Equal(t, hd.Secp256r1Type, list[1].GetAlgo())
+
+	info, err := kr.Key("bob")
+	require.NoError(t, err)
+	require.Equal(t, list[1], info)
+
+	info, err = kr.KeyByAddress(list[0].GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, list[0], info)
+
+	_, err = kr.Key("carol")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "key carol not found")
+
+	_, err = kr.KeyByAddress(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	require.Error(t, err)
+
+	armor, err := kr.ExportPubKeyArmor("bob")
+	require.NoError(t, err)
+	bz, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256r1Type), algo)
+	require.NotEmpty(t, bz)
+}
+
+func TestPluginKeyring_Sign(t *testing.T) {
+	kr := newMockPluginKeyring(t)
+	msg := []byte("some message")
+
+	for _, name := range []string{"alice", "bob"} {
+		sig, pub, err := kr.Sign(name, msg)
+		require.NoError(t, err)
+		require.Equal(t, mockPluginKeys[name].PubKey(), pub)
+		require.True(t, pub.VerifySignature(msg, sig))
+
+		info, err := kr.Key(name)
+		require.NoError(t, err)
+		sig, pub, err = kr.SignByAddress(info.GetAddress(), msg)
+		require.NoError(t, err)
+		require.True(t, pub.VerifySignature(msg, sig))
+	}
+
+	// the signature returned by the plugin is checked
+	_, _, err := kr.Sign("mallory", msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid signature")
+
+	_, _, err = kr.Sign("carol", msg)
+	require.Error(t, err)
+}
+
+func TestPluginKeyring_Unsupported(t *testing.T) {
+	kr := newMockPluginKeyring(t)
+	pub := secp256k1.GenPrivKey().PubKey()
+
+	_, _, err := kr.NewMnemonic("carol", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrPluginUnsupported, err)
+	_, err = kr.SavePubKey("carol", pub, hd.Secp256k1Type)
+	require.Equal(t, ErrPluginUnsupported, err)
+	require.Equal(t, ErrPluginUnsupported, kr.Delete("alice"))
+	_, err = kr.ExportPrivKeyArmor("alice", "password")
+	require.Equal(t, ErrPluginUnsupported, err)
+	_, err = NewUnsafe(kr).UnsafeExportPrivKeyHex("alice")
+	require.Equal(t, ErrPluginUnsupported, err)
+
+	algos, ledgerAlgos := kr.SupportedAlgorithms()
+	require.Empty(t, algos)
+	require.Empty(t, ledgerAlgos)
+}
+
+func TestPluginKeyring_Errors(t *testing.T) {
+	_, err := New(t.Name(), BackendPluginPrefix, t.TempDir(), nil)
+	require.Error(t, err)
+
+	// the plugin is only run once a key is used
+	kr, err := New(t.Name(), BackendPluginPrefix+"/non/existent/plugin", t.TempDir(), nil)
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+
+	// unknown methods are reported, invalid requests rejected
+	var out strings.Builder
+	require.NoError(t, ServePlugin(mockPlugin{}, strings.NewReader(`{"method":"unknown"}`), &out))
+	require.Equal(t, `{"error":"unknown method \"unknown\""}`+"\n", out.String())
+	require.Error(t, ServePlugin(mockPlugin{}, strings.NewReader(`garbage`), &out))
+}
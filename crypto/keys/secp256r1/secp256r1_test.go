@@ -0,0 +1,206 @@
+package secp256r1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestSignAndValidateSecp256r1(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), secp256r1.PubKeySize)
+	require.Len(t, pubKey.Address(), 20)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// ----
+	// Test cross packages verification
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Bytes())
+	require.NotNil(t, x)
+	stdPubKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	assert.True(t, ecdsa.Verify(stdPubKey, crypto.Sha256(msg), r, s))
+
+	// The malleated signature (r, n - s) is rejected
+	highS := new(big.Int).Sub(elliptic.P256().Params().N, s)
+	sig2 := make([]byte, secp256r1.SignatureSize)
+	copy(sig2, sig[:32])
+	highS.FillBytes(sig2[32:])
+	assert.True(t, ecdsa.Verify(stdPubKey, crypto.Sha256(msg), r, highS))
+	assert.False(t, pubKey.VerifySignature(msg, sig2))
+
+	// ----
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+
+	// Invalid signature length and public key are rejected
+	assert.False(t, pubKey.VerifySignature(msg, sig[:63]))
+	invalidPubKey := &secp256r1.PubKey{Key: make([]byte, secp256r1.PubKeySize)}
+	assert.False(t, invalidPubKey.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := secp256r1.GenPrivKeyFromSecret([]byte("secret"))
+	require.Len(t, privKey.Bytes(), secp256r1.PrivKeySize)
+	require.Equal(t, privKey, secp256r1.GenPrivKeyFromSecret([]byte("secret")))
+	require.NotEqual(t, privKey, secp256r1.GenPrivKeyFromSecret([]byte("other secret")))
+
+	msg := []byte("hello")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	secp256r1PubKey := secp256r1.GenPrivKey().PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		msg      string
+		pubKey   cryptotypes.PubKey
+		other    cryptotypes.PubKey
+		expectEq bool
+	}{
+		{
+			"different bytes",
+			secp256r1PubKey,
+			secp256r1.GenPrivKey().PubKey(),
+			false,
+		},
+		{
+			"equals",
+			secp256r1PubKey,
+			&secp256r1.PubKey{
+				Key: secp256r1PubKey.Key,
+			},
+			true,
+		},
+		{
+			"different types",
+			secp256r1PubKey,
+			&secp256k1.PubKey{
+				Key: secp256r1PubKey.Key,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			eq := tc.pubKey.Equals(tc.other)
+			require.Equal(t, eq, tc.expectEq)
+		})
+	}
+}
+
+func TestPrivKeyEquals(t *testing.T) {
+	secp256r1PrivKey := secp256r1.GenPrivKey()
+
+	testCases := []struct {
+		msg      string
+		privKey  cryptotypes.PrivKey
+		other    cryptotypes.PrivKey
+		expectEq bool
+	}{
+		{
+			"different bytes",
+			secp256r1PrivKey,
+			secp256r1.GenPrivKey(),
+			false,
+		},
+		{
+			"equals",
+			secp256r1PrivKey,
+			&secp256r1.PrivKey{
+				Key: secp256r1PrivKey.Key,
+			},
+			true,
+		},
+		{
+			"different types",
+			secp256r1PrivKey,
+			&secp256k1.PrivKey{
+				Key: secp256r1PrivKey.Key,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			eq := tc.privKey.Equals(tc.other)
+			require.Equal(t, eq, tc.expectEq)
+		})
+	}
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"secp256r1 private key",
+			privKey,
+			&secp256r1.PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"secp256r1 public key",
+			pubKey,
+			&secp256r1.PubKey{},
+			append([]byte{33}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.MarshalBinaryBare(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.UnmarshalBinaryBare(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
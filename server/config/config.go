@@ -107,6 +107,29 @@ type GRPCConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// RosettaConfig defines the Rosetta API listener configuration.
+type RosettaConfig struct {
+	// Enable defines if the Rosetta API server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the Rosetta API server to listen on.
+	Address string `mapstructure:"address"`
+
+	// Blockchain defines the blockchain name the Rosetta API server serves.
+	Blockchain string `mapstructure:"blockchain"`
+
+	// Network defines the network name the Rosetta API server serves.
+	Network string `mapstructure:"network"`
+
+	// Retries defines the number of attempts made to reach the node before the
+	// Rosetta API server fails to start.
+	Retries int `mapstructure:"retries"`
+
+	// Offline defines if the Rosetta API server runs in offline mode, serving
+	// only the construction endpoints which do not require a node connection.
+	Offline bool `mapstructure:"offline"`
+}
+
 // StateSyncConfig defines the state sync snapshot configuration.
 type StateSyncConfig struct {
 	// SnapshotInterval sets the interval at which state sync snapshots are taken.
@@ -116,6 +139,40 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotQueryCacheSize sets the number of snapshots restored to serve
+	// queries at pruned heights to keep. 0 disables queries from snapshots.
+	SnapshotQueryCacheSize uint32 `mapstructure:"snapshot-query-cache-size"`
+
+	// SnapshotQueryDir sets the directory to restore snapshots in to serve
+	// queries at pruned heights. Its contents are deleted when the node starts.
+	// The snapshots are restored in memory if empty.
+	SnapshotQueryDir string `mapstructure:"snapshot-query-dir"`
+}
+
+// StoreConfig defines the multi-store configuration.
+type StoreConfig struct {
+	// Streamers defines the names of the streaming services the state changes
+	// of the multi-store are streamed to. Each of them is configured by its own
+	// section of StreamersConfig.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of the state streaming services.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys defines the names of the KVStores to stream, "*" streams all of them.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the files are written to.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix defines an optional prefix for the names of the files.
+	Prefix string `mapstructure:"prefix"`
 }
 
 // Config defines the server's top level configuration
@@ -126,7 +183,10 @@ type Config struct {
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -185,10 +245,28 @@ func DefaultConfig() *Config {
 			Enable:  true,
 			Address: DefaultGRPCAddress,
 		},
+		Rosetta: RosettaConfig{
+			Enable:     false,
+			Address:    ":8080",
+			Blockchain: "app",
+			Network:    "network",
+			Retries:    3,
+			Offline:    false,
+		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "",
+				Prefix:   "",
+			},
+		},
 	}
 }
 
@@ -239,9 +317,29 @@ func GetConfig(v *viper.Viper) Config {
 			Enable:  v.GetBool("grpc.enable"),
 			Address: v.GetString("grpc.address"),
 		},
+		Rosetta: RosettaConfig{
+			Enable:     v.GetBool("rosetta.enable"),
+			Address:    v.GetString("rosetta.address"),
+			Blockchain: v.GetString("rosetta.blockchain"),
+			Network:    v.GetString("rosetta.network"),
+			Retries:    v.GetInt("rosetta.retries"),
+			Offline:    v.GetBool("rosetta.offline"),
+		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:       v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent:     v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotQueryCacheSize: v.GetUint32("state-sync.snapshot-query-cache-size"),
+			SnapshotQueryDir:       v.GetString("state-sync.snapshot-query-dir"),
+		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write-dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
 		},
 	}
 }
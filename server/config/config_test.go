@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,19 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestWriteConfigFileStreamers(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	cfg := DefaultConfig()
+	cfg.Store.Streamers = []string{"file"}
+	cfg.Streamers.File.WriteDir = "/tmp/streaming"
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := GetConfig(v)
+	require.Equal(t, cfg.Store, parsed.Store)
+	require.Equal(t, cfg.Streamers, parsed.Streamers)
+}
@@ -147,6 +147,32 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+###############################################################################
+###                           Rosetta Configuration                         ###
+###############################################################################
+
+[rosetta]
+
+# Enable defines if the Rosetta API server should be enabled.
+enable = {{ .Rosetta.Enable }}
+
+# Address defines the Rosetta API server to listen on.
+address = "{{ .Rosetta.Address }}"
+
+# Blockchain defines the blockchain name the Rosetta API server serves.
+blockchain = "{{ .Rosetta.Blockchain }}"
+
+# Network defines the network name the Rosetta API server serves.
+network = "{{ .Rosetta.Network }}"
+
+# Retries defines the number of attempts made to reach the node before the
+# Rosetta API server fails to start.
+retries = {{ .Rosetta.Retries }}
+
+# Offline defines if the Rosetta API server runs in offline mode, serving only
+# the construction endpoints which do not require a node connection.
+offline = {{ .Rosetta.Offline }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -161,6 +187,42 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-query-cache-size specifies the number of local snapshots restored to serve queries at
+# heights which were pruned to keep (0 to disable). Queries at such heights are only served if a
+# snapshot was taken at that height.
+snapshot-query-cache-size = {{ .StateSync.SnapshotQueryCacheSize }}
+
+# snapshot-query-dir specifies the directory, relative to the node's home directory if not
+# absolute, to restore the snapshots serving queries in. Its contents are deleted when the node
+# starts. The snapshots are restored in memory if empty.
+snapshot-query-dir = "{{ .StateSync.SnapshotQueryDir }}"
+
+###############################################################################
+###                        Store / State Streaming                          ###
+###############################################################################
+
+[store]
+
+# streamers defines the streaming services the state changes (KV sets and deletes) of each
+# BeginBlock, DeliverTx and EndBlock are streamed to, together with the ABCI request and response.
+# Supported services: "file".
+streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+[streamers]
+
+[streamers.file]
+
+# keys defines the names of the KVStores to stream, "*" streams all of them.
+keys = [{{ range .Streamers.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write-dir defines the directory the files are written to. Each BeginBlock, DeliverTx and
+# EndBlock is written to its own file, holding the length-prefixed protobuf encoded request,
+# state changes (cosmos.base.store.v1beta1.StoreKVPair) and response.
+write-dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix for the names of the files.
+prefix = "{{ .Streamers.File.Prefix }}"
 `
 
 var configTemplate *template.Template
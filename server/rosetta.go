@@ -0,0 +1,110 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+)
+
+// RosettaCommand builds the rosetta root command, which starts a standalone
+// Rosetta API server connected to a node, given the interface registry of the
+// application.
+func RosettaCommand(ir codectypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Run the Rosetta API server",
+		Long: `Run a standalone Rosetta API server connected to a node through its
+Tendermint RPC and gRPC endpoints. With '--offline', no node connection is made and
+only the construction endpoints which do not require one are served.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			conf, err := rosetta.FromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			conf.InterfaceRegistry = ir
+
+			srv, err := rosetta.NewServer(conf, GetServerContextFromCmd(cmd).Logger.With("module", "rosetta-server"))
+			if err != nil {
+				return err
+			}
+
+			return srv.Start()
+		},
+	}
+
+	rosetta.SetFlags(cmd.Flags())
+	cmd.AddCommand(rosettaSignCommand())
+
+	return cmd
+}
+
+// rosettaSignCommand signs the payloads of a /construction/payloads response
+// with the keyring.
+func rosettaSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [payloads-file]",
+		Short: "Sign the payloads of a Rosetta /construction/payloads response with the keyring",
+		Long: `Sign the payloads of a Rosetta /construction/payloads response, read from the
+given JSON file, with the keys of the keyring. The key signing a payload is looked up
+by the payload account address.
+
+The output is a /construction/combine request, holding the unsigned transaction and
+its signatures, which can be sent as it is to build the signed transaction.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Keyring == nil {
+				return fmt.Errorf("no keyring available, use the --%s flag", flags.FlagKeyringBackend)
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var payloads rosettatypes.ConstructionPayloadsResponse
+			if err := json.Unmarshal(bz, &payloads); err != nil {
+				return err
+			}
+
+			signatures, err := rosetta.SignPayloads(clientCtx.Keyring, payloads.Payloads)
+			if err != nil {
+				return err
+			}
+
+			blockchain, _ := cmd.Flags().GetString(rosetta.FlagBlockchain)
+			network, _ := cmd.Flags().GetString(rosetta.FlagNetwork)
+			out, err := json.Marshal(rosettatypes.ConstructionCombineRequest{
+				NetworkIdentifier: &rosettatypes.NetworkIdentifier{
+					Blockchain: blockchain,
+					Network:    network,
+				},
+				UnsignedTransaction: payloads.UnsignedTransaction,
+				Signatures:          signatures,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", out))
+		},
+	}
+
+	cmd.Flags().String(rosetta.FlagBlockchain, rosetta.DefaultBlockchain, "the blockchain type")
+	cmd.Flags().String(rosetta.FlagNetwork, rosetta.DefaultNetwork, "the network name")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+
+	return cmd
+}
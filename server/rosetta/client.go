@@ -0,0 +1,404 @@
+package rosetta
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmrpc "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Client is the Rosetta client of a node. Blocks and node information are
+// queried through the tendermint gRPC service, balances and accounts through
+// the bank and auth gRPC query services and transactions are broadcast through
+// the tx gRPC service. Block results, which hold the events balance changes are
+// read from, and the mempool are queried from the tendermint RPC.
+type Client struct {
+	config    *Config
+	converter converter
+
+	auth      authtypes.QueryClient
+	bank      banktypes.QueryClient
+	tmService tmservice.ServiceClient
+	txService tx.ServiceClient
+	tmRPC     tmrpc.Client
+	grpcConn  *grpc.ClientConn
+}
+
+// NewClient returns a new Client for the given configuration. Bootstrap must
+// be called before the client is used.
+func NewClient(cfg *Config) (*Client, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		config:    cfg,
+		converter: newConverter(cfg),
+	}, nil
+}
+
+// Bootstrap connects the client to the node.
+func (c *Client) Bootstrap() error {
+	grpcConn, err := grpc.Dial(c.config.GRPCEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+
+	tmRPC, err := rpchttp.New(c.config.TendermintRPC, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	c.grpcConn = grpcConn
+	c.auth = authtypes.NewQueryClient(grpcConn)
+	c.bank = banktypes.NewQueryClient(grpcConn)
+	c.tmService = tmservice.NewServiceClient(grpcConn)
+	c.txService = tx.NewServiceClient(grpcConn)
+	c.tmRPC = tmRPC
+
+	return nil
+}
+
+// Ready returns an error if the node cannot be reached.
+func (c *Client) Ready() error {
+	ctx := context.Background()
+	if _, err := c.tmRPC.Health(ctx); err != nil {
+		return err
+	}
+
+	_, err := c.tmService.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	return err
+}
+
+// Close closes the connections of the client.
+func (c *Client) Close() error {
+	if c.grpcConn == nil {
+		return nil
+	}
+
+	return c.grpcConn.Close()
+}
+
+// Balances returns the balances of the given address at the given height.
+func (c *Client) Balances(ctx context.Context, address string, height int64) ([]*types.Amount, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+
+	var balances sdk.Coins
+	var nextKey []byte
+	for {
+		res, err := c.bank.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	amounts := make([]*types.Amount, len(balances))
+	for i, coin := range balances {
+		amounts[i] = &types.Amount{
+			Value:    coin.Amount.String(),
+			Currency: &types.Currency{Symbol: coin.Denom},
+		}
+	}
+
+	return amounts, nil
+}
+
+// BlockByHeight returns the block at the given height, or the latest block if
+// height is nil.
+func (c *Client) BlockByHeight(ctx context.Context, height *int64) (*types.Block, error) {
+	var (
+		blockID *tmproto.BlockID
+		block   *tmproto.Block
+	)
+	if height == nil {
+		res, err := c.tmService.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return nil, err
+		}
+		blockID, block = res.BlockId, res.Block
+	} else {
+		res, err := c.tmService.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: *height})
+		if err != nil {
+			return nil, err
+		}
+		blockID, block = res.BlockId, res.Block
+	}
+
+	return c.block(ctx, blockID.Hash, block)
+}
+
+// BlockByHash returns the block with the given hex encoded hash.
+func (c *Client) BlockByHash(ctx context.Context, hash string) (*types.Block, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, WrapError(ErrBadArgument, fmt.Sprintf("invalid block hash: %s", err))
+	}
+
+	res, err := c.tmRPC.BlockByHash(ctx, hashBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, WrapError(ErrNotFound, fmt.Sprintf("block %s", hash))
+	}
+
+	block, err := res.Block.ToProto()
+	if err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	return c.block(ctx, res.BlockID.Hash, block)
+}
+
+// block builds the Rosetta block of a tendermint block. Its transactions are
+// the block transactions, preceded and followed by the BeginBlock and EndBlock
+// pseudo transactions if balances changed in BeginBlock or EndBlock.
+func (c *Client) block(ctx context.Context, hash []byte, block *tmproto.Block) (*types.Block, error) {
+	height := block.Header.Height
+	results, err := c.tmRPC.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.TxsResults) != len(block.Data.Txs) {
+		return nil, WrapError(ErrUnknown, fmt.Sprintf("block %d has %d transactions but %d results", height, len(block.Data.Txs), len(results.TxsResults)))
+	}
+
+	txs := make([]*types.Transaction, 0, len(block.Data.Txs)+2)
+
+	beginTx, err := c.converter.BlockEventsTx(beginBlockHashPrefix, hash, results.BeginBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	if len(beginTx.Operations) != 0 {
+		txs = append(txs, beginTx)
+	}
+
+	for i, txBytes := range block.Data.Txs {
+		tx, err := c.converter.Tx(txBytes, results.TxsResults[i])
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	endTx, err := c.converter.BlockEventsTx(endBlockHashPrefix, hash, results.EndBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	if len(endTx.Operations) != 0 {
+		txs = append(txs, endTx)
+	}
+
+	identifier := &types.BlockIdentifier{Index: height, Hash: fmt.Sprintf("%X", hash)}
+
+	// the first block of the chain is its own parent
+	parentIdentifier := identifier
+	if len(block.Header.LastBlockId.Hash) != 0 {
+		parentIdentifier = &types.BlockIdentifier{Index: height - 1, Hash: fmt.Sprintf("%X", block.Header.LastBlockId.Hash)}
+	}
+
+	return &types.Block{
+		BlockIdentifier:       identifier,
+		ParentBlockIdentifier: parentIdentifier,
+		Timestamp:             block.Header.Time.UnixNano() / 1e6,
+		Transactions:          txs,
+	}, nil
+}
+
+// BlockTransaction returns the transaction with the given hex encoded hash, it
+// may be the BeginBlock or EndBlock pseudo transaction of a block.
+func (c *Client) BlockTransaction(ctx context.Context, hash string) (*types.Transaction, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, WrapError(ErrBadArgument, fmt.Sprintf("invalid transaction hash: %s", err))
+	}
+
+	hashPrefix, blockHash, ok := parseBlockEventsTxHash(hashBytes)
+	if !ok {
+		res, err := c.tmRPC.Tx(ctx, hashBytes, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.converter.Tx(res.Tx, &res.TxResult)
+	}
+
+	block, err := c.tmRPC.BlockByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if block.Block == nil {
+		return nil, WrapError(ErrNotFound, fmt.Sprintf("block %X", blockHash))
+	}
+
+	results, err := c.tmRPC.BlockResults(ctx, &block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	switch hashPrefix {
+	case beginBlockHashPrefix:
+		return c.converter.BlockEventsTx(hashPrefix, blockHash, results.BeginBlockEvents)
+	case endBlockHashPrefix:
+		return c.converter.BlockEventsTx(hashPrefix, blockHash, results.EndBlockEvents)
+	default:
+		return nil, WrapError(ErrBadArgument, fmt.Sprintf("invalid transaction hash %s", hash))
+	}
+}
+
+// Mempool returns the identifiers of the transactions in the mempool.
+func (c *Client) Mempool(ctx context.Context) ([]*types.TransactionIdentifier, error) {
+	res, err := c.tmRPC.UnconfirmedTxs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]*types.TransactionIdentifier, len(res.Txs))
+	for i, tx := range res.Txs {
+		identifiers[i] = &types.TransactionIdentifier{Hash: fmt.Sprintf("%X", tx.Hash())}
+	}
+
+	return identifiers, nil
+}
+
+// MempoolTransaction returns the mempool transaction with the given hex encoded
+// hash. As the transaction is not executed yet, its operations are built from
+// its messages.
+func (c *Client) MempoolTransaction(ctx context.Context, hash string) (*types.Transaction, error) {
+	res, err := c.tmRPC.UnconfirmedTxs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range res.Txs {
+		if fmt.Sprintf("%X", tx.Hash()) == hash {
+			return c.converter.Tx(tx, nil)
+		}
+	}
+
+	return nil, WrapError(ErrNotFound, fmt.Sprintf("mempool transaction %s", hash))
+}
+
+// Status returns the status of the node. As blocks may have been pruned, the
+// genesis block is the oldest block the node stores.
+func (c *Client) Status(ctx context.Context) (*types.NetworkStatusResponse, error) {
+	status, err := c.tmRPC.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	netInfo, err := c.tmRPC.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*types.Peer, len(netInfo.Peers))
+	for i, peer := range netInfo.Peers {
+		peers[i] = &types.Peer{
+			PeerID: string(peer.NodeInfo.ID()),
+			Metadata: map[string]interface{}{
+				"moniker":     peer.NodeInfo.Moniker,
+				"remote_ip":   peer.RemoteIP,
+				"is_outbound": peer.IsOutbound,
+			},
+		}
+	}
+
+	syncInfo := status.SyncInfo
+	oldest := &types.BlockIdentifier{Index: syncInfo.EarliestBlockHeight, Hash: syncInfo.EarliestBlockHash.String()}
+	synced := !syncInfo.CatchingUp
+
+	return &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{Index: syncInfo.LatestBlockHeight, Hash: syncInfo.LatestBlockHash.String()},
+		CurrentBlockTimestamp:  syncInfo.LatestBlockTime.UnixNano() / 1e6,
+		GenesisBlockIdentifier: oldest,
+		OldestBlockIdentifier:  oldest,
+		SyncStatus: &types.SyncStatus{
+			CurrentIndex: &syncInfo.LatestBlockHeight,
+			Synced:       &synced,
+		},
+		Peers: peers,
+	}, nil
+}
+
+// NodeVersion returns the version of the application the node runs.
+func (c *Client) NodeVersion(ctx context.Context) (string, error) {
+	res, err := c.tmService.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return res.ApplicationVersion.Version, nil
+}
+
+// ChainID returns the chain ID of the node.
+func (c *Client) ChainID(ctx context.Context) (string, error) {
+	res, err := c.tmService.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return res.DefaultNodeInfo.Network, nil
+}
+
+// SignerData returns the account number and sequence of the given address.
+func (c *Client) SignerData(ctx context.Context, address string) (*signerData, error) {
+	res, err := c.auth.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	var account authtypes.AccountI
+	if err := c.converter.cdc.UnpackAny(res.Account, &account); err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	return &signerData{
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+	}, nil
+}
+
+// PostTx broadcasts the given transaction and returns its hash. It returns an
+// error if the transaction fails CheckTx.
+func (c *Client) PostTx(ctx context.Context, txBytes []byte) (string, error) {
+	res, err := c.txService.BroadcastTx(ctx, &tx.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if res.TxResponse.Code != abci.CodeTypeOK {
+		return "", WrapError(ErrTxBroadcast, res.TxResponse.RawLog)
+	}
+
+	return fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), nil
+}
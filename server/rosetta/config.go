@@ -0,0 +1,170 @@
+package rosetta
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/spf13/pflag"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// configuration defaults constants
+const (
+	// DefaultBlockchain defines the default blockchain identifier name
+	DefaultBlockchain = "app"
+	// DefaultAddr defines the default rosetta binding address
+	DefaultAddr = ":8080"
+	// DefaultRetries is the default number of retries
+	DefaultRetries = 5
+	// DefaultTendermintEndpoint is the default value for the tendermint endpoint
+	DefaultTendermintEndpoint = "localhost:26657"
+	// DefaultGRPCEndpoint is the default value for the gRPC endpoint
+	DefaultGRPCEndpoint = "localhost:9090"
+	// DefaultNetwork defines the default network name
+	DefaultNetwork = "network"
+	// DefaultOffline defines the default offline value
+	DefaultOffline = false
+)
+
+// configuration flags
+const (
+	FlagBlockchain         = "blockchain"
+	FlagNetwork            = "network"
+	FlagTendermintEndpoint = "tendermint"
+	FlagGRPCEndpoint       = "grpc"
+	FlagAddr               = "addr"
+	FlagRetries            = "retries"
+	FlagOffline            = "offline"
+)
+
+// retryWait is the time waited between two attempts to reach the node.
+const retryWait = 5 * time.Second
+
+// Config defines the configuration of the rosetta server
+type Config struct {
+	// Blockchain defines the blockchain name
+	// defaults to DefaultBlockchain
+	Blockchain string
+	// Network defines the network name
+	Network string
+	// TendermintRPC defines the endpoint to connect to
+	// tendermint RPC, specifying 'tcp://' before is not
+	// required, usually it's at port 26657 of the node
+	TendermintRPC string
+	// GRPCEndpoint defines the cosmos application gRPC endpoint
+	// usually it is located at 9090 port
+	GRPCEndpoint string
+	// Addr defines the rosetta API listen address
+	Addr string
+	// Retries defines the maximum number of attempts made to reach the
+	// node before failing to start
+	Retries int
+	// Offline defines if the rosetta server should run in offline mode, in
+	// which case only the endpoints which do not require a node connection
+	// are available
+	Offline bool
+	// InterfaceRegistry is the interface registry of the application, it is
+	// used to decode transactions
+	InterfaceRegistry codectypes.InterfaceRegistry
+}
+
+// NetworkIdentifier returns the network identifier the server serves.
+func (c *Config) NetworkIdentifier() *types.NetworkIdentifier {
+	return &types.NetworkIdentifier{
+		Blockchain: c.Blockchain,
+		Network:    c.Network,
+	}
+}
+
+// validate validates a configuration and sets
+// its defaults in case they were not provided
+func (c *Config) validate() error {
+	if c.Blockchain == "" {
+		c.Blockchain = DefaultBlockchain
+	}
+	if c.Network == "" {
+		c.Network = DefaultNetwork
+	}
+	if c.Addr == "" {
+		c.Addr = DefaultAddr
+	}
+	if c.Retries <= 0 {
+		c.Retries = DefaultRetries
+	}
+	if c.InterfaceRegistry == nil {
+		return fmt.Errorf("interface registry must be set")
+	}
+
+	// these are only required by the online mode
+	if c.Offline {
+		return nil
+	}
+
+	if c.GRPCEndpoint == "" {
+		return fmt.Errorf("grpc endpoint not provided")
+	}
+	if c.TendermintRPC == "" {
+		return fmt.Errorf("tendermint rpc not provided")
+	}
+	if !strings.HasPrefix(c.TendermintRPC, "tcp://") {
+		c.TendermintRPC = fmt.Sprintf("tcp://%s", c.TendermintRPC)
+	}
+
+	return nil
+}
+
+// FromFlags gets the configuration from flags
+func FromFlags(flags *pflag.FlagSet) (*Config, error) {
+	blockchain, err := flags.GetString(FlagBlockchain)
+	if err != nil {
+		return nil, err
+	}
+	network, err := flags.GetString(FlagNetwork)
+	if err != nil {
+		return nil, err
+	}
+	tendermintRPC, err := flags.GetString(FlagTendermintEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	gRPCEndpoint, err := flags.GetString(FlagGRPCEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := flags.GetString(FlagAddr)
+	if err != nil {
+		return nil, err
+	}
+	retries, err := flags.GetInt(FlagRetries)
+	if err != nil {
+		return nil, err
+	}
+	offline, err := flags.GetBool(FlagOffline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Blockchain:    blockchain,
+		Network:       network,
+		TendermintRPC: tendermintRPC,
+		GRPCEndpoint:  gRPCEndpoint,
+		Addr:          addr,
+		Retries:       retries,
+		Offline:       offline,
+	}, nil
+}
+
+// SetFlags sets the configuration flags to the given flagset
+func SetFlags(flags *pflag.FlagSet) {
+	flags.String(FlagBlockchain, DefaultBlockchain, "the blockchain type")
+	flags.String(FlagNetwork, DefaultNetwork, "the network name")
+	flags.String(FlagTendermintEndpoint, DefaultTendermintEndpoint, "the tendermint rpc endpoint, without tcp://")
+	flags.String(FlagGRPCEndpoint, DefaultGRPCEndpoint, "the app gRPC endpoint")
+	flags.String(FlagAddr, DefaultAddr, "the address rosetta will bind to")
+	flags.Int(FlagRetries, DefaultRetries, "the number of retries that will be done before quitting")
+	flags.Bool(FlagOffline, DefaultOffline, "run rosetta only with construction API")
+}
@@ -0,0 +1,544 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Rosetta operation types and statuses
+const (
+	// OperationTransfer is the type of the operations which move coins from
+	// or to an account.
+	OperationTransfer = "transfer"
+
+	// StatusSuccess is the status of the operations of successful transactions.
+	StatusSuccess = "Success"
+	// StatusReverted is the status of the operations of failed transactions.
+	StatusReverted = "Reverted"
+)
+
+// beginBlockHashPrefix and endBlockHashPrefix are prepended to a block hash to
+// build the hash of the pseudo transactions holding the balance changes which
+// happened in BeginBlock and EndBlock. They make those hashes one byte longer
+// than a transaction hash so that they can't collide.
+const (
+	beginBlockHashPrefix byte = 0x0
+	endBlockHashPrefix   byte = 0x1
+)
+
+// converter converts between Rosetta API types and Cosmos SDK types. It does not
+// require a node connection and is therefore used by both the online and the
+// offline modes.
+type converter struct {
+	cdc      *codec.ProtoCodec
+	txConfig client.TxConfig
+}
+
+func newConverter(cfg *Config) converter {
+	cdc := codec.NewProtoCodec(cfg.InterfaceRegistry)
+	return converter{
+		cdc:      cdc,
+		txConfig: authtx.NewTxConfig(cdc, authtx.DefaultSignModes),
+	}
+}
+
+// EventsToOperations maps the balance changing events to Rosetta operations.
+// Each bank transfer event is mapped to an operation debiting the sender, if
+// any, and an operation crediting the recipient for every coin transferred.
+func (c converter) EventsToOperations(events []abci.Event, status string) ([]*types.Operation, error) {
+	var ops []*types.Operation
+	for _, event := range events {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+
+		var sender, recipient, amount string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case banktypes.AttributeKeySender:
+				sender = string(attr.Value)
+			case banktypes.AttributeKeyRecipient:
+				recipient = string(attr.Value)
+			case sdk.AttributeKeyAmount:
+				amount = string(attr.Value)
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, WrapError(ErrCodec, fmt.Sprintf("invalid transfer amount %q: %s", amount, err))
+		}
+
+		for _, coin := range coins {
+			if sender != "" {
+				ops = append(ops, newOperation(int64(len(ops)), status, sender, coin.Amount.Neg(), coin.Denom))
+			}
+			ops = append(ops, newOperation(int64(len(ops)), status, recipient, coin.Amount, coin.Denom))
+		}
+	}
+
+	return ops, nil
+}
+
+// MsgsToOperations maps the bank send messages to Rosetta operations. Messages
+// of other types do not have a deterministic effect on balances before they
+// are executed and are skipped.
+func (c converter) MsgsToOperations(msgs []sdk.Msg, status string) []*types.Operation {
+	var ops []*types.Operation
+	for _, msg := range msgs {
+		send, ok := msg.(*banktypes.MsgSend)
+		if !ok {
+			continue
+		}
+
+		for _, coin := range send.Amount {
+			ops = append(ops,
+				newOperation(int64(len(ops)), status, send.FromAddress, coin.Amount.Neg(), coin.Denom),
+				newOperation(int64(len(ops))+1, status, send.ToAddress, coin.Amount, coin.Denom),
+			)
+		}
+	}
+
+	return ops
+}
+
+// OperationsToMsgs maps Rosetta operations to bank send messages. The n-th
+// operation debiting an account is paired with the n-th operation crediting
+// an account, both must move the same coin.
+func (c converter) OperationsToMsgs(ops []*types.Operation) ([]sdk.Msg, error) {
+	var debits, credits []*types.Operation
+	for _, op := range ops {
+		if op.Type != OperationTransfer {
+			return nil, WrapError(ErrInvalidOperation, fmt.Sprintf("unsupported operation type %s", op.Type))
+		}
+		if op.Account == nil || op.Amount == nil || op.Amount.Currency == nil {
+			return nil, WrapError(ErrInvalidOperation, "operation account and amount must be set")
+		}
+
+		if strings.HasPrefix(op.Amount.Value, "-") {
+			debits = append(debits, op)
+		} else {
+			credits = append(credits, op)
+		}
+	}
+
+	if len(debits) == 0 || len(debits) != len(credits) {
+		return nil, WrapError(ErrInvalidOperation, "every debit operation must have a matching credit operation")
+	}
+
+	msgs := make([]sdk.Msg, len(debits))
+	for i, debit := range debits {
+		credit := credits[i]
+		if debit.Amount.Currency.Symbol != credit.Amount.Currency.Symbol ||
+			strings.TrimPrefix(debit.Amount.Value, "-") != credit.Amount.Value {
+			return nil, WrapError(ErrInvalidOperation, fmt.Sprintf("operations %d and %d do not move the same amount", debit.OperationIdentifier.Index, credit.OperationIdentifier.Index))
+		}
+
+		amount, ok := sdk.NewIntFromString(credit.Amount.Value)
+		if !ok {
+			return nil, WrapError(ErrInvalidOperation, fmt.Sprintf("invalid amount %s", credit.Amount.Value))
+		}
+
+		from, err := sdk.AccAddressFromBech32(debit.Account.Address)
+		if err != nil {
+			return nil, WrapError(ErrInvalidAddress, err.Error())
+		}
+		to, err := sdk.AccAddressFromBech32(credit.Account.Address)
+		if err != nil {
+			return nil, WrapError(ErrInvalidAddress, err.Error())
+		}
+
+		msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewCoin(credit.Amount.Currency.Symbol, amount)))
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, WrapError(ErrInvalidOperation, err.Error())
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// Tx converts transaction bytes into a Rosetta transaction. If the result of
+// the transaction execution is given, the operations are built from its events,
+// otherwise they are built from its messages.
+func (c converter) Tx(txBytes []byte, result *abci.ResponseDeliverTx) (*types.Transaction, error) {
+	tx, err := c.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	var ops []*types.Operation
+	if result == nil {
+		ops = c.MsgsToOperations(tx.GetMsgs(), "")
+	} else {
+		status := StatusSuccess
+		if result.Code != abci.CodeTypeOK {
+			status = StatusReverted
+		}
+
+		ops, err = c.EventsToOperations(result.Events, status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: fmt.Sprintf("%X", tmhash.Sum(txBytes))},
+		Operations:            ops,
+	}, nil
+}
+
+// BlockEventsTx builds the pseudo transaction holding the balance changes which
+// happened in BeginBlock or EndBlock.
+func (c converter) BlockEventsTx(hashPrefix byte, blockHash []byte, events []abci.Event) (*types.Transaction, error) {
+	ops, err := c.EventsToOperations(events, StatusSuccess)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: blockEventsTxHash(hashPrefix, blockHash)},
+		Operations:            ops,
+	}, nil
+}
+
+// blockEventsTxHash returns the hash of the BeginBlock or EndBlock pseudo
+// transaction of a block.
+func blockEventsTxHash(hashPrefix byte, blockHash []byte) string {
+	return fmt.Sprintf("%X", append([]byte{hashPrefix}, blockHash...))
+}
+
+// parseBlockEventsTxHash returns the hash prefix and the block hash of a
+// BeginBlock or EndBlock pseudo transaction hash. ok is false if the hash is
+// the one of a regular transaction.
+func parseBlockEventsTxHash(hash []byte) (hashPrefix byte, blockHash []byte, ok bool) {
+	if len(hash) != tmhash.Size+1 {
+		return 0, nil, false
+	}
+
+	return hash[0], hash[1:], true
+}
+
+// signers returns the unique signers of the given messages, in the order they
+// have to sign. The first one pays the fees.
+func signers(msgs []sdk.Msg) []*types.AccountIdentifier {
+	var accounts []*types.AccountIdentifier
+	seen := make(map[string]bool)
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			addr := signer.String()
+			if seen[addr] {
+				continue
+			}
+
+			seen[addr] = true
+			accounts = append(accounts, &types.AccountIdentifier{Address: addr})
+		}
+	}
+
+	return accounts
+}
+
+// PubKey converts a Rosetta public key into a secp256k1 public key.
+func (c converter) PubKey(pk *types.PublicKey) (cryptotypes.PubKey, error) {
+	if pk.CurveType != types.Secp256k1 {
+		return nil, ErrUnsupportedCurve
+	}
+	if len(pk.Bytes) != secp256k1.PubKeySize {
+		return nil, WrapError(ErrInvalidPubkey, fmt.Sprintf("expected a %d bytes compressed public key", secp256k1.PubKeySize))
+	}
+
+	return &secp256k1.PubKey{Key: pk.Bytes}, nil
+}
+
+// signerData holds the data required to build the sign bytes of a signer.
+type signerData struct {
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+}
+
+// UnsignedTx builds an unsigned transaction and returns it, hex encoded, along
+// with the payloads the signers have to sign. The signers, their public keys and
+// their data must be in the same order.
+func (c converter) UnsignedTx(
+	msgs []sdk.Msg, meta constructionMetadata, signers []*types.AccountIdentifier, pubKeys []cryptotypes.PubKey,
+) (string, []*types.SigningPayload, error) {
+	if len(signers) != len(pubKeys) || len(signers) != len(meta.SignersData) {
+		return "", nil, WrapError(ErrBadArgument, fmt.Sprintf("expected %d public keys and signer data, got %d and %d", len(signers), len(pubKeys), len(meta.SignersData)))
+	}
+
+	gasPrice, err := sdk.ParseDecCoins(meta.GasPrice)
+	if err != nil {
+		return "", nil, WrapError(ErrBadArgument, err.Error())
+	}
+
+	builder := c.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return "", nil, WrapError(ErrInvalidTransaction, err.Error())
+	}
+	builder.SetMemo(meta.Memo)
+	builder.SetGasLimit(meta.GasLimit)
+	builder.SetFeeAmount(fees(gasPrice, meta.GasLimit))
+
+	// the signer infos, holding the public keys and the sequences, are part of
+	// the sign bytes hence they must be set before computing them
+	sigs := make([]signingtypes.SignatureV2, len(signers))
+	for i, pubKey := range pubKeys {
+		addr, err := sdk.AccAddressFromBech32(signers[i].Address)
+		if err != nil {
+			return "", nil, WrapError(ErrInvalidAddress, err.Error())
+		}
+		if !addr.Equals(sdk.AccAddress(pubKey.Address())) {
+			return "", nil, WrapError(ErrInvalidPubkey, fmt.Sprintf("public key does not match signer %s", signers[i].Address))
+		}
+
+		sigs[i] = signingtypes.SignatureV2{
+			PubKey: pubKey,
+			Data: &signingtypes.SingleSignatureData{
+				SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT,
+			},
+			Sequence: meta.SignersData[i].Sequence,
+		}
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return "", nil, WrapError(ErrInvalidTransaction, err.Error())
+	}
+
+	payloads := make([]*types.SigningPayload, len(signers))
+	for i, signer := range signers {
+		signBytes, err := c.txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+			ChainID:       meta.ChainID,
+			AccountNumber: meta.SignersData[i].AccountNumber,
+			Sequence:      meta.SignersData[i].Sequence,
+		}, builder.GetTx())
+		if err != nil {
+			return "", nil, WrapError(ErrInvalidTransaction, err.Error())
+		}
+
+		payloads[i] = &types.SigningPayload{
+			AccountIdentifier: signer,
+			Bytes:             signBytes,
+			SignatureType:     types.Ecdsa,
+		}
+	}
+
+	txBytes, err := c.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", nil, WrapError(ErrCodec, err.Error())
+	}
+
+	return hex.EncodeToString(txBytes), payloads, nil
+}
+
+// SignedTx adds the given signatures to a hex encoded unsigned transaction built
+// by UnsignedTx and returns the hex encoded signed transaction.
+func (c converter) SignedTx(unsignedTx string, signatures []*types.Signature) (string, error) {
+	tx, err := c.decodeTx(unsignedTx)
+	if err != nil {
+		return "", err
+	}
+
+	builder, err := c.txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return "", WrapError(ErrInvalidTransaction, err.Error())
+	}
+
+	oldSigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return "", WrapError(ErrInvalidTransaction, err.Error())
+	}
+	if len(oldSigs) != len(signatures) {
+		return "", WrapError(ErrBadArgument, fmt.Sprintf("expected %d signatures, got %d", len(oldSigs), len(signatures)))
+	}
+
+	sigs := make([]signingtypes.SignatureV2, len(signatures))
+	for i, signature := range signatures {
+		if signature.PublicKey == nil {
+			return "", WrapError(ErrBadArgument, "signature public key must be set")
+		}
+
+		pubKey, err := c.PubKey(signature.PublicKey)
+		if err != nil {
+			return "", err
+		}
+		if !pubKey.Equals(oldSigs[i].PubKey) {
+			return "", WrapError(ErrBadArgument, fmt.Sprintf("signature %d does not match the signer public key", i))
+		}
+
+		sigs[i] = signingtypes.SignatureV2{
+			PubKey: pubKey,
+			Data: &signingtypes.SingleSignatureData{
+				SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
+				Signature: signature.Bytes,
+			},
+			Sequence: oldSigs[i].Sequence,
+		}
+	}
+
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return "", WrapError(ErrInvalidTransaction, err.Error())
+	}
+
+	txBytes, err := c.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", WrapError(ErrCodec, err.Error())
+	}
+
+	return hex.EncodeToString(txBytes), nil
+}
+
+// ParseTx returns the operations of a hex encoded transaction, and its signers
+// if it is signed.
+func (c converter) ParseTx(txHex string, signed bool) ([]*types.Operation, []*types.AccountIdentifier, error) {
+	tx, err := c.decodeTx(txHex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ops := c.MsgsToOperations(tx.GetMsgs(), "")
+	if !signed {
+		return ops, nil, nil
+	}
+
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, nil, WrapError(ErrInvalidTransaction, err.Error())
+	}
+
+	signers := make([]*types.AccountIdentifier, len(sigs))
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		if !ok || len(data.Signature) == 0 {
+			return nil, nil, WrapError(ErrInvalidTransaction, "transaction is not signed")
+		}
+
+		signers[i] = &types.AccountIdentifier{Address: sdk.AccAddress(sig.PubKey.Address()).String()}
+	}
+
+	return ops, signers, nil
+}
+
+// TxHash returns the hash of a hex encoded transaction.
+func (c converter) TxHash(txHex string) (string, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return "", WrapError(ErrCodec, err.Error())
+	}
+
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes)), nil
+}
+
+// decodeTx decodes a hex encoded transaction.
+func (c converter) decodeTx(txHex string) (authsigning.Tx, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	tx, err := c.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, WrapError(ErrInvalidTransaction, fmt.Sprintf("expected a signing tx, got %T", tx))
+	}
+
+	return sigTx, nil
+}
+
+// constructionOptions is returned by /construction/preprocess and passed to
+// /construction/metadata.
+type constructionOptions struct {
+	Signers  []string `json:"signers"`
+	GasLimit uint64   `json:"gas_limit"`
+	GasPrice string   `json:"gas_price"`
+	Memo     string   `json:"memo"`
+}
+
+// constructionMetadata is returned by /construction/metadata and passed to
+// /construction/payloads.
+type constructionMetadata struct {
+	ChainID     string        `json:"chain_id"`
+	SignersData []*signerData `json:"signers_data"`
+	GasLimit    uint64        `json:"gas_limit"`
+	GasPrice    string        `json:"gas_price"`
+	Memo        string        `json:"memo"`
+}
+
+// toMetadata converts v into a Rosetta metadata map.
+func toMetadata(v interface{}) (map[string]interface{}, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(bz, &metadata); err != nil {
+		return nil, WrapError(ErrCodec, err.Error())
+	}
+
+	return metadata, nil
+}
+
+// fromMetadata fills v from a Rosetta metadata map.
+func fromMetadata(metadata map[string]interface{}, v interface{}) error {
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return WrapError(ErrCodec, err.Error())
+	}
+
+	if err := json.Unmarshal(bz, v); err != nil {
+		return WrapError(ErrBadArgument, err.Error())
+	}
+
+	return nil
+}
+
+// fees returns the fees paid by a transaction with the given gas price and
+// gas limit, rounded up.
+func fees(gasPrice sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	gas := sdk.NewDec(int64(gasLimit))
+	var fees sdk.Coins
+	for _, price := range gasPrice {
+		fees = fees.Add(sdk.NewCoin(price.Denom, price.Amount.Mul(gas).Ceil().RoundInt()))
+	}
+
+	return fees
+}
+
+// newOperation returns a transfer operation moving the given amount.
+func newOperation(index int64, status string, address string, amount sdk.Int, denom string) *types.Operation {
+	op := &types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{Index: index},
+		Type:                OperationTransfer,
+		Account:             &types.AccountIdentifier{Address: address},
+		Amount: &types.Amount{
+			Value:    amount.String(),
+			Currency: &types.Currency{Symbol: denom},
+		},
+	}
+	if status != "" {
+		op.Status = &status
+	}
+
+	return op
+}
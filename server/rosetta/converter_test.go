@@ -0,0 +1,217 @@
+package rosetta
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func newTestConverter() converter {
+	ir := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(ir)
+	banktypes.RegisterInterfaces(ir)
+
+	return newConverter(&Config{InterfaceRegistry: ir})
+}
+
+func TestEventsToOperations(t *testing.T) {
+	c := newTestConverter()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	events := []abci.Event{
+		{
+			Type: banktypes.EventTypeTransfer,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(banktypes.AttributeKeyRecipient), Value: []byte(recipient.String())},
+				{Key: []byte(banktypes.AttributeKeySender), Value: []byte(sender.String())},
+				{Key: []byte(sdk.AttributeKeyAmount), Value: []byte("5bar,10foo")},
+			},
+		},
+		{
+			Type: sdk.EventTypeMessage,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(banktypes.AttributeKeySender), Value: []byte(sender.String())},
+			},
+		},
+		{
+			// multi-send outputs have no sender
+			Type: banktypes.EventTypeTransfer,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(banktypes.AttributeKeyRecipient), Value: []byte(recipient.String())},
+				{Key: []byte(sdk.AttributeKeyAmount), Value: []byte("3foo")},
+			},
+		},
+	}
+
+	ops, err := c.EventsToOperations(events, StatusSuccess)
+	require.NoError(t, err)
+	require.Len(t, ops, 5)
+
+	expected := []struct {
+		address string
+		value   string
+		denom   string
+	}{
+		{sender.String(), "-5", "bar"},
+		{recipient.String(), "5", "bar"},
+		{sender.String(), "-10", "foo"},
+		{recipient.String(), "10", "foo"},
+		{recipient.String(), "3", "foo"},
+	}
+	for i, op := range ops {
+		require.Equal(t, int64(i), op.OperationIdentifier.Index)
+		require.Equal(t, OperationTransfer, op.Type)
+		require.Equal(t, StatusSuccess, *op.Status)
+		require.Equal(t, expected[i].address, op.Account.Address)
+		require.Equal(t, expected[i].value, op.Amount.Value)
+		require.Equal(t, expected[i].denom, op.Amount.Currency.Symbol)
+	}
+
+	_, err = c.EventsToOperations([]abci.Event{{
+		Type: banktypes.EventTypeTransfer,
+		Attributes: []abci.EventAttribute{
+			{Key: []byte(sdk.AttributeKeyAmount), Value: []byte("invalid")},
+		},
+	}}, StatusSuccess)
+	require.Error(t, err)
+}
+
+func TestOperationsToMsgs(t *testing.T) {
+	c := newTestConverter()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 10))),
+		banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("bar", 5))),
+	}
+
+	ops := c.MsgsToOperations(msgs, "")
+	require.Len(t, ops, 4)
+	for _, op := range ops {
+		require.Nil(t, op.Status)
+	}
+
+	res, err := c.OperationsToMsgs(ops)
+	require.NoError(t, err)
+	require.Equal(t, msgs, res)
+
+	transfer := func(addr sdk.AccAddress, value, denom string) *types.Operation {
+		return &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{},
+			Type:                OperationTransfer,
+			Account:             &types.AccountIdentifier{Address: addr.String()},
+			Amount:              &types.Amount{Value: value, Currency: &types.Currency{Symbol: denom}},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		ops  []*types.Operation
+	}{
+		{"no operations", nil},
+		{"missing credit", []*types.Operation{transfer(addr1, "-10", "foo")}},
+		{"different amounts", []*types.Operation{transfer(addr1, "-10", "foo"), transfer(addr2, "9", "foo")}},
+		{"different denoms", []*types.Operation{transfer(addr1, "-10", "foo"), transfer(addr2, "10", "bar")}},
+		{"zero amount", []*types.Operation{transfer(addr1, "-0", "foo"), transfer(addr2, "0", "foo")}},
+		{"invalid address", []*types.Operation{transfer(addr1, "-10", "foo"), {
+			OperationIdentifier: &types.OperationIdentifier{},
+			Type:                OperationTransfer,
+			Account:             &types.AccountIdentifier{Address: "invalid"},
+			Amount:              &types.Amount{Value: "10", Currency: &types.Currency{Symbol: "foo"}},
+		}}},
+		{"unsupported type", []*types.Operation{{
+			OperationIdentifier: &types.OperationIdentifier{},
+			Type:                "delegate",
+		}}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := c.OperationsToMsgs(tc.ops)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestOfflineConstruction(t *testing.T) {
+	c := newTestConverter()
+
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(info.GetAddress(), recipient, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))}
+	meta := constructionMetadata{
+		ChainID:     "test-chain",
+		SignersData: []*signerData{{AccountNumber: 1, Sequence: 2}},
+		GasLimit:    100000,
+		GasPrice:    "0.025foo",
+		Memo:        "memo",
+	}
+	signers := signers(msgs)
+	require.Equal(t, []*types.AccountIdentifier{{Address: info.GetAddress().String()}}, signers)
+
+	unsignedTx, payloads, err := c.UnsignedTx(msgs, meta, signers, []cryptotypes.PubKey{info.GetPubKey()})
+	require.NoError(t, err)
+	require.Len(t, payloads, 1)
+	require.Equal(t, types.Ecdsa, payloads[0].SignatureType)
+
+	// the public keys must match the signers
+	_, _, err = c.UnsignedTx(msgs, meta, signers, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()})
+	require.Error(t, err)
+
+	ops, parsedSigners, err := c.ParseTx(unsignedTx, false)
+	require.NoError(t, err)
+	require.Equal(t, c.MsgsToOperations(msgs, ""), ops)
+	require.Empty(t, parsedSigners)
+
+	// an unsigned transaction can't be parsed as a signed one
+	_, _, err = c.ParseTx(unsignedTx, true)
+	require.Error(t, err)
+
+	signatures, err := SignPayloads(kr, payloads)
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+	require.True(t, info.GetPubKey().VerifySignature(payloads[0].Bytes, signatures[0].Bytes))
+
+	signedTx, err := c.SignedTx(unsignedTx, signatures)
+	require.NoError(t, err)
+
+	ops, parsedSigners, err = c.ParseTx(signedTx, true)
+	require.NoError(t, err)
+	require.Equal(t, c.MsgsToOperations(msgs, ""), ops)
+	require.Equal(t, signers, parsedSigners)
+
+	tx, err := c.decodeTx(signedTx)
+	require.NoError(t, err)
+	require.Equal(t, "memo", tx.GetMemo())
+	require.Equal(t, uint64(100000), tx.GetGas())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 2500)), tx.GetFee())
+
+	hash, err := c.TxHash(signedTx)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	// signatures must be made by the keys of the signers
+	otherKr := keyring.NewInMemory()
+	_, _, err = otherKr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = SignPayloads(otherKr, payloads)
+	require.Error(t, err)
+}
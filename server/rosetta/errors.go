@@ -0,0 +1,122 @@
+package rosetta
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error defines a Rosetta API error which can be used as a go error.
+type Error struct {
+	rosErr *types.Error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.rosErr.Description != nil {
+		return fmt.Sprintf("rosetta: (%d) %s: %s", e.rosErr.Code, e.rosErr.Message, *e.rosErr.Description)
+	}
+
+	return fmt.Sprintf("rosetta: (%d) %s", e.rosErr.Code, e.rosErr.Message)
+}
+
+// Is implements errors.Is, two errors are equal if their codes are equal.
+func (e *Error) Is(err error) bool {
+	var rosErr *Error
+	if !errors.As(err, &rosErr) {
+		return false
+	}
+
+	return e.rosErr.Code == rosErr.rosErr.Code
+}
+
+// RosettaError returns the Rosetta API representation of the error.
+func (e *Error) RosettaError() *types.Error {
+	return e.rosErr
+}
+
+// WrapError returns a copy of the given error with the given description.
+func WrapError(err *Error, description string) *Error {
+	rosErr := *err.rosErr
+	rosErr.Description = &description
+
+	return &Error{rosErr: &rosErr}
+}
+
+// ToRosettaError converts an error into a Rosetta API error. Errors which are
+// not Rosetta errors are converted to ErrUnknown, or to the closest Rosetta
+// error if they are gRPC status errors.
+func ToRosettaError(err error) *types.Error {
+	var rosErr *Error
+	if errors.As(err, &rosErr) {
+		return rosErr.rosErr
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		switch grpcStatus.Code() {
+		case codes.NotFound:
+			return WrapError(ErrNotFound, grpcStatus.Message()).rosErr
+		case codes.InvalidArgument:
+			return WrapError(ErrBadArgument, grpcStatus.Message()).rosErr
+		case codes.Unavailable:
+			return WrapError(ErrBadGateway, grpcStatus.Message()).rosErr
+		}
+	}
+
+	return WrapError(ErrUnknown, err.Error()).rosErr
+}
+
+// registeredErrors holds all the errors the Rosetta API can return, they are
+// listed in the /network/options response.
+var registeredErrors []*types.Error
+
+func registerError(code int32, message string, retriable bool) *Error {
+	rosErr := &types.Error{
+		Code:      code,
+		Message:   message,
+		Retriable: retriable,
+	}
+	registeredErrors = append(registeredErrors, rosErr)
+
+	return &Error{rosErr: rosErr}
+}
+
+// Rosetta API errors
+var (
+	// ErrUnknown defines an unknown error.
+	ErrUnknown = registerError(0, "unknown", false)
+	// ErrOffline is returned by the endpoints which require a node connection
+	// when the server runs in offline mode.
+	ErrOffline = registerError(1, "cannot query endpoint in offline mode", false)
+	// ErrNetworkNotSupported is returned when a request targets a network which
+	// is not the one of the server.
+	ErrNetworkNotSupported = registerError(2, "network is not supported", false)
+	// ErrCodec is returned when an encoding or decoding operation fails.
+	ErrCodec = registerError(3, "encode/decode error", true)
+	// ErrInvalidOperation is returned when an operation is not valid.
+	ErrInvalidOperation = registerError(4, "invalid operation", false)
+	// ErrInvalidTransaction is returned when a transaction is not valid.
+	ErrInvalidTransaction = registerError(5, "invalid transaction", false)
+	// ErrInvalidAddress is returned when an address is not valid.
+	ErrInvalidAddress = registerError(6, "invalid address", false)
+	// ErrInvalidPubkey is returned when a public key is not valid.
+	ErrInvalidPubkey = registerError(7, "invalid pubkey", false)
+	// ErrUnsupportedCurve is returned when a public key uses a curve which is
+	// not supported.
+	ErrUnsupportedCurve = registerError(8, "unsupported curve, expected secp256k1", false)
+	// ErrBadArgument is returned when a request argument is not valid.
+	ErrBadArgument = registerError(9, "bad argument", false)
+	// ErrNotFound is returned when the requested resource does not exist.
+	ErrNotFound = registerError(10, "not found", false)
+	// ErrBadGateway is returned when the node cannot be reached.
+	ErrBadGateway = registerError(11, "bad gateway", true)
+	// ErrNotImplemented is returned by the endpoints which are not supported.
+	ErrNotImplemented = registerError(12, "not implemented", false)
+	// ErrInvalidRequest is returned when a request is not valid.
+	ErrInvalidRequest = registerError(13, "invalid request", false)
+	// ErrTxBroadcast is returned when a transaction is rejected by the node.
+	ErrTxBroadcast = registerError(14, "transaction broadcast failed", false)
+)
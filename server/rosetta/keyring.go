@@ -0,0 +1,56 @@
+package rosetta
+
+import (
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignPayloads signs the payloads returned by /construction/payloads with the
+// keys of the keyring, the key signing a payload is looked up by the payload
+// account address. The returned signatures can be given as they are to
+// /construction/combine, which allows to construct transactions offline with
+// the keys of the keyring.
+func SignPayloads(kr keyring.Keyring, payloads []*types.SigningPayload) ([]*types.Signature, error) {
+	signatures := make([]*types.Signature, len(payloads))
+	for i, payload := range payloads {
+		if payload.SignatureType != "" && payload.SignatureType != types.Ecdsa {
+			return nil, fmt.Errorf("unsupported signature type %s", payload.SignatureType)
+		}
+
+		if payload.AccountIdentifier == nil {
+			return nil, fmt.Errorf("payload %d has no account identifier", i)
+		}
+
+		address := payload.AccountIdentifier.Address
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, pubKey, err := kr.SignByAddress(addr, payload.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+			return nil, fmt.Errorf("key of %s is not a secp256k1 key", address)
+		}
+
+		signatures[i] = &types.Signature{
+			SigningPayload: payload,
+			PublicKey: &types.PublicKey{
+				Bytes:     pubKey.Bytes(),
+				CurveType: types.Secp256k1,
+			},
+			SignatureType: types.Ecdsa,
+			Bytes:         sig,
+		}
+	}
+
+	return signatures, nil
+}
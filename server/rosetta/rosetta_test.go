@@ -0,0 +1,296 @@
+package rosetta_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+
+	server        *httptest.Server
+	offlineServer *httptest.Server
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	srv, err := rosetta.NewServer(&rosetta.Config{
+		Network:           cfg.ChainID,
+		TendermintRPC:     val.RPCAddress,
+		GRPCEndpoint:      val.AppConfig.GRPC.Address,
+		InterfaceRegistry: cfg.InterfaceRegistry,
+	}, log.NewNopLogger())
+	s.Require().NoError(err)
+	s.server = httptest.NewServer(srv)
+
+	offlineSrv, err := rosetta.NewServer(&rosetta.Config{
+		Network:           cfg.ChainID,
+		Offline:           true,
+		InterfaceRegistry: cfg.InterfaceRegistry,
+	}, log.NewNopLogger())
+	s.Require().NoError(err)
+	s.offlineServer = httptest.NewServer(offlineSrv)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.server.Close()
+	s.offlineServer.Close()
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) networkIdentifier() *types.NetworkIdentifier {
+	return &types.NetworkIdentifier{Blockchain: rosetta.DefaultBlockchain, Network: s.cfg.ChainID}
+}
+
+// post sends req to the given endpoint of srv and decodes the response into
+// res, or returns the Rosetta error of the response.
+func (s *IntegrationTestSuite) post(srv *httptest.Server, endpoint string, req, res interface{}) *types.Error {
+	bz, err := json.Marshal(req)
+	s.Require().NoError(err)
+
+	httpRes, err := http.Post(srv.URL+endpoint, "application/json", bytes.NewReader(bz))
+	s.Require().NoError(err)
+	defer httpRes.Body.Close()
+
+	bz, err = ioutil.ReadAll(httpRes.Body)
+	s.Require().NoError(err)
+
+	if httpRes.StatusCode != http.StatusOK {
+		var rosErr types.Error
+		s.Require().NoError(json.Unmarshal(bz, &rosErr), string(bz))
+		return &rosErr
+	}
+
+	s.Require().NoError(json.Unmarshal(bz, res))
+	return nil
+}
+
+func (s *IntegrationTestSuite) TestNetwork() {
+	var list types.NetworkListResponse
+	s.Require().Nil(s.post(s.server, "/network/list", types.MetadataRequest{}, &list))
+	s.Require().Equal([]*types.NetworkIdentifier{s.networkIdentifier()}, list.NetworkIdentifiers)
+
+	var options types.NetworkOptionsResponse
+	s.Require().Nil(s.post(s.server, "/network/options", types.NetworkRequest{NetworkIdentifier: s.networkIdentifier()}, &options))
+	s.Require().Equal([]string{rosetta.OperationTransfer}, options.Allow.OperationTypes)
+	s.Require().True(options.Allow.HistoricalBalanceLookup)
+
+	var status types.NetworkStatusResponse
+	s.Require().Nil(s.post(s.server, "/network/status", types.NetworkRequest{NetworkIdentifier: s.networkIdentifier()}, &status))
+	s.Require().GreaterOrEqual(status.CurrentBlockIdentifier.Index, int64(1))
+	s.Require().Equal(int64(1), status.GenesisBlockIdentifier.Index)
+	s.Require().True(*status.SyncStatus.Synced)
+
+	// requests to another network are rejected
+	rosErr := s.post(s.server, "/network/status", types.NetworkRequest{
+		NetworkIdentifier: &types.NetworkIdentifier{Blockchain: rosetta.DefaultBlockchain, Network: "other"},
+	}, &status)
+	s.Require().NotNil(rosErr)
+}
+
+func (s *IntegrationTestSuite) TestBlock() {
+	var res types.BlockResponse
+	index := int64(1)
+	s.Require().Nil(s.post(s.server, "/block", types.BlockRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &index},
+	}, &res))
+	s.Require().Equal(index, res.Block.BlockIdentifier.Index)
+	// the first block is its own parent
+	s.Require().Equal(res.Block.BlockIdentifier, res.Block.ParentBlockIdentifier)
+
+	var byHash types.BlockResponse
+	s.Require().Nil(s.post(s.server, "/block", types.BlockRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		BlockIdentifier:   &types.PartialBlockIdentifier{Hash: &res.Block.BlockIdentifier.Hash},
+	}, &byHash))
+	s.Require().Equal(res.Block, byHash.Block)
+}
+
+func (s *IntegrationTestSuite) TestOffline() {
+	var status types.NetworkStatusResponse
+	rosErr := s.post(s.offlineServer, "/network/status", types.NetworkRequest{NetworkIdentifier: s.networkIdentifier()}, &status)
+	s.Require().NotNil(rosErr)
+	s.Require().Equal(rosetta.ErrOffline.RosettaError().Code, rosErr.Code)
+
+	val := s.network.Validators[0]
+	info, err := val.ClientCtx.Keyring.Key(val.Moniker)
+	s.Require().NoError(err)
+
+	var derive types.ConstructionDeriveResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/derive", types.ConstructionDeriveRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		PublicKey:         &types.PublicKey{Bytes: info.GetPubKey().Bytes(), CurveType: types.Secp256k1},
+	}, &derive))
+	s.Require().Equal(val.Address.String(), derive.AccountIdentifier.Address)
+}
+
+func (s *IntegrationTestSuite) TestConstruction() {
+	val := s.network.Validators[0]
+	info, err := val.ClientCtx.Keyring.Key(val.Moniker)
+	s.Require().NoError(err)
+
+	kr := keyring.NewInMemory()
+	recipient, _, err := kr.NewMnemonic("recipient", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+	currency := &types.Currency{Symbol: denom}
+	ops := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                rosetta.OperationTransfer,
+			Account:             &types.AccountIdentifier{Address: val.Address.String()},
+			Amount:              &types.Amount{Value: "-10", Currency: currency},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			Type:                rosetta.OperationTransfer,
+			Account:             &types.AccountIdentifier{Address: recipient.GetAddress().String()},
+			Amount:              &types.Amount{Value: "10", Currency: currency},
+		},
+	}
+
+	// the construction endpoints which do not require a node connection are
+	// served by the offline server
+	var preprocess types.ConstructionPreprocessResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/preprocess", types.ConstructionPreprocessRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		Operations:        ops,
+		Metadata:          map[string]interface{}{"gas_price": "0.01stake", "memo": "rosetta"},
+	}, &preprocess))
+	s.Require().Equal([]*types.AccountIdentifier{{Address: val.Address.String()}}, preprocess.RequiredPublicKeys)
+
+	var metadata types.ConstructionMetadataResponse
+	s.Require().Nil(s.post(s.server, "/construction/metadata", types.ConstructionMetadataRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		Options:           preprocess.Options,
+	}, &metadata))
+	s.Require().Equal([]*types.Amount{{Value: "2000", Currency: &types.Currency{Symbol: "stake"}}}, metadata.SuggestedFee)
+
+	var payloads types.ConstructionPayloadsResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/payloads", types.ConstructionPayloadsRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		Operations:        ops,
+		Metadata:          metadata.Metadata,
+		PublicKeys:        []*types.PublicKey{{Bytes: info.GetPubKey().Bytes(), CurveType: types.Secp256k1}},
+	}, &payloads))
+	s.Require().Len(payloads.Payloads, 1)
+
+	signatures, err := rosetta.SignPayloads(val.ClientCtx.Keyring, payloads.Payloads)
+	s.Require().NoError(err)
+
+	var combine types.ConstructionCombineResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/combine", types.ConstructionCombineRequest{
+		NetworkIdentifier:   s.networkIdentifier(),
+		UnsignedTransaction: payloads.UnsignedTransaction,
+		Signatures:          signatures,
+	}, &combine))
+
+	var parse types.ConstructionParseResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/parse", types.ConstructionParseRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		Signed:            true,
+		Transaction:       combine.SignedTransaction,
+	}, &parse))
+	s.Require().Equal(ops, parse.Operations)
+	s.Require().Equal([]*types.AccountIdentifier{{Address: val.Address.String()}}, parse.AccountIdentifierSigners)
+
+	var hash types.TransactionIdentifierResponse
+	s.Require().Nil(s.post(s.offlineServer, "/construction/hash", types.ConstructionHashRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		SignedTransaction: combine.SignedTransaction,
+	}, &hash))
+
+	var submit types.TransactionIdentifierResponse
+	s.Require().Nil(s.post(s.server, "/construction/submit", types.ConstructionSubmitRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		SignedTransaction: combine.SignedTransaction,
+	}, &submit))
+	s.Require().Equal(hash.TransactionIdentifier, submit.TransactionIdentifier)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	hashBytes, err := hex.DecodeString(submit.TransactionIdentifier.Hash)
+	s.Require().NoError(err)
+	txRes, err := val.RPCClient.Tx(context.Background(), hashBytes, false)
+	s.Require().NoError(err)
+	s.Require().Zero(txRes.TxResult.Code, txRes.TxResult.Log)
+
+	// the transaction operations are built from its events: the fee payment
+	// followed by the transfer
+	var block types.BlockResponse
+	s.Require().Nil(s.post(s.server, "/block", types.BlockRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &txRes.Height},
+	}, &block))
+
+	var tx types.BlockTransactionResponse
+	s.Require().Nil(s.post(s.server, "/block/transaction", types.BlockTransactionRequest{
+		NetworkIdentifier:     s.networkIdentifier(),
+		BlockIdentifier:       block.Block.BlockIdentifier,
+		TransactionIdentifier: submit.TransactionIdentifier,
+	}, &tx))
+	s.Require().Contains(block.Block.Transactions, tx.Transaction)
+	s.Require().Len(tx.Transaction.Operations, 4)
+	s.Require().Equal("-2000", tx.Transaction.Operations[0].Amount.Value)
+	s.Require().Equal(val.Address.String(), tx.Transaction.Operations[0].Account.Address)
+	s.Require().Equal("-10", tx.Transaction.Operations[2].Amount.Value)
+	s.Require().Equal(recipient.GetAddress().String(), tx.Transaction.Operations[3].Account.Address)
+	s.Require().Equal("10", tx.Transaction.Operations[3].Amount.Value)
+	s.Require().Equal(rosetta.StatusSuccess, *tx.Transaction.Operations[3].Status)
+
+	var balance types.AccountBalanceResponse
+	s.Require().Nil(s.post(s.server, "/account/balance", types.AccountBalanceRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		AccountIdentifier: &types.AccountIdentifier{Address: recipient.GetAddress().String()},
+	}, &balance))
+	s.Require().Equal([]*types.Amount{{Value: "10", Currency: currency}}, balance.Balances)
+
+	// the balance before the transaction was included is empty
+	before := txRes.Height - 1
+	s.Require().Nil(s.post(s.server, "/account/balance", types.AccountBalanceRequest{
+		NetworkIdentifier: s.networkIdentifier(),
+		AccountIdentifier: &types.AccountIdentifier{Address: recipient.GetAddress().String()},
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &before},
+	}, &balance))
+	s.Require().Empty(balance.Balances)
+	s.Require().Equal(before, balance.BlockIdentifier.Index)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,104 @@
+package rosetta
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coinbase/rosetta-sdk-go/asserter"
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Server defines the Rosetta API server of an application.
+type Server struct {
+	h      http.Handler
+	addr   string
+	client *Client
+	logger log.Logger
+}
+
+// NewServer returns a new Server for the given configuration. In online mode it
+// connects to the node, retrying up to the configured number of retries.
+func NewServer(cfg *Config, logger log.Logger) (*Server, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	var client *Client
+	if !cfg.Offline {
+		var err error
+		client, err = NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := bootstrap(client, cfg.Retries, logger); err != nil {
+			return nil, err
+		}
+	}
+
+	svc := service{
+		config:    cfg,
+		client:    client,
+		converter: newConverter(cfg),
+	}
+
+	asrt, err := asserter.NewServer(
+		[]string{OperationTransfer},
+		true,
+		[]*types.NetworkIdentifier{cfg.NetworkIdentifier()},
+		nil,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	router := server.NewRouter(
+		server.NewNetworkAPIController(svc, asrt),
+		server.NewAccountAPIController(svc, asrt),
+		server.NewBlockAPIController(svc, asrt),
+		server.NewMempoolAPIController(svc, asrt),
+		server.NewConstructionAPIController(svc, asrt),
+	)
+
+	return &Server{
+		h:      server.CorsMiddleware(router),
+		addr:   cfg.Addr,
+		client: client,
+		logger: logger,
+	}, nil
+}
+
+// bootstrap connects the client to the node and waits until the node can be
+// reached.
+func bootstrap(client *Client, retries int, logger log.Logger) error {
+	if err := client.Bootstrap(); err != nil {
+		return err
+	}
+
+	var err error
+	for i := 0; i < retries; i++ {
+		if err = client.Ready(); err == nil {
+			return nil
+		}
+
+		logger.Error("node is not ready, retrying", "attempt", i+1, "err", err)
+		time.Sleep(retryWait)
+	}
+
+	return fmt.Errorf("failed to reach the node after %d attempts: %w", retries, err)
+}
+
+// Start starts the Rosetta API server, it blocks until the server fails.
+func (s *Server) Start() error {
+	s.logger.Info("starting rosetta server", "address", s.addr)
+	return http.ListenAndServe(s.addr, s.h)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.h.ServeHTTP(w, r)
+}
@@ -0,0 +1,400 @@
+package rosetta
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var (
+	_ server.NetworkAPIServicer      = service{}
+	_ server.AccountAPIServicer      = service{}
+	_ server.BlockAPIServicer        = service{}
+	_ server.MempoolAPIServicer      = service{}
+	_ server.ConstructionAPIServicer = service{}
+)
+
+// service implements the Rosetta API servicers. In offline mode client is nil
+// and only the construction endpoints which do not require a node connection
+// are available.
+type service struct {
+	config    *Config
+	client    *Client
+	converter converter
+}
+
+func (s service) online() *types.Error {
+	if s.client == nil {
+		return ErrOffline.RosettaError()
+	}
+
+	return nil
+}
+
+// NetworkList implements the /network/list endpoint
+func (s service) NetworkList(context.Context, *types.MetadataRequest) (*types.NetworkListResponse, *types.Error) {
+	return &types.NetworkListResponse{
+		NetworkIdentifiers: []*types.NetworkIdentifier{s.config.NetworkIdentifier()},
+	}, nil
+}
+
+// NetworkOptions implements the /network/options endpoint
+func (s service) NetworkOptions(ctx context.Context, _ *types.NetworkRequest) (*types.NetworkOptionsResponse, *types.Error) {
+	nodeVersion := version.Version
+	if s.client != nil {
+		v, err := s.client.NodeVersion(ctx)
+		if err != nil {
+			return nil, ToRosettaError(err)
+		}
+		nodeVersion = v
+	}
+
+	return &types.NetworkOptionsResponse{
+		Version: &types.Version{
+			RosettaVersion:    types.RosettaAPIVersion,
+			NodeVersion:       nodeVersion,
+			MiddlewareVersion: &version.Version,
+		},
+		Allow: &types.Allow{
+			OperationStatuses: []*types.OperationStatus{
+				{Status: StatusSuccess, Successful: true},
+				{Status: StatusReverted, Successful: false},
+			},
+			OperationTypes:          []string{OperationTransfer},
+			Errors:                  registeredErrors,
+			HistoricalBalanceLookup: true,
+		},
+	}, nil
+}
+
+// NetworkStatus implements the /network/status endpoint
+func (s service) NetworkStatus(ctx context.Context, _ *types.NetworkRequest) (*types.NetworkStatusResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return status, nil
+}
+
+// AccountBalance implements the /account/balance endpoint
+func (s service) AccountBalance(ctx context.Context, req *types.AccountBalanceRequest) (*types.AccountBalanceResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	block, err := s.partialBlock(ctx, req.BlockIdentifier)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	balances, err := s.client.Balances(ctx, req.AccountIdentifier.Address, block.BlockIdentifier.Index)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.AccountBalanceResponse{
+		BlockIdentifier: block.BlockIdentifier,
+		Balances:        balances,
+	}, nil
+}
+
+// AccountCoins implements the /account/coins endpoint, which is only relevant
+// to UTXO based blockchains and therefore not supported.
+func (s service) AccountCoins(context.Context, *types.AccountCoinsRequest) (*types.AccountCoinsResponse, *types.Error) {
+	return nil, ErrNotImplemented.RosettaError()
+}
+
+// Block implements the /block endpoint
+func (s service) Block(ctx context.Context, req *types.BlockRequest) (*types.BlockResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	block, err := s.partialBlock(ctx, req.BlockIdentifier)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.BlockResponse{Block: block}, nil
+}
+
+// partialBlock returns the block matching the given identifier, or the latest
+// block if no identifier field is set.
+func (s service) partialBlock(ctx context.Context, identifier *types.PartialBlockIdentifier) (*types.Block, error) {
+	switch {
+	case identifier == nil || (identifier.Hash == nil && identifier.Index == nil):
+		return s.client.BlockByHeight(ctx, nil)
+	case identifier.Hash != nil:
+		block, err := s.client.BlockByHash(ctx, *identifier.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if identifier.Index != nil && *identifier.Index != block.BlockIdentifier.Index {
+			return nil, WrapError(ErrBadArgument, fmt.Sprintf("block %s is not at height %d", *identifier.Hash, *identifier.Index))
+		}
+
+		return block, nil
+	default:
+		return s.client.BlockByHeight(ctx, identifier.Index)
+	}
+}
+
+// BlockTransaction implements the /block/transaction endpoint
+func (s service) BlockTransaction(ctx context.Context, req *types.BlockTransactionRequest) (*types.BlockTransactionResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.BlockTransaction(ctx, req.TransactionIdentifier.Hash)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.BlockTransactionResponse{Transaction: tx}, nil
+}
+
+// Mempool implements the /mempool endpoint
+func (s service) Mempool(ctx context.Context, _ *types.NetworkRequest) (*types.MempoolResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	identifiers, err := s.client.Mempool(ctx)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.MempoolResponse{TransactionIdentifiers: identifiers}, nil
+}
+
+// MempoolTransaction implements the /mempool/transaction endpoint
+func (s service) MempoolTransaction(ctx context.Context, req *types.MempoolTransactionRequest) (*types.MempoolTransactionResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.MempoolTransaction(ctx, req.TransactionIdentifier.Hash)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.MempoolTransactionResponse{Transaction: tx}, nil
+}
+
+// ConstructionDerive implements the /construction/derive endpoint
+func (s service) ConstructionDerive(_ context.Context, req *types.ConstructionDeriveRequest) (*types.ConstructionDeriveResponse, *types.Error) {
+	pubKey, err := s.converter.PubKey(req.PublicKey)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionDeriveResponse{
+		AccountIdentifier: &types.AccountIdentifier{Address: sdk.AccAddress(pubKey.Address()).String()},
+	}, nil
+}
+
+// ConstructionPreprocess implements the /construction/preprocess endpoint. The
+// gas limit, gas price and memo of the transaction can be set through the
+// request metadata fields of the same names.
+func (s service) ConstructionPreprocess(_ context.Context, req *types.ConstructionPreprocessRequest) (*types.ConstructionPreprocessResponse, *types.Error) {
+	msgs, err := s.converter.OperationsToMsgs(req.Operations)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	options := constructionOptions{GasLimit: flags.DefaultGasLimit}
+	if err := fromMetadata(req.Metadata, &options); err != nil {
+		return nil, ToRosettaError(err)
+	}
+	if _, err := sdk.ParseDecCoins(options.GasPrice); err != nil {
+		return nil, ToRosettaError(WrapError(ErrBadArgument, fmt.Sprintf("invalid gas price: %s", err)))
+	}
+
+	required := signers(msgs)
+	for _, signer := range required {
+		options.Signers = append(options.Signers, signer.Address)
+	}
+
+	metadata, err := toMetadata(options)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionPreprocessResponse{
+		Options:            metadata,
+		RequiredPublicKeys: required,
+	}, nil
+}
+
+// ConstructionMetadata implements the /construction/metadata endpoint
+func (s service) ConstructionMetadata(ctx context.Context, req *types.ConstructionMetadataRequest) (*types.ConstructionMetadataResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	var options constructionOptions
+	if err := fromMetadata(req.Options, &options); err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	chainID, err := s.client.ChainID(ctx)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	meta := constructionMetadata{
+		ChainID:     chainID,
+		SignersData: make([]*signerData, len(options.Signers)),
+		GasLimit:    options.GasLimit,
+		GasPrice:    options.GasPrice,
+		Memo:        options.Memo,
+	}
+	for i, signer := range options.Signers {
+		data, err := s.client.SignerData(ctx, signer)
+		if err != nil {
+			return nil, ToRosettaError(err)
+		}
+		meta.SignersData[i] = data
+	}
+
+	gasPrice, err := sdk.ParseDecCoins(options.GasPrice)
+	if err != nil {
+		return nil, ToRosettaError(WrapError(ErrBadArgument, fmt.Sprintf("invalid gas price: %s", err)))
+	}
+
+	var suggestedFee []*types.Amount
+	for _, fee := range fees(gasPrice, options.GasLimit) {
+		suggestedFee = append(suggestedFee, &types.Amount{
+			Value:    fee.Amount.String(),
+			Currency: &types.Currency{Symbol: fee.Denom},
+		})
+	}
+
+	metadata, err := toMetadata(meta)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionMetadataResponse{
+		Metadata:     metadata,
+		SuggestedFee: suggestedFee,
+	}, nil
+}
+
+// ConstructionPayloads implements the /construction/payloads endpoint. The
+// payloads are the SIGN_MODE_DIRECT sign bytes of the signers, which, as for
+// any secp256k1 key of the SDK, are hashed with sha256 when signed.
+func (s service) ConstructionPayloads(_ context.Context, req *types.ConstructionPayloadsRequest) (*types.ConstructionPayloadsResponse, *types.Error) {
+	msgs, err := s.converter.OperationsToMsgs(req.Operations)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	var meta constructionMetadata
+	if err := fromMetadata(req.Metadata, &meta); err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	// the signers are in the order they were returned by preprocess, which is
+	// the order the signers data was queried in
+	accounts := signers(msgs)
+
+	// public keys are matched to their signer by address as their order is
+	// not guaranteed
+	pubKeysByAddr := make(map[string]cryptotypes.PubKey, len(req.PublicKeys))
+	for _, pk := range req.PublicKeys {
+		pubKey, err := s.converter.PubKey(pk)
+		if err != nil {
+			return nil, ToRosettaError(err)
+		}
+		pubKeysByAddr[sdk.AccAddress(pubKey.Address()).String()] = pubKey
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(accounts))
+	for i, signer := range accounts {
+		pubKey, ok := pubKeysByAddr[signer.Address]
+		if !ok {
+			return nil, ToRosettaError(WrapError(ErrBadArgument, fmt.Sprintf("missing public key of signer %s", signer.Address)))
+		}
+		pubKeys[i] = pubKey
+	}
+
+	unsignedTx, payloads, err := s.converter.UnsignedTx(msgs, meta, accounts, pubKeys)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionPayloadsResponse{
+		UnsignedTransaction: unsignedTx,
+		Payloads:            payloads,
+	}, nil
+}
+
+// ConstructionCombine implements the /construction/combine endpoint
+func (s service) ConstructionCombine(_ context.Context, req *types.ConstructionCombineRequest) (*types.ConstructionCombineResponse, *types.Error) {
+	signedTx, err := s.converter.SignedTx(req.UnsignedTransaction, req.Signatures)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionCombineResponse{SignedTransaction: signedTx}, nil
+}
+
+// ConstructionParse implements the /construction/parse endpoint
+func (s service) ConstructionParse(_ context.Context, req *types.ConstructionParseRequest) (*types.ConstructionParseResponse, *types.Error) {
+	ops, signers, err := s.converter.ParseTx(req.Transaction, req.Signed)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.ConstructionParseResponse{
+		Operations:               ops,
+		AccountIdentifierSigners: signers,
+	}, nil
+}
+
+// ConstructionHash implements the /construction/hash endpoint
+func (s service) ConstructionHash(_ context.Context, req *types.ConstructionHashRequest) (*types.TransactionIdentifierResponse, *types.Error) {
+	hash, err := s.converter.TxHash(req.SignedTransaction)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.TransactionIdentifierResponse{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: hash},
+	}, nil
+}
+
+// ConstructionSubmit implements the /construction/submit endpoint
+func (s service) ConstructionSubmit(ctx context.Context, req *types.ConstructionSubmitRequest) (*types.TransactionIdentifierResponse, *types.Error) {
+	if err := s.online(); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ToRosettaError(WrapError(ErrCodec, err.Error()))
+	}
+
+	hash, err := s.client.PostTx(ctx, txBytes)
+	if err != nil {
+		return nil, ToRosettaError(err)
+	}
+
+	return &types.TransactionIdentifierResponse{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: hash},
+	}, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
@@ -59,8 +60,10 @@ const (
 
 // State sync-related flags.
 const (
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval       = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent     = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotQueryCacheSize = "state-sync.snapshot-query-cache-size"
+	FlagStateSyncSnapshotQueryDir       = "state-sync.snapshot-query-dir"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -152,6 +155,8 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotQueryCacheSize, 0, "State sync snapshots restored to serve queries at pruned heights to keep (0 to disable)")
+	cmd.Flags().String(FlagStateSyncSnapshotQueryDir, "", "Directory to restore state sync snapshots serving queries in (in memory if empty)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -310,6 +315,39 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
+	if config.Rosetta.Enable {
+		if !config.Rosetta.Offline && !config.GRPC.Enable {
+			return fmt.Errorf("the rosetta server requires the gRPC server to be enabled when not offline")
+		}
+
+		rosettaSrv, err := rosetta.NewServer(&rosetta.Config{
+			Blockchain:        config.Rosetta.Blockchain,
+			Network:           config.Rosetta.Network,
+			TendermintRPC:     cfg.RPC.ListenAddress,
+			GRPCEndpoint:      config.GRPC.Address,
+			Addr:              config.Rosetta.Address,
+			Retries:           config.Rosetta.Retries,
+			Offline:           config.Rosetta.Offline,
+			InterfaceRegistry: clientCtx.InterfaceRegistry,
+		}, ctx.Logger.With("module", "rosetta-server"))
+		if err != nil {
+			return err
+		}
+
+		errCh := make(chan error)
+		go func() {
+			if err := rosettaSrv.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(5 * time.Second): // assume server started successfully
+		}
+	}
+
 	defer func() {
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
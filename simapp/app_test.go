@@ -10,6 +10,12 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestSimAppExport(t *testing.T) {
@@ -49,3 +55,39 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+// ensure that the ante handler of the app sets the priority of txs returned by CheckTx
+func TestCheckTxPriority(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	genAcc := authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000)),
+	}
+	app := SetupWithGenesisAccounts([]authtypes.GenesisAccount{genAcc}, balance)
+
+	txCfg := MakeTestEncodingConfig().TxConfig
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500000))
+	tx, err := helpers.GenTx(txCfg, []sdk.Msg{msg}, fee, 100000, "", []uint64{0}, []uint64{0}, priv)
+	require.NoError(t, err)
+	txBytes, err := txCfg.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	var priority string
+	for _, event := range res.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyPriority {
+				priority = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, "5", priority)
+}
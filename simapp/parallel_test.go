@@ -0,0 +1,118 @@
+package simapp
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestAppParallelDeliverTxsDeterminism delivers blocks of random bank sends,
+// many of them sharing senders and recipients, over a randomized simulation
+// genesis, with and without parallel tx workers, and checks that the tx
+// responses and the app hashes of both apps are the same.
+func TestAppParallelDeliverTxsDeterminism(t *testing.T) {
+	numBlocks := 5
+	numTxs := 40
+
+	for _, seed := range []int64{1, 7, 42} {
+		r := rand.New(rand.NewSource(seed))
+		encCfg := MakeTestEncodingConfig()
+
+		serialApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+		parallelApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{}, baseapp.SetParallelTxWorkers(4))
+
+		config := simtypes.Config{ChainID: helpers.SimAppChainID}
+		appState, accs, chainID, genesisTimestamp := AppStateFn(serialApp.AppCodec(), serialApp.SimulationManager())(r, simtypes.RandomAccounts(r, 100), config)
+
+		for _, app := range []*SimApp{serialApp, parallelApp} {
+			app.InitChain(abci.RequestInitChain{AppStateBytes: appState, ChainId: chainID})
+			app.Commit()
+		}
+		require.Equal(t, serialApp.LastCommitID(), parallelApp.LastCommitID())
+
+		accNums := make(map[string]uint64)
+		seqs := make(map[string]uint64)
+		ctx := serialApp.NewContext(true, tmproto.Header{})
+		for _, acc := range accs {
+			account := serialApp.AccountKeeper.GetAccount(ctx, acc.Address)
+			require.NotNil(t, account)
+			accNums[acc.Address.String()] = account.GetAccountNumber()
+			seqs[acc.Address.String()] = account.GetSequence()
+		}
+
+		var succeeded int
+		for b := 0; b < numBlocks; b++ {
+			height := serialApp.LastBlockHeight() + 1
+			ctx := serialApp.NewContext(true, tmproto.Header{})
+
+			txs := make([][]byte, numTxs)
+			for i := range txs {
+				sender := accs[r.Intn(len(accs))]
+				recipient := simtypes.RandomAccounts(r, 1)[0].Address
+				if r.Intn(3) > 0 {
+					recipient = accs[r.Intn(len(accs))].Address
+				}
+
+				spendable := serialApp.BankKeeper.SpendableCoins(ctx, sender.Address).AmountOf(sdk.DefaultBondDenom)
+				amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, simtypes.RandomAmount(r, spendable.QuoRaw(100)).AddRaw(1)))
+
+				var fees sdk.Coins
+				if r.Intn(4) == 0 {
+					fees = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+				}
+
+				addr := sender.Address.String()
+				tx, err := helpers.GenTx(
+					encCfg.TxConfig,
+					[]sdk.Msg{banktypes.NewMsgSend(sender.Address, recipient, amount)},
+					fees,
+					helpers.DefaultGenTxGas,
+					chainID,
+					[]uint64{accNums[addr]},
+					[]uint64{seqs[addr]},
+					sender.PrivKey,
+				)
+				require.NoError(t, err)
+				seqs[addr]++
+
+				txs[i], err = encCfg.TxConfig.TxEncoder()(tx)
+				require.NoError(t, err)
+			}
+
+			header := tmproto.Header{
+				ChainID: chainID,
+				Height:  height,
+				Time:    genesisTimestamp.Add(time.Duration(height) * 5 * time.Second),
+			}
+			serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			serialRes := serialApp.DeliverTxs(txs)
+			parallelRes := parallelApp.DeliverTxs(txs)
+			require.Equal(t, serialRes, parallelRes, "seed %d, height %d", seed, height)
+
+			for _, res := range serialRes {
+				if res.IsOK() {
+					succeeded++
+				}
+			}
+
+			require.Equal(t, serialApp.EndBlock(abci.RequestEndBlock{Height: height}), parallelApp.EndBlock(abci.RequestEndBlock{Height: height}))
+			require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data, "seed %d, height %d", seed, height)
+		}
+
+		require.NotZero(t, succeeded, "seed %d", seed)
+	}
+}
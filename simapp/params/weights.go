@@ -2,20 +2,37 @@ package params
 
 // Default simulation operation weights for messages and gov proposals
 const (
-	DefaultWeightMsgSend                        int = 100
-	DefaultWeightMsgMultiSend                   int = 10
-	DefaultWeightMsgSetWithdrawAddress          int = 50
-	DefaultWeightMsgWithdrawDelegationReward    int = 50
-	DefaultWeightMsgWithdrawValidatorCommission int = 50
-	DefaultWeightMsgFundCommunityPool           int = 50
-	DefaultWeightMsgDeposit                     int = 100
-	DefaultWeightMsgVote                        int = 67
-	DefaultWeightMsgUnjail                      int = 100
-	DefaultWeightMsgCreateValidator             int = 100
-	DefaultWeightMsgEditValidator               int = 5
-	DefaultWeightMsgDelegate                    int = 100
-	DefaultWeightMsgUndelegate                  int = 100
-	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgSend                         int = 100
+	DefaultWeightMsgMultiSend                    int = 10
+	DefaultWeightMsgSetWithdrawAddress           int = 50
+	DefaultWeightMsgWithdrawDelegationReward     int = 50
+	DefaultWeightMsgWithdrawValidatorCommission  int = 50
+	DefaultWeightMsgFundCommunityPool            int = 50
+	DefaultWeightMsgDeposit                      int = 100
+	DefaultWeightMsgVote                         int = 67
+	DefaultWeightMsgWeightedVote                 int = 33
+	DefaultWeightMsgUnjail                       int = 100
+	DefaultWeightMsgCreateValidator              int = 100
+	DefaultWeightMsgEditValidator                int = 5
+	DefaultWeightMsgDelegate                     int = 100
+	DefaultWeightMsgUndelegate                   int = 100
+	DefaultWeightMsgBeginRedelegate              int = 100
+	DefaultWeightGrantAllowance                  int = 100
+	DefaultWeightRevokeAllowance                 int = 100
+	DefaultWeightMsgGrant                        int = 100
+	DefaultWeightMsgRevoke                       int = 90
+	DefaultWeightMsgExec                         int = 90
+	DefaultWeightMsgCreateGroup                  int = 100
+	DefaultWeightMsgUpdateGroupMembers           int = 10
+	DefaultWeightMsgCreateGroupPolicy            int = 50
+	DefaultWeightMsgSubmitGroupProposal          int = 90
+	DefaultWeightMsgGroupVote                    int = 90
+	DefaultWeightMsgGroupExec                    int = 90
+	DefaultWeightMsgSendNFT                      int = 100
+	DefaultWeightMsgCreateVestingAccount         int = 20
+	DefaultWeightMsgCreatePeriodicVestingAccount int = 20
+	DefaultWeightMsgCreateClawbackVestingAccount int = 20
+	DefaultWeightMsgClawback                     int = 10
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
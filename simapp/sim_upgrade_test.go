@@ -0,0 +1,300 @@
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	simUpgradeName = "sim-upgrade"
+
+	// store deleted by the upgrade; the upgraded application still mounts it,
+	// as the Deleted store upgrades only clear mounted stores, to check that
+	// it is empty afterwards
+	simUpgradeDeletedStore = "sim_upgrade_deleted"
+
+	// store mounted by the old application only
+	simUpgradeRenamedStore = "sim_upgrade_renamed"
+
+	// stores mounted by the upgraded application only
+	simUpgradeAddedStore     = "sim_upgrade_added"
+	simUpgradeRenamedToStore = "sim_upgrade_renamed_to"
+)
+
+var (
+	simUpgradeStoreUpgrades = storetypes.StoreUpgrades{
+		Added:   []string{simUpgradeAddedStore},
+		Renamed: []storetypes.StoreRename{{OldKey: simUpgradeRenamedStore, NewKey: simUpgradeRenamedToStore}},
+		Deleted: []string{simUpgradeDeletedStore},
+	}
+
+	simUpgradeKey   = []byte("key")
+	simUpgradeValue = []byte("value")
+)
+
+// mountKVStoresOpt returns a BaseApp option that mounts the given KV stores,
+// besides the ones of SimApp.
+func mountKVStoresOpt(keys map[string]*sdk.KVStoreKey) func(*baseapp.BaseApp) {
+	return func(bapp *baseapp.BaseApp) {
+		bapp.MountKVStores(keys)
+	}
+}
+
+// simUpgrade simulates a software upgrade of SimApp. The old application mounts
+// a store that the upgrade deletes and one that it renames, while the upgraded
+// application mounts the renamed store and a new one. The upgrade handler runs
+// the module migrations. Invariants are asserted on both sides of the upgrade,
+// and the state exported by the old application right before the upgrade must
+// match the one exported by the upgraded application.
+type simUpgrade struct {
+	t      *testing.T
+	db     dbm.DB
+	home   string
+	logger log.Logger
+	config simtypes.Config
+	height int64
+	opts   []func(*baseapp.BaseApp)
+
+	oldApp  *SimApp
+	oldKeys map[string]*sdk.KVStoreKey
+	newApp  *SimApp
+	newKeys map[string]*sdk.KVStoreKey
+}
+
+// newSimUpgrade creates the old application of the upgrade. The given BaseApp
+// options are applied to both the old and the upgraded application, so they must
+// not hold state such as an inter-block cache.
+func newSimUpgrade(
+	t *testing.T, db dbm.DB, home string, logger log.Logger, config simtypes.Config, opts ...func(*baseapp.BaseApp),
+) *simUpgrade {
+	u := &simUpgrade{
+		t:       t,
+		db:      db,
+		home:    home,
+		logger:  logger,
+		config:  config,
+		height:  int64(config.NumBlocks/2) + 1,
+		opts:    opts,
+		oldKeys: sdk.NewKVStoreKeys(simUpgradeDeletedStore, simUpgradeRenamedStore),
+		newKeys: sdk.NewKVStoreKeys(simUpgradeDeletedStore, simUpgradeAddedStore, simUpgradeRenamedToStore),
+	}
+
+	u.oldApp = NewSimApp(
+		logger, db, nil, true, map[int64]bool{}, home, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{},
+		append(opts, mountKVStoresOpt(u.oldKeys))...,
+	)
+
+	return u
+}
+
+// upgrade returns the simulation.Upgrade performing the upgrade.
+func (u *simUpgrade) upgrade() simulation.Upgrade {
+	return simulation.Upgrade{
+		Height:   u.height,
+		Schedule: u.schedule,
+		Apply:    u.apply,
+	}
+}
+
+// schedule schedules the upgrade plan and fills the stores that the upgrade
+// deletes and renames.
+func (u *simUpgrade) schedule(ctx sdk.Context) {
+	plan := upgradetypes.Plan{Name: simUpgradeName, Height: u.height}
+	require.NoError(u.t, u.oldApp.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	ctx.KVStore(u.oldKeys[simUpgradeDeletedStore]).Set(simUpgradeKey, simUpgradeValue)
+	ctx.KVStore(u.oldKeys[simUpgradeRenamedStore]).Set(simUpgradeKey, simUpgradeValue)
+}
+
+// apply starts the upgraded application once the old one halted.
+func (u *simUpgrade) apply(tb testing.TB) (*baseapp.BaseApp, simulation.WeightedOperations) {
+	// the old application must have halted because of the upgrade
+	upgradeInfo, err := u.oldApp.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	require.NoError(tb, err)
+	require.Equal(tb, storetypes.UpgradeInfo{Name: simUpgradeName, Height: u.height}, upgradeInfo)
+
+	header := tmproto.Header{Height: u.oldApp.LastBlockHeight()}
+	u.oldApp.CrisisKeeper.AssertInvariants(u.oldApp.NewContext(true, header))
+
+	exportedBefore, err := u.oldApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(tb, err)
+
+	opts := append(
+		u.opts,
+		mountKVStoresOpt(u.newKeys),
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &simUpgradeStoreUpgrades))
+		},
+	)
+	u.newApp = NewSimApp(
+		u.logger, u.db, nil, true, map[int64]bool{}, u.home, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{},
+		opts...,
+	)
+	u.newApp.UpgradeKeeper.SetUpgradeHandler(
+		simUpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			ctx.KVStore(u.newKeys[simUpgradeAddedStore]).Set(simUpgradeKey, simUpgradeValue)
+
+			toVM, err := u.newApp.mm.RunMigrations(ctx, u.newApp.configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			u.newApp.CrisisKeeper.AssertInvariants(ctx)
+			return toVM, nil
+		},
+	)
+
+	// the stores must have been upgraded
+	ctx := u.newApp.NewContext(true, header)
+	require.Equal(tb, simUpgradeValue, ctx.KVStore(u.newKeys[simUpgradeRenamedToStore]).Get(simUpgradeKey))
+	require.Nil(tb, ctx.KVStore(u.newKeys[simUpgradeDeletedStore]).Get(simUpgradeKey))
+	require.Nil(tb, ctx.KVStore(u.newKeys[simUpgradeAddedStore]).Get(simUpgradeKey))
+
+	// and the rest of the state left as is
+	exportedAfter, err := u.newApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(tb, err)
+	require.JSONEq(tb, string(exportedBefore.AppState), string(exportedAfter.AppState))
+	require.Equal(tb, exportedBefore.Validators, exportedAfter.Validators)
+
+	return u.newApp.BaseApp, SimulationOperations(u.newApp, u.newApp.AppCodec(), u.config)
+}
+
+// checkUpgraded checks that the upgrade was applied by the upgraded application.
+func (u *simUpgrade) checkUpgraded() {
+	require.NotNil(u.t, u.newApp, "the application was not upgraded")
+
+	ctx := u.newApp.NewContext(true, tmproto.Header{Height: u.newApp.LastBlockHeight()})
+	require.Equal(u.t, u.height, u.newApp.UpgradeKeeper.GetDoneHeight(ctx, simUpgradeName))
+	require.Equal(u.t, u.newApp.mm.GetVersionMap(), u.newApp.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.Equal(u.t, simUpgradeValue, ctx.KVStore(u.newKeys[simUpgradeAddedStore]).Get(simUpgradeKey))
+
+	u.newApp.CrisisKeeper.AssertInvariants(ctx)
+}
+
+func TestAppSimulationWithUpgrade(t *testing.T) {
+	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation with upgrade")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	config.Commit = true
+	u := newSimUpgrade(t, db, dir, logger, config, fauxMerkleModeOpt)
+	require.Equal(t, "SimApp", u.oldApp.Name())
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeedWithUpgrade(
+		t,
+		os.Stdout,
+		u.oldApp.BaseApp,
+		AppStateFn(u.oldApp.AppCodec(), u.oldApp.SimulationManager()),
+		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+		SimulationOperations(u.oldApp, u.oldApp.AppCodec(), config),
+		u.oldApp.ModuleAccountAddrs(),
+		config,
+		u.oldApp.AppCodec(),
+		u.upgrade(),
+	)
+	require.NoError(t, simErr)
+
+	u.checkUpgraded()
+
+	// export state and simParams
+	err = CheckExportSimulation(u.newApp, config, simParams)
+	require.NoError(t, err)
+
+	PrintStats(db)
+}
+
+func TestAppStateDeterminismWithUpgrade(t *testing.T) {
+	if !FlagEnabledValue {
+		t.Skip("skipping application simulation with upgrade")
+	}
+
+	config := NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.Commit = true
+	config.ChainID = helpers.SimAppChainID
+
+	numSeeds := 2
+	numTimesToRunPerSeed := 2
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			var logger log.Logger
+			if FlagVerboseValue {
+				logger = log.TestingLogger()
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			home, err := ioutil.TempDir("", "app-sim-upgrade")
+			require.NoError(t, err)
+
+			db := dbm.NewMemDB()
+			u := newSimUpgrade(t, db, home, logger, config)
+
+			fmt.Printf(
+				"running non-determinism simulation with upgrade; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err = simulation.SimulateFromSeedWithUpgrade(
+				t,
+				os.Stdout,
+				u.oldApp.BaseApp,
+				AppStateFn(u.oldApp.AppCodec(), u.oldApp.SimulationManager()),
+				simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+				SimulationOperations(u.oldApp, u.oldApp.AppCodec(), config),
+				u.oldApp.ModuleAccountAddrs(),
+				config,
+				u.oldApp.AppCodec(),
+				u.upgrade(),
+			)
+			require.NoError(t, err)
+			require.NoError(t, os.RemoveAll(home))
+
+			u.checkUpgraded()
+
+			appHash := u.newApp.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -57,6 +58,16 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
+			// SIGN_MODE_TEXTUAL renders coins using the bank metadata of the
+			// chain, so it is enabled once the node to query is known.
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			txConfig := authtx.NewTxConfigWithTextual(
+				codec.NewProtoCodec(clientCtx.InterfaceRegistry), authtx.DefaultSignModes, authtx.NewClientCoinMetadataQueryFn(clientCtx),
+			)
+			if err := client.SetCmdClientContext(cmd, clientCtx.WithTxConfig(txConfig)); err != nil {
+				return err
+			}
+
 			return server.InterceptConfigsPreRunHandler(cmd)
 		},
 	}
@@ -90,6 +101,9 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		txCommand(),
 		keys.Commands(simapp.DefaultNodeHome),
 	)
+
+	// add rosetta
+	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry))
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
@@ -176,6 +190,11 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		panic(err)
 	}
 
+	snapshotQueryDir := cast.ToString(appOpts.Get(server.FlagStateSyncSnapshotQueryDir))
+	if snapshotQueryDir != "" && !filepath.IsAbs(snapshotQueryDir) {
+		snapshotQueryDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), snapshotQueryDir)
+	}
+
 	return simapp.NewSimApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
@@ -193,6 +212,8 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+		baseapp.SetSnapshotQueryCacheSize(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotQueryCacheSize))),
+		baseapp.SetSnapshotQueryDir(snapshotQueryDir),
 	)
 }
 
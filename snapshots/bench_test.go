@@ -0,0 +1,131 @@
+package snapshots_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	benchStores    = 8
+	benchStoreKeys = 5000
+)
+
+var benchFormats = []uint32{types.FormatZlib, types.FormatZstdSections}
+
+// newBenchMultiStore returns a multistore with benchStores IAVL stores, filled
+// with benchStoreKeys keys each if fill is true.
+func newBenchMultiStore(b *testing.B, fill bool) *rootmulti.Store {
+	multiStore := rootmulti.NewStore(db.NewMemDB())
+	keys := make([]*storetypes.KVStoreKey, benchStores)
+	for i := range keys {
+		keys[i] = storetypes.NewKVStoreKey(fmt.Sprintf("store%v", i))
+		multiStore.MountStoreWithDB(keys[i], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(b, multiStore.LoadLatestVersion())
+	if !fill {
+		return multiStore
+	}
+
+	// values are drawn from a small alphabet, so that they compress like actual state
+	r := rand.New(rand.NewSource(3920758213583))
+	for _, key := range keys {
+		store := multiStore.GetCommitKVStore(key)
+		for i := 0; i < benchStoreKeys; i++ {
+			value := make([]byte, 512)
+			for j := range value {
+				value[j] = byte(r.Intn(16))
+			}
+			store.Set([]byte(fmt.Sprintf("key%08d", i)), value)
+		}
+	}
+	multiStore.Commit()
+
+	return multiStore
+}
+
+// readSnapshotChunks reads all the chunks of a snapshot.
+func readSnapshotChunks(b *testing.B, chunks <-chan io.ReadCloser) [][]byte {
+	bzs := [][]byte{}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(b, err)
+		require.NoError(b, chunk.Close())
+		bzs = append(bzs, bz)
+	}
+	return bzs
+}
+
+func BenchmarkSnapshot(b *testing.B) {
+	source := newBenchMultiStore(b, true)
+	height := uint64(source.LastCommitID().Version)
+
+	for _, format := range benchFormats {
+		format := format
+
+		b.Run(fmt.Sprintf("format=%v", format), func(b *testing.B) {
+			size := 0
+			for i := 0; i < b.N; i++ {
+				chunks, err := source.Snapshot(height, format)
+				require.NoError(b, err)
+
+				size = 0
+				for _, chunk := range readSnapshotChunks(b, chunks) {
+					size += len(chunk)
+				}
+			}
+			b.ReportMetric(float64(size), "snapshot-bytes")
+		})
+	}
+}
+
+func BenchmarkRestore(b *testing.B) {
+	source := newBenchMultiStore(b, true)
+	height := uint64(source.LastCommitID().Version)
+
+	for _, format := range benchFormats {
+		format := format
+
+		b.Run(fmt.Sprintf("format=%v", format), func(b *testing.B) {
+			b.StopTimer()
+			chunks, err := source.Snapshot(height, format)
+			require.NoError(b, err)
+			bzs := readSnapshotChunks(b, chunks)
+
+			snapshot := types.Snapshot{Height: height, Format: format, Chunks: uint32(len(bzs))}
+			for _, bz := range bzs {
+				hash := sha256.Sum256(bz)
+				snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash[:])
+			}
+
+			for i := 0; i < b.N; i++ {
+				target := newBenchMultiStore(b, false)
+				store, err := snapshots.NewStore(db.NewMemDB(), b.TempDir())
+				require.NoError(b, err)
+				manager := snapshots.NewManager(store, target)
+				b.StartTimer()
+
+				require.NoError(b, manager.Restore(snapshot))
+				for j, bz := range bzs {
+					done, err := manager.RestoreChunk(bz)
+					require.NoError(b, err)
+					require.Equal(b, j == len(bzs)-1, done)
+				}
+
+				b.StopTimer()
+				require.Equal(b, source.LastCommitID(), target.LastCommitID())
+			}
+		})
+	}
+}
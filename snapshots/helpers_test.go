@@ -97,6 +97,41 @@ func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.Read
 	return ch, nil
 }
 
+type mockExtendableSnapshotter struct {
+	mockSnapshotter
+	extensions []types.ExtensionSnapshotter
+}
+
+func (m *mockExtendableSnapshotter) RegisterSnapshotExtensions(extensions ...types.ExtensionSnapshotter) error {
+	for _, extension := range extensions {
+		for _, registered := range m.extensions {
+			if registered.SnapshotName() == extension.SnapshotName() {
+				return errors.New("duplicate extension")
+			}
+		}
+		m.extensions = append(m.extensions, extension)
+	}
+	return nil
+}
+
+type mockExtension struct {
+	name string
+}
+
+func (m *mockExtension) SnapshotName() string { return m.name }
+
+func (m *mockExtension) SnapshotFormat() uint32 { return 1 }
+
+func (m *mockExtension) SupportedFormats() []uint32 { return []uint32{1} }
+
+func (m *mockExtension) SnapshotExtension(height uint64, payloadWriter types.ExtensionPayloadWriter) error {
+	return nil
+}
+
+func (m *mockExtension) RestoreExtension(height uint64, format uint32, payloadReader types.ExtensionPayloadReader) error {
+	return nil
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
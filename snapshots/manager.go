@@ -100,6 +100,17 @@ func (m *Manager) endLocked() {
 	m.restoreChunkIndex = 0
 }
 
+// RegisterExtensions registers snapshot extensions, whose state is snapshotted and restored
+// along with the state of the target. The target must be an ExtendableSnapshotter.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	target, ok := m.target.(types.ExtendableSnapshotter)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshotter %T does not support extensions", m.target)
+	}
+
+	return target.RegisterSnapshotExtensions(extensions...)
+}
+
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	if m == nil {
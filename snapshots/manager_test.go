@@ -113,6 +113,19 @@ func TestManager_Prune(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_RegisterExtensions(t *testing.T) {
+	store := setupStore(t)
+	extension := &mockExtension{name: "mock"}
+
+	// the target must support extensions
+	manager := snapshots.NewManager(store, &mockSnapshotter{})
+	require.Error(t, manager.RegisterExtensions(extension))
+
+	manager = snapshots.NewManager(store, &mockExtendableSnapshotter{})
+	require.NoError(t, manager.RegisterExtensions(extension))
+	require.Error(t, manager.RegisterExtensions(extension))
+}
+
 func TestManager_Restore(t *testing.T) {
 	store := setupStore(t)
 	target := &mockSnapshotter{}
@@ -13,4 +13,8 @@ var (
 
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
+
+	// ErrExtensionNotFound is returned when a snapshot contains the state of an extension which
+	// is not registered.
+	ErrExtensionNotFound = errors.New("snapshot extension not found")
 )
@@ -1,6 +1,17 @@
 package types
 
+const (
+	// FormatZlib is the snapshot format where the snapshot is a single zlib-compressed stream of
+	// snapshot items, covering all the stores, split into chunks.
+	FormatZlib uint32 = 1
+
+	// FormatZstdSections is the snapshot format where the snapshot is split into sections, one per
+	// store and one for the snapshot extensions, each being a zstd-compressed stream of snapshot
+	// items split into its own chunks. The stores of such snapshots are restored concurrently.
+	FormatZstdSections uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat uint32 = FormatZstdSections
@@ -14,3 +14,41 @@ type Snapshotter interface {
 	// restorer is ready to accept chunks.
 	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
 }
+
+// ExtensionPayloadWriter writes a payload of a snapshot extension to the snapshot stream.
+type ExtensionPayloadWriter = func(payload []byte) error
+
+// ExtensionPayloadReader reads the next payload of a snapshot extension from the snapshot stream.
+// It returns io.EOF once all the payloads of the extension were read.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionSnapshotter is a snapshot extension, which snapshots and restores state kept outside
+// of the multistore, e.g. by a module. Its state is written to the snapshot stream as a series
+// of opaque payloads, after the state of the multistore.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the extension, which must be unique.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format in which the extension writes its payloads. It must be
+	// bumped when the binary payload output changes, like CurrentFormat.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the formats in which the extension can restore its payloads.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the state of the extension at the given height as payloads.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the state of the extension at the given height from the
+	// payloads written in the given format. The payloads must be read until io.EOF.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
+}
+
+// ExtendableSnapshotter is a Snapshotter which also snapshots and restores the state of the
+// registered extensions.
+type ExtendableSnapshotter interface {
+	Snapshotter
+
+	// RegisterSnapshotExtensions registers snapshot extensions.
+	RegisterSnapshotExtensions(extensions ...ExtensionSnapshotter) error
+}
@@ -13,6 +13,7 @@ type ChunkWriter struct {
 	pipe      *io.PipeWriter
 	chunkSize uint64
 	written   uint64
+	header    []byte
 	closed    bool
 }
 
@@ -36,6 +37,29 @@ func (w *ChunkWriter) chunk() error {
 	w.ch <- pr
 	w.pipe = pw
 	w.written = 0
+	if len(w.header) > 0 {
+		if _, err := w.pipe.Write(w.header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartSection ends the current chunk, if any, such that the data written next starts a new
+// chunk. Each of the following chunks begins with the given header, which does not count
+// towards the chunk size.
+func (w *ChunkWriter) StartSection(header []byte) error {
+	if w.closed {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot start section of closed ChunkWriter")
+	}
+	if w.pipe != nil {
+		err := w.pipe.Close()
+		if err != nil {
+			return err
+		}
+		w.pipe = nil
+	}
+	w.header = header
 	return nil
 }
 
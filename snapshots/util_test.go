@@ -82,6 +82,31 @@ func TestChunkWriter(t *testing.T) {
 	assert.Empty(t, ch)
 }
 
+func TestChunkWriter_StartSection(t *testing.T) {
+	ch := make(chan io.ReadCloser, 100)
+	go func() {
+		chunkWriter := snapshots.NewChunkWriter(ch, 2)
+
+		_, err := chunkWriter.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+
+		// the current chunk ends, and the following ones begin with the header
+		require.NoError(t, chunkWriter.StartSection([]byte{0}))
+		_, err = chunkWriter.Write([]byte{4, 5, 6})
+		require.NoError(t, err)
+
+		// a section without header
+		require.NoError(t, chunkWriter.StartSection(nil))
+		_, err = chunkWriter.Write([]byte{7})
+		require.NoError(t, err)
+
+		require.NoError(t, chunkWriter.Close())
+		require.Error(t, chunkWriter.StartSection([]byte{1}))
+	}()
+
+	assert.Equal(t, [][]byte{{1, 2}, {3}, {0, 4, 5}, {0, 6}, {7}}, readChunks(ch))
+}
+
 func TestChunkReader(t *testing.T) {
 	ch := makeChunks([][]byte{
 		{1, 2, 3},
@@ -0,0 +1,169 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(w io.Writer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w io.Writer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+// readKVPair reads a single length-prefixed StoreKVPair from buf.
+func readKVPair(t *testing.T, buf *bytes.Buffer) *types.StoreKVPair {
+	size, err := binary.ReadUvarint(buf)
+	require.NoError(t, err)
+
+	kvPair := new(types.StoreKVPair)
+	require.NoError(t, kvPair.Unmarshal(buf.Next(int(size))))
+	return kvPair
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get([]byte("does-not-exist")))
+	// reads are not reported to the listeners
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	testCases := []struct {
+		key         []byte
+		value       []byte
+		expectedOut *types.StoreKVPair
+	}{
+		{
+			key:   kvPairs[0].Key,
+			value: kvPairs[0].Value,
+			expectedOut: &types.StoreKVPair{
+				StoreKey: testStoreKey.Name(),
+				Key:      kvPairs[0].Key,
+				Value:    kvPairs[0].Value,
+				Delete:   false,
+			},
+		},
+		{
+			key:   kvPairs[1].Key,
+			value: kvPairs[1].Value,
+			expectedOut: &types.StoreKVPair{
+				StoreKey: testStoreKey.Name(),
+				Key:      kvPairs[1].Key,
+				Value:    kvPairs[1].Value,
+				Delete:   false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+
+		store := newEmptyListenKVStore(&buf)
+		buf.Reset()
+		store.Set(tc.key, tc.value)
+
+		require.Equal(t, tc.expectedOut, readKVPair(t, &buf))
+		require.Zero(t, buf.Len())
+		require.Equal(t, tc.value, store.Get(tc.key))
+	}
+
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+	store.Delete(kvPairs[0].Key)
+
+	expected := &types.StoreKVPair{
+		StoreKey: testStoreKey.Name(),
+		Key:      kvPairs[0].Key,
+		Value:    nil,
+		Delete:   true,
+	}
+	require.Equal(t, expected, readKVPair(t, &buf))
+	require.False(t, store.Has(kvPairs[0].Key))
+}
+
+func TestListenKVStorePrefix(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	pStore := prefix.NewStore(store, []byte("listen_prefix"))
+	pStore.Set(kvPairs[0].Key, kvPairs[0].Value)
+
+	// the listeners see the full key
+	kvPair := readKVPair(t, &buf)
+	require.Equal(t, append([]byte("listen_prefix"), kvPairs[0].Key...), kvPair.Key)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	cache.Delete(kvPairs[1].Key)
+
+	// writes are only reported once the cache is written
+	require.Zero(t, buf.Len())
+
+	cache.Write()
+	require.Equal(t, kvPairs[0].Key, readKVPair(t, &buf).Key)
+	kvPair := readKVPair(t, &buf)
+	require.Equal(t, kvPairs[1].Key, kvPair.Key)
+	require.True(t, kvPair.Delete)
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
@@ -9,10 +9,12 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"sync"
 
 	iavltree "github.com/cosmos/iavl"
 	protoio "github.com/gogo/protobuf/io"
 	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
@@ -22,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
@@ -38,6 +41,9 @@ const (
 	snapshotChunkSize   = uint64(10e6)
 	snapshotBufferSize  = int(snapshotChunkSize)
 	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+
+	// Number of chunks of a snapshot section buffered while restoring it
+	snapshotSectionBuffer = 4
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -58,11 +64,17 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
+
+	snapshotExtensions     map[string]snapshottypes.ExtensionSnapshotter
+	skipSnapshotExtensions bool
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore              = (*Store)(nil)
+	_ types.Queryable                     = (*Store)(nil)
+	_ snapshottypes.ExtendableSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -77,6 +89,9 @@ func NewStore(db dbm.DB) *Store {
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
+
+		snapshotExtensions: make(map[string]snapshottypes.ExtensionSnapshotter),
 	}
 }
 
@@ -97,6 +112,12 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetSkipSnapshotExtensions sets whether the state of snapshot extensions is skipped
+// when restoring a snapshot, instead of being restored by the registered extensions.
+func (rs *Store) SetSkipSnapshotExtensions(skip bool) {
+	rs.skipSnapshotExtensions = skip
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -312,6 +333,23 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if ls, ok := rs.listeners[key]; ok {
+		rs.listeners[key] = append(ls, listeners...)
+	} else {
+		rs.listeners[key] = listeners
+	}
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := rs.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -412,7 +450,7 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 		stores[k] = v
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -442,7 +480,40 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	// Historical versions are read-only, so their writes are not listened to.
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
+}
+
+// VersionExists returns whether the given version was committed and has not
+// been pruned from any of the mounted IAVL stores.
+func (rs *Store) VersionExists(version int64) bool {
+	if version < 1 || version > rs.LastCommitID().Version {
+		return false
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		if rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CopyMounts returns a new Store backed by db, with the same stores mounted as
+// rs. The stores are not loaded. All of them are backed by db, even the ones
+// that rs mounts with their own database.
+func (rs *Store) CopyMounts(db dbm.DB) *Store {
+	store := NewStore(db)
+	for key, params := range rs.storesParams {
+		store.MountStoreWithDB(key, params.typ, nil)
+	}
+
+	return store
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -462,7 +533,8 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled on the KVStore, it is also wrapped in a listenkv Store.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
@@ -472,6 +544,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -586,7 +661,7 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatZlib && format != snapshottypes.FormatZstdSections {
 		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -597,15 +672,11 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
+	stores := []namedIAVLStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, namedIAVLStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
@@ -621,91 +692,202 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go func() {
-		// Set up a stream pipeline to serialize snapshot nodes:
-		// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
 		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
 		defer chunkWriter.Close()
-		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-		defer func() {
-			if err := bufWriter.Flush(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+
+		var err error
+		switch format {
+		case snapshottypes.FormatZlib:
+			err = rs.snapshotZlib(chunkWriter, height, stores)
+		case snapshottypes.FormatZstdSections:
+			err = rs.snapshotZstdSections(chunkWriter, height, stores)
+		}
 		if err != nil {
-			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
-			return
+			chunkWriter.CloseWithError(err)
 		}
-		defer func() {
-			if err := zWriter.Close(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-		protoWriter := protoio.NewDelimitedWriter(zWriter)
-		defer func() {
-			if err := protoWriter.Close(); err != nil {
-				chunkWriter.CloseWithError(err)
-			}
-		}()
-
-		// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-		// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-		// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-		// are demarcated by new SnapshotStore items.
-		for _, store := range stores {
-			exporter, err := store.Export(int64(height))
-			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
-			}
-			defer exporter.Close()
-			err = protoWriter.WriteMsg(&types.SnapshotItem{
-				Item: &types.SnapshotItem_Store{
-					Store: &types.SnapshotStoreItem{
-						Name: store.name,
-					},
+	}()
+
+	return ch, nil
+}
+
+// namedIAVLStore is an IAVL store to snapshot, along with its name.
+type namedIAVLStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotZlib writes a snapshot in format 1 to chunkWriter.
+func (rs *Store) snapshotZlib(chunkWriter *snapshots.ChunkWriter, height uint64, stores []namedIAVLStore) error {
+	// Set up a stream pipeline to serialize snapshot nodes:
+	// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+	zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+
+	for _, store := range stores {
+		if err := snapshotIAVLStore(protoWriter, store, height); err != nil {
+			return err
+		}
+	}
+	if err := rs.snapshotExtensionItems(protoWriter, height); err != nil {
+		return err
+	}
+
+	// Closing the Protobuf writer closes the zlib writer. It is closed once more, as it always was
+	// in format 1: this writes another checksum, which must be kept for the output to stay the same.
+	if err := protoWriter.Close(); err != nil {
+		return err
+	}
+	if err := zWriter.Close(); err != nil {
+		return err
+	}
+	return bufWriter.Flush()
+}
+
+// snapshotZstdSections writes a snapshot in format 2 to chunkWriter: a section for each store,
+// followed by a section for the snapshot extensions, if any.
+func (rs *Store) snapshotZstdSections(chunkWriter *snapshots.ChunkWriter, height uint64, stores []namedIAVLStore) error {
+	for _, store := range stores {
+		store := store
+		err := snapshotSection(chunkWriter, store.name, func(protoWriter protoio.WriteCloser) error {
+			return snapshotIAVLStore(protoWriter, store, height)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(rs.snapshotExtensions) == 0 {
+		return nil
+	}
+	return snapshotSection(chunkWriter, "", func(protoWriter protoio.WriteCloser) error {
+		return rs.snapshotExtensionItems(protoWriter, height)
+	})
+}
+
+// snapshotSection writes a section of a snapshot in format 2 to chunkWriter, starting new chunks
+// prefixed with a SnapshotChunkHeader. The items of the section are written by writeItems.
+func snapshotSection(
+	chunkWriter *snapshots.ChunkWriter, section string, writeItems func(protoio.WriteCloser) error,
+) error {
+	bz, err := (&types.SnapshotChunkHeader{Section: section}).Marshal()
+	if err != nil {
+		return err
+	}
+	header := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(bz))
+	header = append(header[:binary.PutUvarint(header, uint64(len(bz)))], bz...)
+	if err := chunkWriter.StartSection(header); err != nil {
+		return err
+	}
+
+	// Set up a stream pipeline to serialize the section items:
+	// SnapshotItem -> delimited Protobuf -> zstd -> buffer -> chunkWriter -> chan io.ReadCloser
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+	zWriter, err := zstd.NewWriter(bufWriter, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return sdkerrors.Wrap(err, "zstd failure")
+	}
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+
+	if err := writeItems(protoWriter); err != nil {
+		return err
+	}
+
+	// Closing the Protobuf writer closes the zstd writer.
+	if err := protoWriter.Close(); err != nil {
+		return err
+	}
+	return bufWriter.Flush()
+}
+
+// snapshotIAVLStore writes an IAVL store at the given height. Stores are serialized as a stream
+// of SnapshotItem Protobuf messages. The first item contains a SnapshotStore with store metadata
+// (i.e. name), and the following messages contain a SnapshotNode (i.e. an ExportNode).
+func snapshotIAVLStore(protoWriter protoio.Writer, store namedIAVLStore, height uint64) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Store{
+			Store: &types.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVL{
+				IAVL: &types.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
-			})
-			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
-			}
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
 
-			for {
-				node, err := exporter.Next()
-				if err == iavltree.ExportDone {
-					break
-				} else if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
-				}
-				err = protoWriter.WriteMsg(&types.SnapshotItem{
-					Item: &types.SnapshotItem_IAVL{
-						IAVL: &types.SnapshotIAVLItem{
-							Key:     node.Key,
-							Value:   node.Value,
-							Height:  int32(node.Height),
-							Version: node.Version,
-						},
+// snapshotExtensionItems writes the state of each snapshot extension at the given height, as a
+// SnapshotExtensionMeta item followed by its SnapshotExtensionPayload items. Extensions are
+// sorted by name.
+func (rs *Store) snapshotExtensionItems(protoWriter protoio.Writer, height uint64) error {
+	for _, extension := range rs.sortedSnapshotExtensions() {
+		name := extension.SnapshotName()
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		payloadWriter := func(payload []byte) error {
+			return protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_ExtensionPayload{
+					ExtensionPayload: &types.SnapshotExtensionPayload{
+						Payload: payload,
 					},
-				})
-				if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
-				}
-			}
-			exporter.Close()
+				},
+			})
 		}
-	}()
+		err = extension.SnapshotExtension(height, payloadWriter)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "snapshot extension %q failed", name)
+		}
+	}
 
-	return ch, nil
+	return nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
 func (rs *Store) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatZlib && format != snapshottypes.FormatZstdSections {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -722,6 +904,23 @@ func (rs *Store) Restore(
 		close(ready)
 	}
 
+	var err error
+	switch format {
+	case snapshottypes.FormatZlib:
+		err = rs.restoreZlib(height, chunks)
+	case snapshottypes.FormatZstdSections:
+		err = rs.restoreZstdSections(height, chunks)
+	}
+	if err != nil {
+		return err
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// restoreZlib restores the stores and extensions from the chunks of a snapshot in format 1.
+func (rs *Store) restoreZlib(height uint64, chunks <-chan io.ReadCloser) error {
 	// Set up a restore stream pipeline
 	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 	chunkReader := snapshots.NewChunkReader(chunks)
@@ -734,21 +933,165 @@ func (rs *Store) Restore(
 	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
 	defer protoReader.Close()
 
+	return rs.restoreItems(height, protoReader, nil)
+}
+
+// restoreZstdSections restores the stores and extensions from the chunks of a snapshot in
+// format 2. Each section is restored by its own goroutine, which is passed the chunks of the
+// section as they arrive, so that the stores are restored concurrently. The extensions are
+// restored once all the stores are.
+func (rs *Store) restoreZstdSections(height uint64, chunks <-chan io.ReadCloser) error {
+	var (
+		wg       sync.WaitGroup
+		mtx      sync.Mutex
+		firstErr error
+
+		sections = make(map[string]bool)
+		section  string
+		current  chan io.ReadCloser // chunks of the current section
+	)
+	fail := func(err error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	failed := func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return firstErr != nil
+	}
+
+	// All the chunks must be read, even after a failure.
+	for chunk := range chunks {
+		if failed() {
+			_ = chunk.Close()
+			continue
+		}
+
+		chunkSection, body, err := readChunkHeader(chunk)
+		if err != nil {
+			_ = chunk.Close()
+			fail(err)
+			continue
+		}
+
+		if current == nil || chunkSection != section {
+			if sections[chunkSection] {
+				_ = chunk.Close()
+				fail(sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot section %q is not contiguous", chunkSection))
+				continue
+			}
+			sections[chunkSection] = true
+
+			if current != nil {
+				close(current)
+			}
+			// the extensions may rely on the state of the stores
+			if chunkSection == "" {
+				wg.Wait()
+			}
+
+			section = chunkSection
+			current = make(chan io.ReadCloser, snapshotSectionBuffer)
+			wg.Add(1)
+			go func(section string, chunks <-chan io.ReadCloser) {
+				defer wg.Done()
+				if err := rs.restoreSection(height, section, chunks); err != nil {
+					fail(err)
+				}
+			}(section, current)
+		}
+
+		current <- body
+	}
+
+	if current != nil {
+		close(current)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// readChunkHeader reads the SnapshotChunkHeader prefixing a chunk of a snapshot in format 2. It
+// returns the section of the chunk and the rest of the chunk.
+func readChunkHeader(chunk io.ReadCloser) (string, io.ReadCloser, error) {
+	reader := bufio.NewReader(chunk)
+	size, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return "", nil, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+	if size > uint64(snapshotMaxItemSize) {
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot chunk header size %v too large", size)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(reader, bz); err != nil {
+		return "", nil, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+	header := &types.SnapshotChunkHeader{}
+	if err := header.Unmarshal(bz); err != nil {
+		return "", nil, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+
+	return header.Section, struct {
+		io.Reader
+		io.Closer
+	}{reader, chunk}, nil
+}
+
+// restoreSection restores a section of a snapshot in format 2 from its chunks, stripped of
+// their header.
+func (rs *Store) restoreSection(height uint64, section string, chunks <-chan io.ReadCloser) error {
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zstd -> delimited Protobuf -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zstd.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zstd failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	return rs.restoreItems(height, protoReader, &section)
+}
+
+// restoreItems restores the stores and extensions from the SnapshotItems read from protoReader.
+// If section is not nil, the items are the ones of a section of a snapshot in format 2, which
+// may only hold the items of the store of that name, or the extensions if empty.
+func (rs *Store) restoreItems(height uint64, protoReader protoio.Reader, section *string) error {
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
-	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
+	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem, a
+	// SnapshotExtensionMeta or EOF. The state of the extensions follows the stores.
+	var (
+		importer *iavltree.Importer
+		next     *types.SnapshotItem // item read ahead while restoring an extension
+		err      error
+	)
 	for {
-		item := &types.SnapshotItem{}
-		err := protoReader.ReadMsg(item)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return sdkerrors.Wrap(err, "invalid protobuf message")
+		item := next
+		next = nil
+		if item == nil {
+			item = &types.SnapshotItem{}
+			err := protoReader.ReadMsg(item)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return sdkerrors.Wrap(err, "invalid protobuf message")
+			}
 		}
 
 		switch item := item.Item.(type) {
 		case *types.SnapshotItem_Store:
+			if section != nil && item.Store.Name != *section {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+					"received store %q item in snapshot section %q", item.Store.Name, *section)
+			}
 			if importer != nil {
 				err = importer.Commit()
 				if err != nil {
@@ -793,6 +1136,27 @@ func (rs *Store) Restore(
 				return sdkerrors.Wrap(err, "IAVL node import failed")
 			}
 
+		case *types.SnapshotItem_Extension:
+			if section != nil && *section != "" {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+					"received extension %q item in snapshot section %q", item.Extension.Name, *section)
+			}
+			if importer != nil {
+				err = importer.Commit()
+				if err != nil {
+					return sdkerrors.Wrap(err, "IAVL commit failed")
+				}
+				importer.Close()
+				importer = nil
+			}
+			next, err = rs.restoreSnapshotExtension(height, item.Extension, protoReader)
+			if err != nil {
+				return err
+			}
+
+		case *types.SnapshotItem_ExtensionPayload:
+			return sdkerrors.Wrap(sdkerrors.ErrLogic, "received extension payload item before extension item")
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
 		}
@@ -806,8 +1170,106 @@ func (rs *Store) Restore(
 		importer.Close()
 	}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return rs.LoadLatestVersion()
+	return nil
+}
+
+// RegisterSnapshotExtensions implements snapshottypes.ExtendableSnapshotter.
+func (rs *Store) RegisterSnapshotExtensions(extensions ...snapshottypes.ExtensionSnapshotter) error {
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := rs.snapshotExtensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "snapshot extension %q already registered", name)
+		}
+		rs.snapshotExtensions[name] = extension
+	}
+
+	return nil
+}
+
+// sortedSnapshotExtensions returns the registered snapshot extensions, sorted by name.
+func (rs *Store) sortedSnapshotExtensions() []snapshottypes.ExtensionSnapshotter {
+	extensions := make([]snapshottypes.ExtensionSnapshotter, 0, len(rs.snapshotExtensions))
+	for _, extension := range rs.snapshotExtensions {
+		extensions = append(extensions, extension)
+	}
+	sort.Slice(extensions, func(i, j int) bool {
+		return extensions[i].SnapshotName() < extensions[j].SnapshotName()
+	})
+
+	return extensions
+}
+
+// restoreSnapshotExtension restores the state of the extension described by meta from the
+// SnapshotExtensionPayload items following it. It returns the first item which is not one of
+// these payloads, if any.
+func (rs *Store) restoreSnapshotExtension(
+	height uint64, meta *types.SnapshotExtensionMeta, protoReader protoio.Reader,
+) (*types.SnapshotItem, error) {
+	var (
+		next *types.SnapshotItem
+		done bool
+	)
+	payloadReader := func() ([]byte, error) {
+		if done {
+			return nil, io.EOF
+		}
+
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			done = true
+			return nil, io.EOF
+		} else if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		payload, ok := item.Item.(*types.SnapshotItem_ExtensionPayload)
+		if !ok {
+			next, done = item, true
+			return nil, io.EOF
+		}
+
+		return payload.ExtensionPayload.Payload, nil
+	}
+
+	if rs.skipSnapshotExtensions {
+		for {
+			if _, err := payloadReader(); err == io.EOF {
+				return next, nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	extension, ok := rs.snapshotExtensions[meta.Name]
+	if !ok {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrExtensionNotFound,
+			"snapshot requires extension %q, which is not registered", meta.Name)
+	}
+
+	supported := false
+	for _, format := range extension.SupportedFormats() {
+		if format == meta.Format {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat,
+			"format %v for snapshot extension %q", meta.Format, meta.Name)
+	}
+
+	err := extension.RestoreExtension(height, meta.Format, payloadReader)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "snapshot extension %q restore failed", meta.Name)
+	}
+	if !done {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"snapshot extension %q did not read all its payloads", meta.Name)
+	}
+
+	return next, nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
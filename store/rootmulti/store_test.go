@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -559,6 +560,18 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, []string{
+			"dc3748e9fbafacd3c05133f53a6b5c80350104384b59c67f16bceaca7a8a0cfe",
+			"dae2d7b0fa2a7ae4956486130cb8034b0a4c453fe5c1dd290b10c15950683b36",
+			"f099d6d8aeea9edcfe2456461bf3ce6baefea349712c7cb69ed315887bf61247",
+			"fb8531b8d760de9916aabc6e2eef8e8bbab317c28bfaacfbceffeafbd344f196",
+			"cf74eac6e891aff1c31081326db6d8c892500c4d680c67191b2efe3e26c4bac4",
+			"c104fe02eb454319745dd3c2a19920db6a5c833e02cd1ed7bc286e56ab31d8bb",
+			"2acbd27aad1923e94b21f174524f68ccf5ce79dfc9ab30c6fc10d77095a5697d",
+			"1ea4463584b79281f5a98d3a8188295029383f33a08eadb16011dc1c7aeb9143",
+			"c3fcb2655eadb4025be798fde19ea3d042c760931b3df3af99aa8ef75bd932dd",
+			"f70b292ab7ec14983e1ab261d6fad63c901af5191bd653228ec870c04b71421d",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -629,31 +642,220 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
+	for _, format := range []uint32{snapshottypes.FormatZlib, snapshottypes.FormatZstdSections} {
+		format := format
+
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+
+			chunks, err := source.Snapshot(version, format)
+			require.NoError(t, err)
+			ready := make(chan struct{})
+			err = target.Restore(version, format, chunks, ready)
+			require.NoError(t, err)
+			assert.EqualValues(t, struct{}{}, <-ready)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				switch sourceStore.GetStoreType() {
+				case types.StoreTypeTransient:
+					assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+						"transient store %v not empty", key.Name())
+				default:
+					assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Sections(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 100)
+	version := uint64(source.LastCommitID().Version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.FormatZstdSections)
+	require.NoError(t, err)
+	sections := [][]byte{}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		sections = append(sections, bz)
+	}
+	// every store fits in a single chunk
+	require.Len(t, sections, 3)
+
+	restore := func(sections ...[]byte) error {
+		target := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 0)
+		ch := make(chan io.ReadCloser, len(sections))
+		for _, section := range sections {
+			ch <- ioutil.NopCloser(bytes.NewReader(section))
+		}
+		close(ch)
+		err := target.Restore(version, snapshottypes.FormatZstdSections, ch, nil)
+		if err == nil {
+			require.Equal(t, source.LastCommitID(), target.LastCommitID())
+		}
+		return err
+	}
+
+	// the sections are independent of each other
+	require.NoError(t, restore(sections...))
+	require.NoError(t, restore(sections[2], sections[0], sections[1]))
+
+	// but the chunks of a section must be contiguous
+	require.Error(t, restore(sections[0], sections[1], sections[0], sections[2]))
+	require.Error(t, restore([]byte{0xff}))
+}
+
+// extensionSnapshotter is a snapshot extension whose state is a list of payloads.
+type extensionSnapshotter struct {
+	name     string
+	format   uint32
+	payloads [][]byte
+}
+
+func (e *extensionSnapshotter) SnapshotName() string { return e.name }
+
+func (e *extensionSnapshotter) SnapshotFormat() uint32 { return e.format }
+
+func (e *extensionSnapshotter) SupportedFormats() []uint32 { return []uint32{e.format} }
+
+func (e *extensionSnapshotter) SnapshotExtension(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	for _, payload := range e.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extensionSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	e.payloads = nil
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		e.payloads = append(e.payloads, payload)
+	}
+}
+
+func TestMultistoreSnapshotRestore_Extensions(t *testing.T) {
 	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
-	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	require.NoError(t, source.RegisterSnapshotExtensions(
+		&extensionSnapshotter{name: "b", format: 1, payloads: [][]byte{[]byte("b1"), []byte("b2")}},
+		&extensionSnapshotter{name: "a", format: 2, payloads: [][]byte{[]byte("a1")}},
+		&extensionSnapshotter{name: "c", format: 1},
+	))
+	require.Error(t, source.RegisterSnapshotExtensions(&extensionSnapshotter{name: "a", format: 1}))
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
 
+	restore := func(target *Store, format uint32) error {
+		chunks, err := source.Snapshot(version, format)
+		require.NoError(t, err)
+		return target.Restore(version, format, chunks, nil)
+	}
+
+	testCases := map[string]struct {
+		extensions []*extensionSnapshotter
+		skip       bool
+		expectErr  error
+	}{
+		"all extensions": {
+			extensions: []*extensionSnapshotter{{name: "a", format: 2}, {name: "b", format: 1}, {name: "c", format: 1}},
+		},
+		"missing extension": {
+			extensions: []*extensionSnapshotter{{name: "a", format: 2}, {name: "c", format: 1}},
+			expectErr:  snapshottypes.ErrExtensionNotFound,
+		},
+		"unsupported extension format": {
+			extensions: []*extensionSnapshotter{{name: "a", format: 1}, {name: "b", format: 1}, {name: "c", format: 1}},
+			expectErr:  snapshottypes.ErrUnknownFormat,
+		},
+		"skipped extensions": {
+			skip: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		for _, format := range []uint32{snapshottypes.FormatZlib, snapshottypes.FormatZstdSections} {
+			tc, format := tc, format
+
+			t.Run(fmt.Sprintf("%s/format %v", name, format), func(t *testing.T) {
+				target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+				target.SetSkipSnapshotExtensions(tc.skip)
+				for _, extension := range tc.extensions {
+					require.NoError(t, target.RegisterSnapshotExtensions(extension))
+				}
+
+				err := restore(target, format)
+				if tc.expectErr != nil {
+					require.True(t, errors.Is(err, tc.expectErr), "unexpected error %v", err)
+					return
+				}
+				require.NoError(t, err)
+
+				require.Equal(t, source.LastCommitID(), target.LastCommitID())
+				for key, sourceStore := range source.stores {
+					if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+						targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+						assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+					}
+				}
+				for _, extension := range tc.extensions {
+					expected := source.snapshotExtensions[extension.name].(*extensionSnapshotter)
+					require.Equal(t, expected.payloads, extension.payloads, "extension %q", extension.name)
+				}
+			})
+		}
+	}
+}
+
+func TestMultistoreCopyMounts(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := source.CopyMounts(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	require.EqualValues(t, 0, target.LastCommitID().Version)
+
+	version := uint64(source.LastCommitID().Version)
 	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
-	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	require.NoError(t, target.Restore(version, snapshottypes.CurrentFormat, chunks, nil))
 
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
 	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		switch sourceStore.GetStoreType() {
-		case types.StoreTypeTransient:
-			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
-				"transient store %v not empty", key.Name())
-		default:
-			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		require.Equal(t, sourceStore.GetStoreType(), target.GetCommitKVStore(key).GetStoreType())
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, target.GetCommitKVStore(key), "store %q not equal", key.Name())
 		}
 	}
 }
 
+func TestMultiStore_VersionExists(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.False(t, ms.VersionExists(0))
+	require.False(t, ms.VersionExists(1))
+
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	for _, v := range []int64{3, 6, 8, 9, 10} {
+		require.True(t, ms.VersionExists(v), "expected version %d to exist", v)
+	}
+	for _, v := range []int64{-1, 0, 1, 2, 4, 5, 7, 11} {
+		require.False(t, ms.VersionExists(v), "expected version %d not to exist", v)
+	}
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -672,6 +874,67 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestAddListenersAndListeningEnabled(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	testKey := types.NewKVStoreKey("listening_test_key")
+	enabled := multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	multi.AddListeners(testKey, []types.WriteListener{})
+	enabled = multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	mockListener := types.NewStoreKVPairWriteListener(nil)
+	multi.AddListeners(testKey, []types.WriteListener{mockListener})
+	wrongTestKey := types.NewKVStoreKey("wrong_listening_test_key")
+	enabled = multi.ListeningEnabled(wrongTestKey)
+	require.False(t, enabled)
+
+	enabled = multi.ListeningEnabled(testKey)
+	require.True(t, enabled)
+}
+
+func TestCacheMultiStoreListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	var buf bytes.Buffer
+	key1 := multi.keysByName["store1"]
+	key2 := multi.keysByName["store2"]
+	multi.AddListeners(key1, []types.WriteListener{types.NewStoreKVPairWriteListener(&buf)})
+
+	readKVPair := func() *types.StoreKVPair {
+		size, err := binary.ReadUvarint(&buf)
+		require.NoError(t, err)
+		kvPair := new(types.StoreKVPair)
+		require.NoError(t, kvPair.Unmarshal(buf.Next(int(size))))
+		return kvPair
+	}
+
+	cacheMulti := multi.CacheMultiStore()
+
+	// direct writes to the cache are reported immediately
+	cacheMulti.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cacheMulti.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Equal(t, &types.StoreKVPair{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")}, readKVPair())
+	require.Zero(t, buf.Len())
+
+	// writes to a nested cache are reported once it is written
+	nested := cacheMulti.CacheMultiStore()
+	nested.GetKVStore(key1).Delete([]byte("k1"))
+	require.Zero(t, buf.Len())
+	nested.Write()
+	require.Equal(t, &types.StoreKVPair{StoreKey: "store1", Key: []byte("k1"), Delete: true}, readKVPair())
+	require.Zero(t, buf.Len())
+
+	// writing the cache to the root store does not report the writes again
+	cacheMulti.Write()
+	multi.Commit()
+	require.Zero(t, buf.Len())
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
@@ -0,0 +1,125 @@
+package rwset
+
+import (
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, recording the keys read from and
+// written to its parent KVStore, and the domains it is iterated over. It is
+// used to detect the conflicts between txs executed concurrently.
+//
+// A Store is not safe for concurrent use.
+type Store struct {
+	parent types.KVStore
+
+	reads   map[string]struct{}
+	domains []domain
+	writes  map[string]struct{}
+}
+
+// domain is an iterated domain, [start, end).
+type domain struct {
+	start, end []byte
+}
+
+// NewStore returns a reference to a new rwset Store given a parent KVStore.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{
+		parent: parent,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// Get implements the KVStore interface. It records the read key.
+func (s *Store) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It records the written key.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.writes[string(key)] = struct{}{}
+}
+
+// Delete implements the KVStore interface. It records the deleted key.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.writes[string(key)] = struct{}{}
+}
+
+// Has implements the KVStore interface. It records the read key.
+func (s *Store) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It records the iterated domain.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.addDomain(start, end)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records the iterated
+// domain.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.addDomain(start, end)
+	return s.parent.ReverseIterator(start, end)
+}
+
+func (s *Store) addDomain(start, end []byte) {
+	s.domains = append(s.domains, domain{
+		start: append([]byte(nil), start...),
+		end:   append([]byte(nil), end...),
+	})
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. Reads of the returned cache
+// which miss it, and its writes once it is written, are recorded.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Writes returns the keys written to the store.
+func (s *Store) Writes() map[string]struct{} {
+	return s.writes
+}
+
+// ReadsAny reports whether any of the given keys was read from the store, or
+// is in one of the domains it was iterated over.
+func (s *Store) ReadsAny(keys map[string]struct{}) bool {
+	for key := range keys {
+		if _, ok := s.reads[key]; ok {
+			return true
+		}
+
+		for _, d := range s.domains {
+			if dbm.IsKeyInDomain([]byte(key), d.start, d.end) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
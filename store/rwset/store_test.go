@@ -0,0 +1,91 @@
+package rwset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keys(ks ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(ks))
+	for _, k := range ks {
+		m[k] = struct{}{}
+	}
+	return m
+}
+
+func newRWSetStore() *rwset.Store {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set(bz("a"), bz("1"))
+	parent.Set(bz("b"), bz("2"))
+
+	return rwset.NewStore(parent)
+}
+
+func TestRWSetStoreReads(t *testing.T) {
+	store := newRWSetStore()
+
+	require.Equal(t, bz("1"), store.Get(bz("a")))
+	require.False(t, store.Has(bz("c")))
+
+	require.True(t, store.ReadsAny(keys("a")))
+	require.True(t, store.ReadsAny(keys("x", "c")))
+	require.False(t, store.ReadsAny(keys("b")))
+	require.False(t, store.ReadsAny(keys()))
+	require.Empty(t, store.Writes())
+}
+
+func TestRWSetStoreWrites(t *testing.T) {
+	store := newRWSetStore()
+
+	store.Set(bz("c"), bz("3"))
+	store.Delete(bz("a"))
+
+	require.Equal(t, keys("a", "c"), store.Writes())
+	require.False(t, store.ReadsAny(keys("a", "c")))
+
+	// writes go through to the parent store
+	require.Nil(t, store.Get(bz("a")))
+	require.Equal(t, bz("3"), store.Get(bz("c")))
+}
+
+func TestRWSetStoreIterators(t *testing.T) {
+	store := newRWSetStore()
+
+	iter := store.Iterator(bz("a"), bz("b"))
+	require.True(t, iter.Valid())
+	require.Equal(t, bz("a"), iter.Key())
+	iter.Close()
+
+	require.True(t, store.ReadsAny(keys("a")))
+	require.True(t, store.ReadsAny(keys("aa")))
+	require.False(t, store.ReadsAny(keys("b")))
+
+	iter = store.ReverseIterator(bz("c"), nil)
+	iter.Close()
+
+	require.True(t, store.ReadsAny(keys("z")))
+	require.False(t, store.ReadsAny(keys("bb")))
+}
+
+func TestRWSetStoreCacheWrap(t *testing.T) {
+	store := newRWSetStore()
+
+	cache := store.CacheWrap().(types.KVStore)
+	require.Equal(t, bz("2"), cache.Get(bz("b")))
+	cache.Set(bz("d"), bz("4"))
+
+	require.True(t, store.ReadsAny(keys("b")))
+	require.Empty(t, store.Writes())
+
+	cache.(types.CacheWrap).Write()
+	require.Equal(t, keys("d"), store.Writes())
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
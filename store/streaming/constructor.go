@@ -0,0 +1,113 @@
+package streaming
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ServiceConstructor is used to construct a streaming service
+type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryMarshaler) (baseapp.StreamingService, error)
+
+// serviceConstructors maps the name of a streaming service, as used in the
+// store.streamers option of app.toml, to its constructor.
+var serviceConstructors = map[string]ServiceConstructor{
+	"file": NewFileStreamingService,
+}
+
+// NewServiceConstructor returns the ServiceConstructor for the streaming
+// service with the given name.
+func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	constructor, ok := serviceConstructors[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
+	}
+
+	return constructor, nil
+}
+
+// NewFileStreamingService is the streaming.ServiceConstructor function for
+// creating a file.StreamingService. It is configured by the streamers.file
+// section of app.toml.
+func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryMarshaler) (baseapp.StreamingService, error) {
+	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
+	fileDir := cast.ToString(opts.Get("streamers.file.write-dir"))
+
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+}
+
+// LoadStreamingServices is a function for loading StreamingServices onto the
+// BaseApp using the provided AppOptions, codec, and keys. It returns the
+// StreamingServices loaded, so that the caller can close them on shutdown.
+//
+// Each service listens to the KVStores named in its streamers.{name}.keys
+// option; the "*" wildcard selects every KVStore in keys.
+func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryMarshaler, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, error) {
+	// configure state listening capabilities using AppOptions
+	streamers := cast.ToStringSlice(appOpts.Get("store.streamers"))
+	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
+
+	for _, streamerName := range streamers {
+		// get the store keys allowed to be exposed for this streaming service
+		exposeKeyStrs := cast.ToStringSlice(appOpts.Get(fmt.Sprintf("streamers.%s.keys", streamerName)))
+
+		var exposeStoreKeys []types.StoreKey
+		if containsWildcard(exposeKeyStrs) {
+			exposeStoreKeys = make([]types.StoreKey, 0, len(keys))
+			for _, storeKey := range keys {
+				exposeStoreKeys = append(exposeStoreKeys, storeKey)
+			}
+		} else {
+			exposeStoreKeys = make([]types.StoreKey, 0, len(exposeKeyStrs))
+			for _, keyStr := range exposeKeyStrs {
+				if storeKey, ok := keys[keyStr]; ok {
+					exposeStoreKeys = append(exposeStoreKeys, storeKey)
+				}
+			}
+		}
+
+		// sort the keys so that listeners are registered deterministically
+		sort.Slice(exposeStoreKeys, func(i, j int) bool {
+			return exposeStoreKeys[i].Name() < exposeStoreKeys[j].Name()
+		})
+
+		// if there are no keys, we don't need a streaming service for this streamer
+		if len(exposeStoreKeys) == 0 {
+			continue
+		}
+
+		constructor, err := NewServiceConstructor(streamerName)
+		if err != nil {
+			return nil, err
+		}
+
+		streamingService, err := constructor(appOpts, exposeStoreKeys, appCodec)
+		if err != nil {
+			return nil, err
+		}
+
+		// register the streaming service with the BaseApp
+		bApp.SetStreamingService(streamingService)
+		activeStreamers = append(activeStreamers, streamingService)
+	}
+
+	return activeStreamers, nil
+}
+
+func containsWildcard(keys []string) bool {
+	for _, key := range keys {
+		if key == "*" {
+			return true
+		}
+	}
+
+	return false
+}
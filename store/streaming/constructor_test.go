@@ -0,0 +1,98 @@
+package streaming
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type fakeOptions map[string]interface{}
+
+func (f fakeOptions) Get(key string) interface{} { return f[key] }
+
+var testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+
+func TestStreamingServiceConstructor(t *testing.T) {
+	_, err := NewServiceConstructor("unexpectedName")
+	require.Error(t, err)
+
+	constructor, err := NewServiceConstructor("file")
+	require.NoError(t, err)
+
+	testDir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	opts := fakeOptions{"streamers.file.write-dir": testDir}
+	keys := []types.StoreKey{types.NewKVStoreKey("acc"), types.NewKVStoreKey("bank")}
+	service, err := constructor(opts, keys, testMarshaller)
+	require.NoError(t, err)
+	require.IsType(t, &file.StreamingService{}, service)
+	require.Len(t, service.Listeners(), 2)
+}
+
+func TestLoadStreamingServices(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	keys := sdk.NewKVStoreKeys("acc", "bank", "gov")
+
+	testCases := map[string]struct {
+		opts              fakeOptions
+		expectedServices  int
+		expectedListeners int
+	}{
+		"no streamers": {
+			fakeOptions{},
+			0, 0,
+		},
+		"all keys": {
+			fakeOptions{
+				"store.streamers":          []string{"file"},
+				"streamers.file.keys":      []string{"*"},
+				"streamers.file.write-dir": testDir,
+			},
+			1, 3,
+		},
+		"some keys": {
+			fakeOptions{
+				"store.streamers":          []string{"file"},
+				"streamers.file.keys":      []string{"bank", "unknown"},
+				"streamers.file.write-dir": testDir,
+			},
+			1, 1,
+		},
+		"no keys": {
+			fakeOptions{
+				"store.streamers":          []string{"file"},
+				"streamers.file.write-dir": testDir,
+			},
+			0, 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp("streaming", log.NewNopLogger(), dbm.NewMemDB(), nil)
+			services, err := LoadStreamingServices(bApp, tc.opts, testMarshaller, keys)
+			require.NoError(t, err)
+			require.Len(t, services, tc.expectedServices)
+			if tc.expectedServices > 0 {
+				require.Len(t, services[0].Listeners(), tc.expectedListeners)
+			}
+		})
+	}
+}
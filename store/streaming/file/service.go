@@ -0,0 +1,170 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes the state changes of each ABCI request to its own file.
+//
+// Each file holds, in order, the length-prefixed protobuf encoded request,
+// the length-prefixed protobuf encoded StoreKVPairs written while processing
+// the request, and the length-prefixed protobuf encoded response. The files
+// are named:
+//
+//	{prefix}-block-{N}-begin
+//	{prefix}-block-{N}-tx-{M}
+//	{prefix}-block-{N}-end
+//
+// where N is the block height and M the index of the tx in the block.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write files into
+	codec      codec.BinaryMarshaler                    // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
+
+	stateCache     [][]byte   // cache the protobuf binary encoded StoreKVPairs in the order they are received
+	stateCacheLock sync.Mutex // mutex for the state cache
+
+	currentBlockNumber int64 // the current block number
+	currentTxIndex     int64 // the index of the current tx
+}
+
+// IntermediateWriter is used so that the StoreKVPairWriteListeners can write
+// into the state cache of the StreamingService.
+type IntermediateWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (iw *IntermediateWriter) Write(b []byte) (int, error) {
+	iw.fss.stateCacheLock.Lock()
+	defer iw.fss.stateCacheLock.Unlock()
+
+	// io.Writer implementations must not retain b
+	iw.fss.stateCache = append(iw.fss.stateCache, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix, and storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryMarshaler) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fss := &StreamingService{
+		filePrefix: filePrefix,
+		writeDir:   writeDir,
+		codec:      c,
+		stateCache: make([][]byte, 0),
+	}
+
+	// sort storeKeys into the listeners, all of them share the same writer
+	listener := types.NewStoreKVPairWriteListener(&IntermediateWriter{fss: fss})
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		fss.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return fss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It writes
+// the BeginBlock request, the state changes and the response to a new file.
+func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.currentBlockNumber = req.GetHeader().Height
+	fss.currentTxIndex = 0
+
+	return fss.writeFile(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber), &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It writes the
+// DeliverTx request, the state changes and the response to a new file.
+func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	name := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It writes the
+// EndBlock request, the state changes and the response to a new file.
+func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return fss.writeFile(fmt.Sprintf("block-%d-end", fss.currentBlockNumber), &req, &res)
+}
+
+// Close satisfies the io.Closer interface. Every file is written and closed
+// by the hook creating it, so there is nothing left to release.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+// writeFile writes the request, the cached state changes and the response to
+// the file with the given name, and resets the state cache.
+func (fss *StreamingService) writeFile(name string, req, res codec.ProtoMarshaler) error {
+	fss.stateCacheLock.Lock()
+	stateCache := fss.stateCache
+	fss.stateCache = make([][]byte, 0)
+	fss.stateCacheLock.Unlock()
+
+	reqBz, err := fss.codec.MarshalBinaryLengthPrefixed(req)
+	if err != nil {
+		return err
+	}
+
+	resBz, err := fss.codec.MarshalBinaryLengthPrefixed(res)
+	if err != nil {
+		return err
+	}
+
+	bz := reqBz
+	for _, kvPairBz := range stateCache {
+		bz = append(bz, kvPairBz...)
+	}
+	bz = append(bz, resBz...)
+
+	if fss.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", fss.filePrefix, name)
+	}
+
+	return ioutil.WriteFile(filepath.Join(fss.writeDir, name), bz, 0600)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errors.New("streaming file write directory is not a directory")
+	}
+
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
@@ -0,0 +1,108 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+	testPrefix    = "testPrefix"
+)
+
+// readMessage reads a single length-prefixed message from bz and returns the
+// remaining bytes.
+func readMessage(t *testing.T, bz []byte, msg codec.ProtoMarshaler) []byte {
+	size, n := binary.Uvarint(bz)
+	require.True(t, n > 0)
+	require.NoError(t, msg.Unmarshal(bz[n:n+int(size)]))
+	return bz[n+int(size):]
+}
+
+func TestFileStreamingService(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	fss, err := NewStreamingService(testDir, testPrefix, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	require.Len(t, fss.Listeners(), 2)
+	require.Len(t, fss.Listeners()[mockStoreKey1], 1)
+
+	ctx := sdk.Context{}
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}
+	beginRes := abci.ResponseBeginBlock{}
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	deliverRes := abci.ResponseDeliverTx{Code: 1, Log: "log"}
+	endReq := abci.RequestEndBlock{Height: 2}
+	endRes := abci.ResponseEndBlock{}
+
+	// BeginBlock without state changes
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	// DeliverTx with a set and a delete
+	require.NoError(t, fss.Listeners()[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, fss.Listeners()[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte("key2"), nil, true))
+	require.NoError(t, fss.ListenDeliverTx(ctx, deliverReq, deliverRes))
+
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, endRes))
+	require.NoError(t, fss.Close())
+
+	// check the BeginBlock file
+	bz, err := ioutil.ReadFile(filepath.Join(testDir, "testPrefix-block-2-begin"))
+	require.NoError(t, err)
+	var gotBeginReq abci.RequestBeginBlock
+	bz = readMessage(t, bz, &gotBeginReq)
+	require.Equal(t, beginReq, gotBeginReq)
+	var gotBeginRes abci.ResponseBeginBlock
+	bz = readMessage(t, bz, &gotBeginRes)
+	require.Empty(t, bz)
+
+	// check the DeliverTx file
+	bz, err = ioutil.ReadFile(filepath.Join(testDir, "testPrefix-block-2-tx-0"))
+	require.NoError(t, err)
+	var gotDeliverReq abci.RequestDeliverTx
+	bz = readMessage(t, bz, &gotDeliverReq)
+	require.Equal(t, deliverReq, gotDeliverReq)
+	var kvPair types.StoreKVPair
+	bz = readMessage(t, bz, &kvPair)
+	require.Equal(t, types.StoreKVPair{StoreKey: "mockStore1", Key: []byte("key1"), Value: []byte("value1")}, kvPair)
+	kvPair = types.StoreKVPair{}
+	bz = readMessage(t, bz, &kvPair)
+	require.Equal(t, types.StoreKVPair{StoreKey: "mockStore2", Key: []byte("key2"), Delete: true}, kvPair)
+	var gotDeliverRes abci.ResponseDeliverTx
+	bz = readMessage(t, bz, &gotDeliverRes)
+	require.Equal(t, deliverRes, gotDeliverRes)
+	require.Empty(t, bz)
+
+	// check the EndBlock file
+	bz, err = ioutil.ReadFile(filepath.Join(testDir, "testPrefix-block-2-end"))
+	require.NoError(t, err)
+	var gotEndReq abci.RequestEndBlock
+	bz = readMessage(t, bz, &gotEndReq)
+	require.Equal(t, endReq, gotEndReq)
+	var gotEndRes abci.ResponseEndBlock
+	bz = readMessage(t, bz, &gotEndRes)
+	require.Empty(t, bz)
+}
+
+func TestNewStreamingServiceInvalidDir(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(os.TempDir(), "does-not-exist-streaming"), "", nil, testMarshaller)
+	require.Error(t, err)
+}
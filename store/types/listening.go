@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called when a KVStore with this listener attached writes or
+	// deletes a key. If the write is a delete, value is nil and delete is true.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer.
+type StoreKVPairWriteListener struct {
+	writer io.Writer
+}
+
+// NewStoreKVPairWriteListener wraps an io.Writer in a StoreKVPairWriteListener.
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{writer: w}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	bz, err := kvPair.Marshal()
+	if err != nil {
+		return err
+	}
+
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, uint64(len(bz)))
+
+	_, err = wl.writer.Write(append(prefix[:n], bz...))
+	return err
+}
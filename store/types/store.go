@@ -130,6 +130,10 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -181,6 +185,13 @@ type CommitMultiStore interface {
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. It appends the listeners to the current set, if one
+	// already exists. The writes of the cache multi-stores returned by
+	// CacheMultiStore are reported to the listeners as they reach that cache,
+	// either directly or when a nested cache is written.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 //---------subsp-------------------------------
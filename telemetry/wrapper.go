@@ -8,9 +8,13 @@ import (
 
 // Common metric key constants
 const (
-	MetricKeyBeginBlocker = "begin_blocker"
-	MetricKeyEndBlocker   = "end_blocker"
-	MetricLabelNameModule = "module"
+	MetricKeyBeginBlocker    = "begin_blocker"
+	MetricKeyEndBlocker      = "end_blocker"
+	MetricLabelNameModule    = "module"
+	MetricLabelNameMsgType   = "msg_type"
+	MetricLabelNameStage     = "stage"
+	MetricLabelNameCodespace = "codespace"
+	MetricLabelNameCode      = "code"
 )
 
 func NewLabel(name, value string) metrics.Label {
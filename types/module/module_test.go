@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -179,7 +180,7 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 
 	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	cfg := module.NewConfigurator(msgRouter, queryRouter)
+	cfg := module.NewConfigurator(nil, msgRouter, queryRouter)
 	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
 	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
@@ -282,3 +283,68 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(nil, nil, nil)
+	noop := func(sdk.Context) error { return nil }
+
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	var migrated []uint64
+	migration := func(version uint64) module.MigrationHandler {
+		return func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}
+	}
+
+	// module1 is missing the migration from version 2 to version 3
+	cfg := module.NewConfigurator(cdc, nil, nil)
+	require.NoError(t, cfg.RegisterMigration("module1", 1, migration(1)))
+	_, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.Error(t, err)
+
+	// module1 is migrated from version 1 to 3, module2 is new and initialized
+	// with its default genesis
+	migrated = nil
+	cfg = module.NewConfigurator(cdc, nil, nil)
+	require.NoError(t, cfg.RegisterMigration("module1", 1, migration(1)))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, migration(2)))
+
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	// a migration error is returned
+	cfg = module.NewConfigurator(cdc, nil, nil)
+	require.NoError(t, cfg.RegisterMigration("module1", 2, func(sdk.Context) error { return errFoo }))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 2, "module2": 1})
+	require.True(t, errors.Is(err, errFoo))
+}
@@ -34,9 +34,10 @@ const (
 	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
 	// verified with raw bytes from Tx
 	SignMode_SIGN_MODE_DIRECT SignMode = 1
-	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
-	// human-readable textual representation on top of the binary representation
-	// from SIGN_MODE_DIRECT
+	// SIGN_MODE_TEXTUAL specifies a signing mode which signs a deterministic
+	// human-readable textual representation of the transaction, encoded as a
+	// TextualSignDoc. The representation includes a hash of the raw bytes from
+	// SIGN_MODE_DIRECT
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
 	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
 	// Amino JSON and will be removed in the future
@@ -391,7 +392,7 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
+	// 540 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
 	0x10, 0xc6, 0xed, 0x26, 0x8d, 0xd2, 0x29, 0x42, 0x61, 0x49, 0xa5, 0xc4, 0x20, 0x13, 0x95, 0x03,
 	0x11, 0x52, 0xd6, 0x6a, 0x72, 0x40, 0x70, 0xcb, 0x1f, 0x93, 0x86, 0x36, 0x09, 0xd8, 0xa9, 0x04,
@@ -416,16 +417,16 @@ var fileDescriptor_9a54958ff3d0b1b9 = []byte{
 	0x00, 0x7a, 0x08, 0x07, 0xeb, 0xa1, 0x25, 0xce, 0xee, 0x68, 0x9b, 0x84, 0xf4, 0x55, 0x84, 0xfd,
 	0xa4, 0x27, 0x3a, 0x83, 0xa2, 0xe5, 0x72, 0x33, 0x0c, 0xcd, 0x6c, 0x68, 0x4a, 0xd6, 0x24, 0xdd,
 	0x49, 0xbc, 0x5e, 0xc1, 0xac, 0x53, 0x97, 0x7a, 0x81, 0x69, 0xf3, 0x8e, 0xcb, 0xdb, 0xcb, 0x63,
-	0xda, 0x1a, 0x80, 0xf4, 0x3f, 0x76, 0x6d, 0x2f, 0xd9, 0xb5, 0x9d, 0x86, 0xba, 0x85, 0xe9, 0xec,
-	0x43, 0x8e, 0x45, 0xde, 0x53, 0x06, 0xc5, 0xec, 0x8a, 0xa8, 0x0a, 0x47, 0xfa, 0xa0, 0x3f, 0x32,
-	0x86, 0xe3, 0x9e, 0x6a, 0x5c, 0x8c, 0xf4, 0xd7, 0x6a, 0x77, 0xf0, 0x72, 0xa0, 0xf6, 0x4a, 0x02,
-	0x2a, 0x43, 0x69, 0x53, 0xea, 0x0d, 0x34, 0xb5, 0x3b, 0x29, 0x89, 0xe8, 0x08, 0xee, 0x6d, 0xb2,
-	0x13, 0xf5, 0xed, 0xe4, 0xa2, 0x7d, 0x5e, 0xda, 0x43, 0x8f, 0xe0, 0xc1, 0x26, 0x7d, 0xae, 0xf6,
-	0xdb, 0xdd, 0x77, 0x46, 0x7b, 0x38, 0x18, 0x8d, 0x8d, 0x57, 0xfa, 0x78, 0x54, 0xfa, 0xdc, 0xe9,
-	0x7f, 0x9f, 0xcb, 0xe2, 0xcd, 0x5c, 0x16, 0x7f, 0xce, 0x65, 0xf1, 0xcb, 0x42, 0x16, 0x6e, 0x16,
-	0xb2, 0xf0, 0x63, 0x21, 0x0b, 0xef, 0x1b, 0x8e, 0xcb, 0x3f, 0x44, 0x16, 0xb6, 0xa9, 0xa7, 0x64,
-	0x6f, 0x38, 0xf9, 0x34, 0xd8, 0xf4, 0x4a, 0xe1, 0x71, 0x40, 0xb6, 0x7f, 0x0c, 0x56, 0x21, 0x79,
-	0x01, 0xad, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x87, 0x55, 0xbf, 0x34, 0x04, 0x00, 0x00,
+	0xda, 0x1a, 0x80, 0xf4, 0x3f, 0x76, 0x6d, 0xaf, 0x96, 0xdb, 0x75, 0xa8, 0x5b, 0x98, 0xce, 0x3e,
+	0xe4, 0x58, 0xe4, 0x3d, 0x65, 0x50, 0xcc, 0xae, 0x88, 0xaa, 0x70, 0xa4, 0x0f, 0xfa, 0x23, 0x63,
+	0x38, 0xee, 0xa9, 0xc6, 0xc5, 0x48, 0x7f, 0xad, 0x76, 0x07, 0x2f, 0x07, 0x6a, 0xaf, 0x24, 0xa0,
+	0x32, 0x94, 0x36, 0xa5, 0xde, 0x40, 0x53, 0xbb, 0x93, 0x92, 0x88, 0x8e, 0xe0, 0xde, 0x26, 0x3b,
+	0x51, 0xdf, 0x4e, 0x2e, 0xda, 0xe7, 0xa5, 0x3d, 0xf4, 0x08, 0x1e, 0x6c, 0xd2, 0xe7, 0x6a, 0xbf,
+	0xdd, 0x7d, 0x67, 0xb4, 0x87, 0x83, 0xd1, 0xd8, 0x78, 0xa5, 0x8f, 0x47, 0xa5, 0xcf, 0x9d, 0xfe,
+	0xf7, 0xb9, 0x2c, 0xde, 0xcc, 0x65, 0xf1, 0xe7, 0x5c, 0x16, 0xbf, 0x2c, 0x64, 0xe1, 0x66, 0x21,
+	0x0b, 0x3f, 0x16, 0xb2, 0xf0, 0xbe, 0xe1, 0xb8, 0xfc, 0x43, 0x64, 0x61, 0x9b, 0x7a, 0x4a, 0xf6,
+	0x86, 0x93, 0x4f, 0x83, 0x4d, 0xaf, 0x14, 0x1e, 0x07, 0x64, 0xfb, 0xc7, 0x60, 0x15, 0x92, 0x17,
+	0xd0, 0xfa, 0x3d, 0x00, 0x86, 0x87, 0x55, 0xbf, 0x34, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
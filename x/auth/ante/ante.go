@@ -8,24 +8,56 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer. The priority of the tx in the mempool is set to its fee per gas.
 func NewAnteHandler(
 	ak AccountKeeper, bankKeeper types.BankKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
+		newAnteDecorators(ak, NewDeductFeeDecorator(ak, bankKeeper), true, sigGasConsumer, signModeHandler)...,
+	)
+}
+
+// NewAnteHandlerWithFeeDecorator returns an AnteHandler like the one of
+// NewAnteHandler, in which the fees are deducted by feeDecorator instead. Txs
+// with a fee granter are not rejected, so feeDecorator must handle them, e.g.
+// by deducting the fees from the granter.
+func NewAnteHandlerWithFeeDecorator(
+	ak AccountKeeper, feeDecorator sdk.AnteDecorator,
+	sigGasConsumer SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		newAnteDecorators(ak, feeDecorator, false, sigGasConsumer, signModeHandler)...,
+	)
+}
+
+// newAnteDecorators returns the decorators of the AnteHandlers of
+// NewAnteHandler and NewAnteHandlerWithFeeDecorator.
+func newAnteDecorators(
+	ak AccountKeeper, feeDecorator sdk.AnteDecorator, rejectFeeGranter bool,
+	sigGasConsumer SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) []sdk.AnteDecorator {
+	decorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
 		NewMempoolFeeDecorator(),
+		NewTxPriorityDecorator(),
 		NewValidateBasicDecorator(),
 		TxTimeoutHeightDecorator{},
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
-		NewRejectFeeGranterDecorator(),
+	}
+	if rejectFeeGranter {
+		decorators = append(decorators, NewRejectFeeGranterDecorator())
+	}
+
+	return append(decorators,
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		feeDecorator,
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak, signModeHandler),
 		NewIncrementSequenceDecorator(ak),
@@ -919,6 +919,8 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 			cost += types.DefaultParams().SigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
 			cost += types.DefaultParams().SigVerifyCostSecp256k1
+		case strings.Contains(pubkeyType, "secp256r1"):
+			cost += types.DefaultParams().SigVerifyCostSecp256r1()
 		default:
 			panic("unexpected key type")
 		}
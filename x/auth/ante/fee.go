@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -54,6 +55,50 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// TxPriorityDecorator sets the priority of the transaction in the mempool to its
+// fee per unit of gas, so that transactions paying a higher gas price are
+// included first. If the fee has several denominations, the lowest price is
+// used. It is run on recheck too, as the priority must be returned then.
+// CONTRACT: Tx must implement FeeTx to use TxPriorityDecorator
+type TxPriorityDecorator struct{}
+
+func NewTxPriorityDecorator() TxPriorityDecorator {
+	return TxPriorityDecorator{}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.IsCheckTx() {
+		ctx = ctx.WithPriority(GetTxPriority(feeTx.GetFee(), feeTx.GetGas()))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GetTxPriority returns the priority of a transaction paying fee for gas: the
+// lowest amount paid per unit of gas among the fee denominations, capped to
+// math.MaxInt64. It is zero if there is no fee or no gas.
+func GetTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if fee.IsZero() || gas == 0 {
+		return 0
+	}
+
+	var priority int64 = math.MaxInt64
+	gasInt := sdk.NewIntFromUint64(gas)
+	for _, c := range fee {
+		p := c.Amount.Quo(gasInt)
+		if p.IsInt64() && p.Int64() < priority {
+			priority = p.Int64()
+		}
+	}
+
+	return priority
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
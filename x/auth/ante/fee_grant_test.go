@@ -3,8 +3,10 @@ package ante_test
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
@@ -30,3 +32,42 @@ func (suite *AnteTestSuite) TestRejectFeeGranter() {
 	_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
 	suite.Require().Error(err)
 }
+
+// feeGranterDecorator records the fee granters of the txs it handles.
+type feeGranterDecorator struct {
+	granters []sdk.AccAddress
+}
+
+func (d *feeGranterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	d.granters = append(d.granters, tx.(sdk.FeeTx).FeeGranter())
+	return next(ctx, tx, simulate)
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(accounts[0].acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	_, _, granter := testdata.KeyTestPubAddr()
+	suite.txBuilder.(setFeeGranter).SetFeeGranter(granter)
+
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the default AnteHandler rejects fee grants
+	_, err = suite.anteHandler(suite.ctx, tx, false)
+	suite.Require().True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	// while the fee decorator handles them
+	feeDecorator := &feeGranterDecorator{}
+	anteHandler := ante.NewAnteHandlerWithFeeDecorator(
+		suite.app.AccountKeeper, feeDecorator, ante.DefaultSigVerificationGasConsumer,
+		suite.clientCtx.TxConfig.SignModeHandler(),
+	)
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{granter}, feeDecorator.granters)
+}
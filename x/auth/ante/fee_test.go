@@ -1,6 +1,8 @@
 package ante_test
 
 import (
+	"math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
@@ -60,6 +62,63 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestTxPriority() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	tpd := ante.NewTxPriorityDecorator()
+	antehandler := sdk.ChainAnteDecorators(tpd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 3000000), sdk.NewInt64Coin("stake", 1000000)))
+	suite.txBuilder.SetGasLimit(100000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the lowest fee per gas is used
+	newCtx, err := antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), newCtx.Priority())
+
+	// the priority is set on recheck too
+	newCtx, err = antehandler(suite.ctx.WithIsReCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), newCtx.Priority())
+
+	// but not in DeliverTx
+	newCtx, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), newCtx.Priority())
+}
+
+func (suite *AnteTestSuite) TestGetTxPriority() {
+	maxInt := sdk.NewIntFromUint64(math.MaxUint64)
+
+	testCases := []struct {
+		fee      sdk.Coins
+		gas      uint64
+		expected int64
+	}{
+		{nil, 100, 0},
+		{sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 0, 0},
+		{sdk.NewCoins(sdk.NewInt64Coin("atom", 99)), 100, 0},
+		{sdk.NewCoins(sdk.NewInt64Coin("atom", 250)), 100, 2},
+		{sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("stake", 1000)), 100, 2},
+		{sdk.NewCoins(sdk.NewCoin("atom", maxInt.Mul(maxInt))), 1, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expected, ante.GetTxPriority(tc.fee, tc.gas), "fee: %s, gas: %d", tc.fee, tc.gas)
+	}
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -1,13 +1,16 @@
 package ante_test
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -16,7 +19,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestSetPubKey() {
@@ -95,6 +101,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1 / 2, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -125,7 +132,7 @@ func (suite *AnteTestSuite) TestSigVerification() {
 	// keys and addresses
 	priv1, _, addr1 := testdata.KeyTestPubAddr()
 	priv2, _, addr2 := testdata.KeyTestPubAddr()
-	priv3, _, addr3 := testdata.KeyTestPubAddr()
+	priv3, _, addr3 := testdata.KeyTestPubAddrSecp256R1()
 
 	addrs := []sdk.AccAddress{addr1, addr2, addr3}
 
@@ -182,6 +189,103 @@ func (suite *AnteTestSuite) TestSigVerification() {
 	}
 }
 
+// Tests that SIGN_MODE_TEXTUAL signatures are verified against the bank
+// metadata in state.
+func (suite *AnteTestSuite) TestSigVerification_Textual() {
+	suite.SetupTest(true) // setup
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	metadata := banktypes.Metadata{
+		Base:    "atom",
+		Display: "katom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "atom", Exponent: 0},
+			{Denom: "katom", Exponent: 3},
+		},
+	}
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.Require().NoError(acc.SetAccountNumber(0))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	protoCodec := codec.NewProtoCodec(suite.app.InterfaceRegistry())
+	stateQueryFn := func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		md := suite.app.BankKeeper.GetDenomMetaData(sdk.UnwrapSDKContext(ctx), denom)
+		if md.Base == "" {
+			return nil, nil
+		}
+		return &md, nil
+	}
+	nodeTxConfig := authtx.NewTxConfigWithTextual(protoCodec, authtx.DefaultSignModes, stateQueryFn)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, nodeTxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		queryFn   authtx.CoinMetadataQueryFn
+		chainID   string
+		shouldErr bool
+	}{
+		{
+			"valid tx",
+			func(_ context.Context, _ string) (*banktypes.Metadata, error) { return &metadata, nil },
+			suite.ctx.ChainID(),
+			false,
+		},
+		{
+			"signer rendered coins without metadata",
+			nil,
+			suite.ctx.ChainID(),
+			true,
+		},
+		{
+			"wrong chain id",
+			func(_ context.Context, _ string) (*banktypes.Metadata, error) { return &metadata, nil },
+			"wrong-chain",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			clientTxConfig := authtx.NewTxConfig(protoCodec, []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL})
+			if tc.queryFn != nil {
+				clientTxConfig = authtx.NewTxConfigWithTextual(protoCodec, authtx.DefaultSignModes, tc.queryFn)
+			}
+
+			txBuilder := clientTxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			sigV2 := signing.SignatureV2{
+				PubKey:   priv1.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL},
+				Sequence: 0,
+			}
+			suite.Require().NoError(txBuilder.SetSignatures(sigV2))
+
+			signerData := xauthsigning.SignerData{ChainID: tc.chainID, AccountNumber: 0, Sequence: 0}
+			sigV2, err := tx.SignWithPrivKey(signing.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder, priv1, clientTxConfig, 0)
+			suite.Require().NoError(err)
+			suite.Require().NoError(txBuilder.SetSignatures(sigV2))
+
+			_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
+			if tc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 // This test is exactly like the one above, but we set the codec explicitly to
 // Amino.
 // Once https://github.com/cosmos/cosmos-sdk/issues/6190 is in, we can remove
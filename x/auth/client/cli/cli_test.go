@@ -852,6 +852,70 @@ func (s *IntegrationTestSuite) TestGetAccountCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetAccountsCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expAddr   bool
+	}{
+		{
+			"all accounts",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			true,
+		},
+		{
+			"module accounts only",
+			[]string{
+				"--account-type=/cosmos.auth.v1beta1.ModuleAccount",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			false,
+		},
+		{
+			"invalid pagination",
+			[]string{
+				fmt.Sprintf("--%s=2", flags.FlagPage),
+				fmt.Sprintf("--%s=1", flags.FlagOffset),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := authcli.GetAccountsCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var res authtypes.QueryAccountsResponse
+				s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().NotEmpty(res.Accounts)
+
+				var found bool
+				for _, any := range res.Accounts {
+					var acc authtypes.AccountI
+					s.Require().NoError(val.ClientCtx.InterfaceRegistry.UnpackAny(any, &acc))
+					if acc.GetAddress().Equals(val.Address) {
+						found = true
+					}
+				}
+				s.Require().Equal(tc.expAddr, found)
+			}
+		})
+	}
+}
+
 func TestGetBroadcastCommand_OfflineFlag(t *testing.T) {
 	clientCtx := client.Context{}.WithOffline(true)
 	clientCtx = clientCtx.WithTxConfig(simapp.MakeTestEncodingConfig().TxConfig)
@@ -18,7 +18,8 @@ import (
 )
 
 const (
-	flagEvents = "events"
+	flagEvents      = "events"
+	flagAccountType = "account-type"
 
 	eventFormat = "{eventType}.{eventAttribute}={value}"
 )
@@ -35,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(),
+		GetAccountsCmd(),
 		QueryParamsCmd(),
 	)
 
@@ -104,6 +106,60 @@ func GetAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAccountsCmd returns a query command that will display a paginated list
+// of all the existing accounts, optionally filtered by account type.
+func GetAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query all the accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the existing accounts, optionally filtered by the type URL of
+their concrete account type.
+
+Example:
+$ %s query %s accounts
+$ %s query %s accounts --%s /cosmos.auth.v1beta1.ModuleAccount
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, flagAccountType,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			accountType, err := cmd.Flags().GetString(flagAccountType)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Accounts(context.Background(), &types.QueryAccountsRequest{
+				AccountType: accountType,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAccountType, "", "Only return accounts of the given type URL, e.g. /cosmos.vesting.v1beta1.ContinuousVestingAccount")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all accounts")
+
+	return cmd
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,17 +2,66 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = AccountKeeper{}
 
+// Accounts returns all the existing accounts, optionally filtered by their
+// concrete account type
+func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	accountType := req.AccountType
+	if accountType != "" && !strings.HasPrefix(accountType, "/") {
+		accountType = "/" + accountType
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(ak.key)
+	accountsStore := prefix.NewStore(store, types.AddressStoreKeyPrefix)
+
+	var accounts []*codectypes.Any
+	pageRes, err := query.FilteredPaginate(accountsStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		account, err := ak.UnmarshalAccount(value)
+		if err != nil {
+			return false, err
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return false, err
+		}
+
+		if accountType != "" && any.TypeUrl != accountType {
+			return false, nil
+		}
+
+		if accumulate {
+			accounts = append(accounts, any)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
 // Account returns account details based on address
 func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
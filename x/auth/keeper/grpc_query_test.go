@@ -5,9 +5,131 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+func (suite *KeeperTestSuite) TestGRPCQueryAccounts() {
+	var (
+		req *types.QueryAccountsRequest
+	)
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryAccountsResponse)
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryAccountsRequest{}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				var count int
+				suite.app.AccountKeeper.IterateAccounts(suite.ctx, func(types.AccountI) bool {
+					count++
+					return false
+				})
+				suite.Require().Len(res.Accounts, count)
+
+				addrs := make(map[string]bool)
+				for _, any := range res.Accounts {
+					var acc types.AccountI
+					suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(any, &acc))
+					addrs[acc.GetAddress().String()] = true
+				}
+				suite.Require().True(addrs[addr1.String()])
+				suite.Require().True(addrs[addr2.String()])
+			},
+		},
+		{
+			"success with pagination",
+			func() {
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 1)
+				suite.Require().NotNil(res.Pagination.NextKey)
+				suite.Require().True(res.Pagination.Total >= 3)
+			},
+		},
+		{
+			"filter by module account type",
+			func() {
+				req = &types.QueryAccountsRequest{AccountType: "/cosmos.auth.v1beta1.ModuleAccount"}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().NotEmpty(res.Accounts)
+				for _, any := range res.Accounts {
+					var acc types.AccountI
+					suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(any, &acc))
+					_, ok := acc.(types.ModuleAccountI)
+					suite.Require().True(ok)
+				}
+			},
+		},
+		{
+			"filter by base account type without leading slash",
+			func() {
+				req = &types.QueryAccountsRequest{AccountType: "cosmos.auth.v1beta1.BaseAccount"}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 2)
+			},
+		},
+		{
+			"filter by unknown account type",
+			func() {
+				req = &types.QueryAccountsRequest{AccountType: "/cosmos.auth.v1beta1.UnknownAccount"}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Empty(res.Accounts)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			for _, addr := range []sdk.AccAddress{addr1, addr2} {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+			}
+			suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Accounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+
+	suite.Run("Case empty request", func() {
+		res, err := suite.app.AccountKeeper.Accounts(sdk.WrapSDKContext(suite.ctx), nil)
+		suite.Require().Error(err)
+		suite.Require().Nil(res)
+	})
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryAccount() {
 	var (
 		req *types.QueryAccountRequest
@@ -24,8 +24,21 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig using the provided
+// ProtoCodec and sign modes, which additionally supports SIGN_MODE_TEXTUAL.
+// coinMetadataQueryFn is used by SIGN_MODE_TEXTUAL to render coins in their
+// display denom; if nil, coins are rendered in their base denom. Nodes and
+// clients must use the same bank metadata for their sign bytes to match.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, coinMetadataQueryFn CoinMetadataQueryFn) client.TxConfig {
+	if !hasSignMode(enabledSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL) && coinMetadataQueryFn != nil {
+		enabledSignModes = append(append([]signingtypes.SignMode{}, enabledSignModes...), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	}
+
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, coinMetadataQueryFn),
 		decoder:     DefaultTxDecoder(protoCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
@@ -67,3 +80,14 @@ func (g config) TxJSONEncoder() sdk.TxEncoder {
 func (g config) TxJSONDecoder() sdk.TxDecoder {
 	return g.jsonDecoder
 }
+
+// hasSignMode returns true if mode is in modes.
+func hasSignMode(modes []signingtypes.SignMode, mode signingtypes.SignMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,202 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank Metadata of the given base denom, or nil
+// if the denom has no metadata. It is used by SIGN_MODE_TEXTUAL to render coins
+// in their display denom.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// NewClientCoinMetadataQueryFn returns a CoinMetadataQueryFn which queries the
+// bank store of the node the client is connected to.
+func NewClientCoinMetadataQueryFn(clientCtx client.Context) CoinMetadataQueryFn {
+	return func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		key := append(banktypes.DenomMetadataKey(denom), []byte(denom)...)
+		bz, _, err := clientCtx.QueryStore(key, banktypes.StoreKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bz) == 0 {
+			return nil, nil
+		}
+
+		var metadata banktypes.Metadata
+		if err := metadata.Unmarshal(bz); err != nil {
+			return nil, err
+		}
+
+		return &metadata, nil
+	}
+}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. It
+// renders a transaction into a list of human-readable screens and signs the
+// TextualSignDoc containing them.
+type signModeTextualHandler struct {
+	// coinMetadataQueryFn is used to render coins in their display denom. If
+	// nil, coins are rendered in their base denom.
+	coinMetadataQueryFn CoinMetadataQueryFn
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens, err := h.txScreens(ctx, data, protoTx)
+	if err != nil {
+		return nil, err
+	}
+
+	signDoc := signingtypes.TextualSignDoc{Screens: screens}
+	return signDoc.Marshal()
+}
+
+// txScreens renders the transaction into the list of screens a signer is
+// shown. The last screen holds a hash of the SIGN_MODE_DIRECT sign bytes so
+// that the signature commits to the raw transaction bytes as well.
+func (h signModeTextualHandler) txScreens(ctx context.Context, data signing.SignerData, protoTx *wrapper) ([]*signingtypes.TextualScreen, error) {
+	r := newTextualRenderer(ctx, h.coinMetadataQueryFn)
+	body := protoTx.tx.Body
+	authInfo := protoTx.tx.AuthInfo
+
+	screens := []*signingtypes.TextualScreen{
+		{Title: "Chain id", Content: data.ChainID},
+		{Title: "Account number", Content: strconv.FormatUint(data.AccountNumber, 10)},
+		{Title: "Sequence", Content: strconv.FormatUint(data.Sequence, 10)},
+	}
+
+	n := len(body.Messages)
+	screens = append(screens, &signingtypes.TextualScreen{Content: fmt.Sprintf("This transaction has %d %s", n, plural(n, "Message"))})
+	for i, msg := range body.Messages {
+		msgScreens, err := r.renderAny(fmt.Sprintf("Message (%d/%d)", i+1, n), msg, 1, false)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Content: "End of Messages"})
+
+	if body.Memo != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Memo", Content: body.Memo})
+	}
+
+	fee := authInfo.Fee
+	if fee == nil {
+		return nil, fmt.Errorf("transaction has no fee")
+	}
+
+	fees, err := r.formatCoins(fee.Amount)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Title: "Fees", Content: fees})
+	if fee.Payer != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Fee payer", Content: fee.Payer})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Fee granter", Content: fee.Granter})
+	}
+	screens = append(screens, &signingtypes.TextualScreen{Title: "Gas limit", Content: strconv.FormatUint(fee.GasLimit, 10), Expert: true})
+
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Timeout height", Content: strconv.FormatUint(body.TimeoutHeight, 10), Expert: true})
+	}
+
+	for i, opt := range body.ExtensionOptions {
+		optScreens, err := r.renderAny(fmt.Sprintf("Extension option (%d/%d)", i+1, len(body.ExtensionOptions)), opt, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, optScreens...)
+	}
+
+	for i, opt := range body.NonCriticalExtensionOptions {
+		optScreens, err := r.renderAny(fmt.Sprintf("Non critical extension option (%d/%d)", i+1, len(body.NonCriticalExtensionOptions)), opt, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, optScreens...)
+	}
+
+	for i, signerInfo := range authInfo.SignerInfos {
+		screens = append(screens, &signingtypes.TextualScreen{
+			Title:   fmt.Sprintf("Signer (%d/%d)", i+1, len(authInfo.SignerInfos)),
+			Content: fmt.Sprintf("sequence %d", signerInfo.Sequence),
+			Expert:  true,
+		})
+
+		if signerInfo.PublicKey != nil {
+			pkScreens, err := r.renderAny("Public key", signerInfo.PublicKey, 1, true)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, pkScreens...)
+		}
+	}
+
+	directBz, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data.ChainID, data.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(directBz)
+	screens = append(screens, &signingtypes.TextualScreen{Title: "Hash of raw bytes", Content: fmt.Sprintf("%X", hash), Expert: true})
+
+	return screens, nil
+}
+
+// plural returns word, followed by an "s" unless n is 1.
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+
+	return word + "s"
+}
+
+// fieldTitle converts a protobuf field name, e.g. from_address, into a screen
+// title, e.g. "From address".
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	if title == "" {
+		return title
+	}
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
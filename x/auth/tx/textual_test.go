@@ -0,0 +1,219 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Base:    "uatom",
+	Display: "atom",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6},
+	},
+}
+
+func atomMetadataQueryFn(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom == atomMetadata.Base {
+		return &atomMetadata, nil
+	}
+	return nil, nil
+}
+
+func TestTextualModeHandler(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	_, _, toAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT}, atomMetadataQueryFn)
+	txBuilder := txConfig.NewTxBuilder()
+
+	msg := banktypes.NewMsgSend(addr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000), sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)))
+	txBuilder.SetGasLimit(20000)
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: 2,
+	}))
+
+	t.Log("verify modes and default-mode")
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT, modeHandler.DefaultMode())
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_TEXTUAL}, modeHandler.Modes())
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	var signDoc signingtypes.TextualSignDoc
+	require.NoError(t, signDoc.Unmarshal(signBytes))
+
+	directBz, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(pubkey)
+	require.NoError(t, err)
+
+	expected := []*signingtypes.TextualScreen{
+		{Title: "Chain id", Content: "test-chain"},
+		{Title: "Account number", Content: "1"},
+		{Title: "Sequence", Content: "2"},
+		{Content: "This transaction has 1 Message"},
+		{Title: "Message (1/1)", Content: "/cosmos.bank.v1beta1.MsgSend", Indent: 1},
+		{Title: "From address", Content: addr.String(), Indent: 2},
+		{Title: "To address", Content: toAddr.String(), Indent: 2},
+		{Title: "Amount", Content: "10 stake, 1.5 atom", Indent: 2},
+		{Content: "End of Messages"},
+		{Title: "Memo", Content: "sometestmemo"},
+		{Title: "Fees", Content: "0.002 atom"},
+		{Title: "Gas limit", Content: "20000", Expert: true},
+		{Title: "Signer (1/1)", Content: "sequence 2", Expert: true},
+		{Title: "Public key", Content: "/cosmos.crypto.secp256k1.PubKey", Indent: 1, Expert: true},
+		{Title: "Key", Content: fmt.Sprintf("%X", pubkey.Bytes()), Indent: 2, Expert: true},
+		{Title: "Hash of raw bytes", Content: fmt.Sprintf("%X", sha256.Sum256(directBz)), Expert: true},
+	}
+	require.Equal(t, pkAny.TypeUrl, expected[13].Content)
+	require.Equal(t, expected, signDoc.Screens)
+
+	t.Log("verify sign bytes are deterministic")
+	signBytes2, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytes2)
+
+	t.Log("verify coins are rendered in their base denom without metadata")
+	noMetadataConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	signBytes, err = noMetadataConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	signDoc = signingtypes.TextualSignDoc{}
+	require.NoError(t, signDoc.Unmarshal(signBytes))
+	require.Equal(t, "10 stake, 1500000 uatom", signDoc.Screens[7].Content)
+	require.Equal(t, "2000 uatom", signDoc.Screens[10].Content)
+
+	t.Log("verify a metadata query error is returned")
+	failingConfig := NewTxConfigWithTextual(marshaler, nil, func(context.Context, string) (*banktypes.Metadata, error) {
+		return nil, fmt.Errorf("query failed")
+	})
+	_, err = failingConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify other modes are rejected")
+	handler := signModeTextualHandler{}
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualRenderer(t *testing.T) {
+	dog, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot", Size_: "small"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msg      interface{}
+		expected []*signingtypes.TextualScreen
+	}{
+		{
+			"nested any",
+			&testdata.HasAnimal{Animal: dog, X: 3},
+			[]*signingtypes.TextualScreen{
+				{Title: "Animal", Content: "/testdata.Dog"},
+				{Title: "Size", Content: "small", Indent: 1},
+				{Title: "Name", Content: "Spot", Indent: 1},
+				{Title: "X", Content: "3"},
+			},
+		},
+		{
+			"any which is not unpacked",
+			&testdata.HasAnimal{Animal: &codectypes.Any{TypeUrl: "/testdata.Dog", Value: []byte{1, 2}}},
+			[]*signingtypes.TextualScreen{
+				{Title: "Animal", Content: "/testdata.Dog"},
+				{Title: "Value", Content: "0102", Indent: 1},
+			},
+		},
+		{
+			"oneof, float and nested message",
+			&testdata.Customer3{
+				Id:       1,
+				Sf:       0.1,
+				Payment:  &testdata.Customer3_ChequeNo{ChequeNo: "42"},
+				Original: &testdata.Customer1{Name: "foo"},
+			},
+			[]*signingtypes.TextualScreen{
+				{Title: "Id", Content: "1"},
+				{Title: "Sf", Content: "0.1"},
+				{Title: "Cheque no", Content: "42"},
+				{Title: "Original", Content: "testdata.Customer1"},
+				{Title: "Name", Content: "foo", Indent: 1},
+			},
+		},
+		{
+			"repeated fields",
+			&banktypes.Metadata{
+				Base: "uatom",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "uatom", Aliases: []string{"microatom"}},
+				},
+			},
+			[]*signingtypes.TextualScreen{
+				{Title: "Denom units", Content: "1 element"},
+				{Title: "Denom units (1/1)", Content: "cosmos.bank.v1beta1.DenomUnit", Indent: 1},
+				{Title: "Denom", Content: "uatom", Indent: 2},
+				{Title: "Aliases", Content: "1 element", Indent: 2},
+				{Title: "Aliases (1/1)", Content: "microatom", Indent: 3},
+				{Title: "Base", Content: "uatom"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTextualRenderer(context.Background(), atomMetadataQueryFn)
+			screens, err := r.renderMessage(reflect.ValueOf(tc.msg), 0, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, screens)
+		})
+	}
+}
+
+func TestTextualFormatCoin(t *testing.T) {
+	testCases := []struct {
+		coin     sdk.Coin
+		expected string
+	}{
+		{sdk.NewInt64Coin("uatom", 1), "0.000001 atom"},
+		{sdk.NewInt64Coin("uatom", 1000000), "1 atom"},
+		{sdk.NewInt64Coin("uatom", 1234567890), "1234.56789 atom"},
+		{sdk.NewInt64Coin("stake", 1234567890), "1234567890 stake"},
+	}
+
+	r := newTextualRenderer(context.Background(), atomMetadataQueryFn)
+	for _, tc := range testCases {
+		got, err := r.formatCoin(tc.coin)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, got)
+	}
+}
@@ -0,0 +1,391 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coinType      = reflect.TypeOf(sdk.Coin{})
+	coinsType     = reflect.TypeOf(sdk.Coins{})
+	decCoinType   = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType  = reflect.TypeOf(sdk.DecCoins{})
+	intType       = reflect.TypeOf(sdk.Int{})
+	decType       = reflect.TypeOf(sdk.Dec{})
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	anyType       = reflect.TypeOf(codectypes.Any{})
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	protoMsgType  = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	byteSliceType = reflect.TypeOf([]byte(nil))
+)
+
+// textualRenderer renders values into SIGN_MODE_TEXTUAL screens. Protobuf
+// messages are rendered field by field in field order, omitting fields set to
+// their default value. Coins, addresses, timestamps, durations and nested Any's
+// have dedicated value renderers.
+type textualRenderer struct {
+	ctx                 context.Context
+	coinMetadataQueryFn CoinMetadataQueryFn
+
+	// metadata caches the coin metadata queried while rendering a transaction
+	metadata map[string]*banktypes.Metadata
+}
+
+func newTextualRenderer(ctx context.Context, coinMetadataQueryFn CoinMetadataQueryFn) *textualRenderer {
+	return &textualRenderer{
+		ctx:                 ctx,
+		coinMetadataQueryFn: coinMetadataQueryFn,
+		metadata:            make(map[string]*banktypes.Metadata),
+	}
+}
+
+// renderAny renders an Any as a screen holding its type URL, followed by the
+// screens of the packed message at the next indentation level.
+func (r *textualRenderer) renderAny(title string, any *codectypes.Any, indent uint32, expert bool) ([]*signingtypes.TextualScreen, error) {
+	screens := []*signingtypes.TextualScreen{{Title: title, Content: any.TypeUrl, Indent: indent, Expert: expert}}
+
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		// the value could not be unpacked, so only its raw bytes can be shown
+		screens = append(screens, &signingtypes.TextualScreen{Title: "Value", Content: fmt.Sprintf("%X", any.Value), Indent: indent + 1, Expert: expert})
+		return screens, nil
+	}
+
+	msgScreens, err := r.renderMessage(reflect.ValueOf(msg), indent+1, expert)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(screens, msgScreens...), nil
+}
+
+// renderMessage renders the fields of a protobuf message struct.
+func (r *textualRenderer) renderMessage(v reflect.Value, indent uint32, expert bool) ([]*signingtypes.TextualScreen, error) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot render %s as a message", v.Type())
+	}
+
+	var screens []*signingtypes.TextualScreen
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		var (
+			name  string
+			value = v.Field(i)
+		)
+
+		switch {
+		case field.Tag.Get("protobuf_oneof") != "":
+			// a oneof is an interface holding a pointer to a wrapper struct
+			// with a single field
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem().Elem()
+			if value.NumField() != 1 {
+				return nil, fmt.Errorf("unexpected oneof type %s", value.Type())
+			}
+			name = protoFieldName(value.Type().Field(0).Tag.Get("protobuf"))
+			value = value.Field(0)
+
+		case field.Tag.Get("protobuf") != "":
+			name = protoFieldName(field.Tag.Get("protobuf"))
+
+		default:
+			// skip XXX_ fields and other non protobuf fields
+			continue
+		}
+
+		fieldScreens, err := r.renderValue(fieldTitle(name), value, indent, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderValue renders a single field value, omitting default values.
+func (r *textualRenderer) renderValue(title string, v reflect.Value, indent uint32, expert bool) ([]*signingtypes.TextualScreen, error) {
+	if !v.IsValid() || v.IsZero() {
+		return nil, nil
+	}
+
+	screen := func(content string) []*signingtypes.TextualScreen {
+		return []*signingtypes.TextualScreen{{Title: title, Content: content, Indent: indent, Expert: expert}}
+	}
+
+	if v.Kind() == reflect.Ptr && v.Type().Elem() != anyType {
+		v = v.Elem()
+	}
+
+	switch v.Type() {
+	case coinType:
+		content, err := r.formatCoin(v.Interface().(sdk.Coin))
+		if err != nil {
+			return nil, err
+		}
+		return screen(content), nil
+
+	case coinsType:
+		content, err := r.formatCoins(v.Interface().(sdk.Coins))
+		if err != nil {
+			return nil, err
+		}
+		return screen(content), nil
+
+	case decCoinType:
+		return screen(formatDecCoin(v.Interface().(sdk.DecCoin))), nil
+
+	case decCoinsType:
+		decCoins := v.Interface().(sdk.DecCoins)
+		parts := make([]string, len(decCoins))
+		for i, coin := range decCoins {
+			parts[i] = formatDecCoin(coin)
+		}
+		return screen(strings.Join(parts, ", ")), nil
+
+	case intType:
+		return screen(v.Interface().(sdk.Int).String()), nil
+
+	case decType:
+		return screen(formatDec(v.Interface().(sdk.Dec))), nil
+
+	case timeType:
+		return screen(v.Interface().(time.Time).UTC().Format(time.RFC3339Nano)), nil
+
+	case durationType:
+		return screen(v.Interface().(time.Duration).String()), nil
+
+	case reflect.PtrTo(anyType):
+		return r.renderAny(title, v.Interface().(*codectypes.Any), indent, expert)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return screen(v.String()), nil
+
+	case reflect.Bool:
+		if v.Bool() {
+			return screen("True"), nil
+		}
+		return screen("False"), nil
+
+	case reflect.Int32:
+		// protobuf enums are int32 types implementing fmt.Stringer
+		if v.Type().Implements(stringerType) {
+			return screen(v.Interface().(fmt.Stringer).String()), nil
+		}
+		return screen(strconv.FormatInt(v.Int(), 10)), nil
+
+	case reflect.Int, reflect.Int64:
+		return screen(strconv.FormatInt(v.Int(), 10)), nil
+
+	case reflect.Uint32, reflect.Uint64:
+		return screen(strconv.FormatUint(v.Uint(), 10)), nil
+
+	case reflect.Float32:
+		return screen(strconv.FormatFloat(v.Float(), 'f', -1, 32)), nil
+
+	case reflect.Float64:
+		return screen(strconv.FormatFloat(v.Float(), 'f', -1, 64)), nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// addresses are byte slices with a bech32 String method
+			if v.Type() != byteSliceType && v.Type().Implements(stringerType) {
+				return screen(v.Interface().(fmt.Stringer).String()), nil
+			}
+			return screen(fmt.Sprintf("%X", v.Bytes())), nil
+		}
+		return r.renderList(title, v, indent, expert)
+
+	case reflect.Map:
+		return r.renderMap(title, v, indent, expert)
+
+	case reflect.Struct:
+		if !reflect.PtrTo(v.Type()).Implements(protoMsgType) {
+			return nil, fmt.Errorf("cannot render field %s of type %s", title, v.Type())
+		}
+
+		if !v.CanAddr() {
+			// map values are not addressable
+			addressable := reflect.New(v.Type()).Elem()
+			addressable.Set(v)
+			v = addressable
+		}
+
+		msg := v.Addr().Interface().(proto.Message)
+		screens := screen(proto.MessageName(msg))
+		msgScreens, err := r.renderMessage(v, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		return append(screens, msgScreens...), nil
+	}
+
+	return nil, fmt.Errorf("cannot render field %s of type %s", title, v.Type())
+}
+
+// renderList renders a repeated field as a screen holding the number of
+// elements, followed by each element at the next indentation level.
+func (r *textualRenderer) renderList(title string, v reflect.Value, indent uint32, expert bool) ([]*signingtypes.TextualScreen, error) {
+	n := v.Len()
+	screens := []*signingtypes.TextualScreen{{Title: title, Content: fmt.Sprintf("%d %s", n, plural(n, "element")), Indent: indent, Expert: expert}}
+
+	for i := 0; i < n; i++ {
+		elemScreens, err := r.renderValue(fmt.Sprintf("%s (%d/%d)", title, i+1, n), v.Index(i), indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, elemScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderMap renders a map field with its entries sorted by key.
+func (r *textualRenderer) renderMap(title string, v reflect.Value, indent uint32, expert bool) ([]*signingtypes.TextualScreen, error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+
+	n := len(keys)
+	screens := []*signingtypes.TextualScreen{{Title: title, Content: fmt.Sprintf("%d %s", n, plural(n, "entry")), Indent: indent, Expert: expert}}
+
+	for _, key := range keys {
+		entryScreens, err := r.renderValue(fmt.Sprint(key.Interface()), v.MapIndex(key), indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, entryScreens...)
+	}
+
+	return screens, nil
+}
+
+// formatCoins renders coins as a comma separated list, using display denoms
+// whenever coin metadata is available.
+func (r *textualRenderer) formatCoins(coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "None", nil
+	}
+
+	parts := make([]string, len(coins))
+	for i, coin := range coins {
+		part, err := r.formatCoin(coin)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = part
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// formatCoin renders a coin in its display denom, e.g. "1.5 atom" for
+// 1500000uatom, if the bank metadata of its denom defines one. Otherwise the
+// coin is rendered in its base denom, e.g. "1500000 uatom".
+func (r *textualRenderer) formatCoin(coin sdk.Coin) (string, error) {
+	baseFormat := fmt.Sprintf("%s %s", coin.Amount, coin.Denom)
+
+	metadata, err := r.coinMetadata(coin.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata == nil || metadata.Display == "" || metadata.Display == coin.Denom {
+		return baseFormat, nil
+	}
+
+	var (
+		coinExp, displayExp     uint32
+		coinFound, displayFound bool
+	)
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == coin.Denom {
+			coinExp, coinFound = unit.Exponent, true
+		}
+		if unit.Denom == metadata.Display {
+			displayExp, displayFound = unit.Exponent, true
+		}
+	}
+
+	if !coinFound || !displayFound || displayExp <= coinExp || displayExp-coinExp > sdk.Precision {
+		return baseFormat, nil
+	}
+
+	amount := sdk.NewDecFromIntWithPrec(coin.Amount, int64(displayExp-coinExp))
+	return fmt.Sprintf("%s %s", formatDec(amount), metadata.Display), nil
+}
+
+// coinMetadata returns the metadata of denom, or nil if it has none.
+func (r *textualRenderer) coinMetadata(denom string) (*banktypes.Metadata, error) {
+	if r.coinMetadataQueryFn == nil {
+		return nil, nil
+	}
+
+	if metadata, ok := r.metadata[denom]; ok {
+		return metadata, nil
+	}
+
+	metadata, err := r.coinMetadataQueryFn(r.ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	r.metadata[denom] = metadata
+	return metadata, nil
+}
+
+// formatDecCoin renders a DecCoin in its own denom.
+func formatDecCoin(coin sdk.DecCoin) string {
+	return fmt.Sprintf("%s %s", formatDec(coin.Amount), coin.Denom)
+}
+
+// formatDec renders a Dec without trailing zeros, e.g. "1.5" rather than
+// "1.500000000000000000".
+func formatDec(d sdk.Dec) string {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+
+	return s
+}
+
+// protoFieldName returns the field name from a protobuf struct tag, e.g.
+// "from_address" for `bytes,1,opt,name=from_address,json=fromAddress,proto3`.
+func protoFieldName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
@@ -76,6 +76,13 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// SigVerifyCostSecp256r1 returns the gas cost of verifying a secp256r1 signature.
+// It is derived from SigVerifyCostSecp256k1, as verifying a secp256r1 signature
+// is at least twice as fast as verifying a secp256k1 one.
+func (p Params) SigVerifyCostSecp256r1() uint64 {
+	return p.SigVerifyCostSecp256k1 / 2
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
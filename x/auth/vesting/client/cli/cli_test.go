@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -134,6 +135,305 @@ func (s *IntegrationTestSuite) TestNewMsgCreateVestingAccountCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	schedule := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 4070908800,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 31536000},
+    {"coins": "5%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+	invalidCoins := testutil.WriteToNewTempFile(s.T(), `{"start_time": 4070908800, "periods": [{"coins": "fooo", "length_seconds": 1}]}`)
+	invalidLength := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{"start_time": 4070908800, "periods": [{"coins": "10%s", "length_seconds": 0}]}`, s.cfg.BondDenom))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name:         "create a periodic vesting account",
+			args:         append([]string{sdk.AccAddress("addr5_______________").String(), schedule.Name()}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name:         "periodic vesting account already exists",
+			args:         append([]string{sdk.AccAddress("addr5_______________").String(), schedule.Name()}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 18,
+		},
+		{
+			name: "merge into a periodic vesting account",
+			args: append(
+				[]string{sdk.AccAddress("addr5_______________").String(), schedule.Name(), fmt.Sprintf("--%s=true", cli.FlagMerge)},
+				txFlags...,
+			),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "invalid address",
+			args: []string{
+				sdk.AccAddress("addr4").String(),
+				schedule.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing schedule file",
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				"missing.json",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid coins",
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				invalidCoins.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid period length",
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				invalidLength.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreatePeriodicVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	vesting := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 4070908800,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 2592000},
+    {"coins": "5%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+	lockup := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 4070908800,
+  "periods": [
+    {"coins": "15%[1]s", "length_seconds": 31536000}
+  ]
+}`, s.cfg.BondDenom))
+	otherStart := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{"start_time": 4070908801, "periods": [{"coins": "15%s", "length_seconds": 1}]}`, s.cfg.BondDenom))
+	otherAmount := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{"start_time": 4070908800, "periods": [{"coins": "10%s", "length_seconds": 1}]}`, s.cfg.BondDenom))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name: "create a clawback vesting account",
+			args: append([]string{
+				sdk.AccAddress("addr7_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockup.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+			}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "create a clawback vesting account without lockup",
+			args: append([]string{
+				sdk.AccAddress("addr8_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+			}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name: "clawback vesting account already exists",
+			args: append([]string{
+				sdk.AccAddress("addr8_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+			}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 18,
+		},
+		{
+			name: "missing vesting schedule",
+			args: []string{
+				sdk.AccAddress("addr9_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockup.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+		{
+			name: "start times do not match",
+			args: []string{
+				sdk.AccAddress("addr9_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, otherStart.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+		{
+			name: "amounts do not match",
+			args: []string{
+				sdk.AccAddress("addr9_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, otherAmount.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewMsgClawbackCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	vesting := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(
+		`{"start_time": 4070908800, "periods": [{"coins": "10%s", "length_seconds": 2592000}]}`, s.cfg.BondDenom,
+	))
+	addr := sdk.AccAddress("addr10______________")
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), append([]string{
+		addr.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagVesting, vesting.Name()),
+	}, txFlags...))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			name: "claw back to another address",
+			args: append([]string{
+				addr.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagDest, sdk.AccAddress("addr11______________")),
+			}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 0,
+		},
+		{
+			name:         "not a clawback vesting account",
+			args:         append([]string{val.Address.String()}, txFlags...),
+			expectErr:    false,
+			respType:     &sdk.TxResponse{},
+			expectedCode: 18,
+		},
+		{
+			name: "invalid destination",
+			args: []string{
+				addr.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagDest, "foo"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgClawbackCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+			}
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -15,6 +18,10 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagMerge   = "merge"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -29,6 +36,9 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -82,3 +92,205 @@ timestamp.`,
 
 	return cmd
 }
+
+// VestingData is the JSON representation of the vesting schedule of a periodic
+// vesting account.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod is the JSON representation of a vesting period.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// ReadVestingData reads the vesting schedule of a periodic vesting account from
+// the given JSON file, and returns its start time and periods.
+func ReadVestingData(path string) (int64, types.Periods, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make(types.Periods, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid coins of vesting period %d: %w", i, err)
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return data.StartTime, periods, nil
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens.
+The vesting schedule is read from a JSON file, giving the start time of the
+schedule as a UNIX epoch timestamp and its periods. Each period vests its coins
+once its length, in seconds, has elapsed since the end of the previous period.
+The account is funded with the sum of the coins of the periods. With the
+'--merge' flag, the schedule is added to the account if it already is a periodic
+vesting account.
+
+Example of a schedule with a one year cliff followed by monthly payments:
+{
+  "start_time": 1609459200,
+  "periods": [
+    {"coins": "12000stake", "length_seconds": 31536000},
+    {"coins": "1000stake", "length_seconds": 2592000},
+    {"coins": "1000stake", "length_seconds": 2592000}
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := ReadVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods, merge)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Add the vesting schedule to the account if it already is a periodic vesting account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Create a new vesting account funded with an allocation of tokens, whose
+unvested tokens can be clawed back by the sender. The account has separate
+lockup and vesting schedules, read from the JSON files given with the '--lockup'
+and '--vesting' flags, in the format of the 'create-periodic-vesting-account'
+command. Coins can only be spent once both unlocked and vested. All the coins
+are unlocked at the start time if no lockup schedule is given. The start times of
+the schedules must match, and so must the sums of the coins of their periods.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if vestingFile == "" {
+				return fmt.Errorf("a vesting schedule must be given with the --%s flag", FlagVesting)
+			}
+
+			startTime, vestingPeriods, err := ReadVestingData(vestingFile)
+			if err != nil {
+				return err
+			}
+
+			var lockupPeriods types.Periods
+			if lockupFile, _ := cmd.Flags().GetString(FlagLockup); lockupFile != "" {
+				var lockupStart int64
+				lockupStart, lockupPeriods, err = ReadVestingData(lockupFile)
+				if err != nil {
+					return err
+				}
+
+				if lockupStart != startTime {
+					return fmt.Errorf("lockup start time %d does not match vesting start time %d", lockupStart, startTime)
+				}
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the JSON file of the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to the JSON file of the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Take back the unvested tokens of a clawback vesting account.",
+		Long: `Take back the unvested tokens of a clawback vesting account. The transaction
+must be sent by the funder of the account. Unvested tokens which are delegated or
+unbonding are transferred along with their delegations. The tokens are sent to the
+funder, or to the address given with the '--dest' flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destArg, _ := cmd.Flags().GetString(FlagDest); destArg != "" {
+				dest, err = sdk.AccAddressFromBech32(destArg)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens; defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
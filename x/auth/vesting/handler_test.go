@@ -2,6 +2,7 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -10,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +26,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +94,249 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	startTime := ctx.BlockTime().Unix()
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+	}
+	grant := types.Periods{
+		{Length: 150, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 20))},
+	}
+
+	testCases := []struct {
+		name             string
+		msg              *types.MsgCreatePeriodicVestingAccount
+		expectErr        bool
+		expOrigVesting   sdk.Coins
+		expVestingPeriod types.Periods
+	}{
+		{
+			name:             "create periodic vesting account",
+			msg:              types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime, periods, false),
+			expOrigVesting:   sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			expVestingPeriod: periods,
+		},
+		{
+			name:      "periodic vesting account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime, grant, false),
+			expectErr: true,
+		},
+		{
+			name:           "merge into periodic vesting account",
+			msg:            types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime+50, grant, true),
+			expOrigVesting: sdk.NewCoins(sdk.NewInt64Coin("test", 120)),
+			expVestingPeriod: types.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 70))},
+			},
+		},
+		{
+			name:      "merge into base account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr2, addr1, startTime, grant, true),
+			expectErr: true,
+		},
+		{
+			name:             "merge into new account",
+			msg:              types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, startTime, grant, true),
+			expOrigVesting:   sdk.NewCoins(sdk.NewInt64Coin("test", 20)),
+			expVestingPeriod: grant,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, startTime, types.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 10000))},
+			}, true),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().NoError(acc.Validate())
+				suite.Require().Equal(tc.expOrigVesting, acc.GetOriginalVesting())
+				suite.Require().Equal(tc.expVestingPeriod, acc.GetVestingPeriods())
+				suite.Require().Equal(tc.expOrigVesting, acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(tc.expOrigVesting, suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 860)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	startTime := ctx.BlockTime().Unix()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	lockupPeriods := types.Periods{
+		{Length: 200, Amount: amount},
+	}
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+	}
+
+	testCases := []struct {
+		name            string
+		msg             *types.MsgCreateClawbackVestingAccount
+		expectErr       bool
+		expLockupPeriod types.Periods
+	}{
+		{
+			name:            "create clawback vesting account",
+			msg:             types.NewMsgCreateClawbackVestingAccount(addr1, addr2, startTime, lockupPeriods, vestingPeriods),
+			expLockupPeriod: lockupPeriods,
+		},
+		{
+			name:      "clawback vesting account already exists",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr2, startTime, lockupPeriods, vestingPeriods),
+			expectErr: true,
+		},
+		{
+			name:            "create clawback vesting account without lockup",
+			msg:             types.NewMsgCreateClawbackVestingAccount(addr1, addr3, startTime, nil, vestingPeriods),
+			expLockupPeriod: types.Periods{{Length: 0, Amount: amount}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().NoError(acc.Validate())
+				suite.Require().Equal(addr1, acc.GetFunder())
+				suite.Require().Equal(amount, acc.GetOriginalVesting())
+				suite.Require().Equal(tc.expLockupPeriod, types.Periods(acc.LockupPeriods))
+				suite.Require().Equal(vestingPeriods, types.Periods(acc.VestingPeriods))
+				suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 800)), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: now})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)) }
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	valAddr := sdk.ValAddress([]byte("val_________________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, coins(1000)))
+
+	// create a clawback vesting account with coins vesting every 100 seconds
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: coins(25)},
+		{Length: 100, Amount: coins(25)},
+		{Length: 100, Amount: coins(25)},
+		{Length: 100, Amount: coins(25)},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(addr1, addr2, now.Unix(), nil, vestingPeriods))
+	suite.Require().NoError(err)
+
+	// delegate 60 coins and undelegate 10 of them
+	validator := teststaking.NewValidator(suite.T(), valAddr, simapp.CreateTestPubKeys(1)[0])
+	suite.app.StakingKeeper.SetValidator(ctx, validator)
+	suite.app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+	_, err = suite.app.StakingKeeper.Delegate(ctx, addr2, sdk.NewInt(60), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+	_, err = suite.app.StakingKeeper.Undelegate(ctx, addr2, valAddr, sdk.NewDec(10))
+	suite.Require().NoError(err)
+
+	// only the funder can claw back, and only from a clawback vesting account
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second))
+	_, err = suite.handler(ctx, types.NewMsgClawback(addr3, addr2, nil))
+	suite.Require().Error(err)
+	_, err = suite.handler(ctx, types.NewMsgClawback(addr2, addr1, nil))
+	suite.Require().Error(err)
+
+	// claw back the 75 unvested coins: the 40 unbonded coins, then the 10
+	// unbonding coins, and then 25 of the delegated coins
+	res, err := suite.handler(ctx, types.NewMsgClawback(addr1, addr2, addr3))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(coins(25), acc.GetOriginalVesting())
+	suite.Require().Empty(acc.GetDelegatedVesting())
+	suite.Require().Equal(coins(25), acc.GetDelegatedFree())
+
+	suite.Require().Empty(suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewInt(25), suite.app.StakingKeeper.GetDelegatorBonded(ctx, addr2))
+	suite.Require().True(suite.app.StakingKeeper.GetDelegatorUnbonding(ctx, addr2).IsZero())
+
+	suite.Require().Equal(coins(40), suite.app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(sdk.NewInt(25), suite.app.StakingKeeper.GetDelegatorBonded(ctx, addr3))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.StakingKeeper.GetDelegatorUnbonding(ctx, addr3))
+
+	// nothing is left to claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(addr1, addr2, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(25), suite.app.StakingKeeper.GetDelegatorBonded(ctx, addr2))
+	suite.Require().Equal(coins(900), suite.app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
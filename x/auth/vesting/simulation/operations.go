@@ -0,0 +1,315 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+)
+
+const (
+	// maxVestingDuration is the maximum duration, in seconds, of the simulated
+	// vesting schedules
+	maxVestingDuration = 60 * 60 * 24 * 365
+
+	// maxVestingPeriods is the maximum number of periods of the simulated
+	// periodic vesting schedules
+	maxVestingPeriods = 12
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak keeper.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateVestingAccount         int
+		weightMsgCreatePeriodicVestingAccount int
+		weightMsgCreateClawbackVestingAccount int
+		weightMsgClawback                     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount creating
+// a continuous or delayed vesting account, funded with random coins of a random
+// simulation account.
+// nolint: interfacer
+func SimulateMsgCreateVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		amount := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, from.Address))
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, "no coins to vest"), nil, nil
+		}
+
+		if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateVestingAccount, err.Error()), nil, nil
+		}
+
+		to := simtypes.RandomAccounts(r, 1)[0]
+		endTime := ctx.BlockTime().Unix() + 1 + r.Int63n(maxVestingDuration)
+
+		msg := types.NewMsgCreateVestingAccount(from.Address, to.Address, amount, endTime, r.Intn(2) == 0)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, amount, from, chainID)
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a MsgCreatePeriodicVestingAccount
+// creating a periodic vesting account with a random schedule, funded with coins
+// of a random simulation account. The schedule is merged into an existing
+// periodic vesting account half of the time.
+// nolint: interfacer
+func SimulateMsgCreatePeriodicVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		periods, err := randomPeriods(r, bk.SpendableCoins(ctx, from.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, err.Error()), nil, nil
+		}
+
+		amount := periods.TotalAmount()
+		if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount, err.Error()), nil, nil
+		}
+
+		to, merge := simtypes.RandomAccounts(r, 1)[0].Address, false
+		if r.Intn(2) == 0 {
+			if pva, found := randomPeriodicVestingAccount(r, ctx, ak); found {
+				to, merge = pva.GetAddress(), true
+			}
+		}
+
+		// schedules may start up to a month in the past or in the future
+		startTime := ctx.BlockTime().Unix() + r.Int63n(2*60*60*24*30) - 60*60*24*30
+		if startTime <= 0 {
+			startTime = 1
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(from.Address, to, startTime, periods, merge)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, amount, from, chainID)
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a
+// MsgCreateClawbackVestingAccount creating a clawback vesting account with
+// random lockup and vesting schedules, funded with coins of a random simulation
+// account. Half of the accounts have no lockup schedule.
+// nolint: interfacer
+func SimulateMsgCreateClawbackVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		vestingPeriods, err := randomPeriods(r, bk.SpendableCoins(ctx, from.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, err.Error()), nil, nil
+		}
+
+		amount := vestingPeriods.TotalAmount()
+		if err := bk.SendEnabledCoins(ctx, amount...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, err.Error()), nil, nil
+		}
+
+		var lockupPeriods types.Periods
+		if r.Intn(2) == 0 {
+			lockupPeriods = types.Periods{{Length: r.Int63n(maxVestingDuration), Amount: amount}}
+		}
+
+		to := simtypes.RandomAccounts(r, 1)[0]
+		startTime := ctx.BlockTime().Unix()
+
+		msg := types.NewMsgCreateClawbackVestingAccount(from.Address, to.Address, startTime, lockupPeriods, vestingPeriods)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, amount, from, chainID)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback clawing back the unvested coins
+// of a random clawback vesting account funded by a simulation account, to the
+// funder or to another random simulation account.
+// nolint: interfacer
+func SimulateMsgClawback(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var accounts []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+			if va, ok := acc.(*types.ClawbackVestingAccount); ok {
+				if _, found := simtypes.FindAccount(accs, va.GetFunder()); found {
+					accounts = append(accounts, va)
+				}
+			}
+			return false
+		})
+
+		if len(accounts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no clawback vesting accounts"), nil, nil
+		}
+
+		va := accounts[r.Intn(len(accounts))]
+		funder, _ := simtypes.FindAccount(accs, va.GetFunder())
+
+		var dest sdk.AccAddress
+		if r.Intn(2) == 0 {
+			destAcc, _ := simtypes.RandomAcc(r, accs)
+			dest = destAcc.Address
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), dest)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, nil, funder, chainID)
+	}
+}
+
+// randomPeriods returns random vesting periods, vesting at most the given
+// amount of a random denom.
+func randomPeriods(r *rand.Rand, spendable sdk.Coins) (types.Periods, error) {
+	if spendable.Empty() {
+		return nil, errors.New("no coins to vest")
+	}
+
+	// split at most the spendable amount of a random denom into the periods
+	numPeriods := 1 + r.Intn(maxVestingPeriods)
+	coin := spendable[r.Intn(len(spendable))]
+	maxAmount := coin.Amount.QuoRaw(int64(numPeriods))
+	if !maxAmount.IsPositive() {
+		return nil, errors.New("not enough coins to vest")
+	}
+
+	periods := make(types.Periods, numPeriods)
+	for i := range periods {
+		amount, err := simtypes.RandPositiveInt(r, maxAmount)
+		if err != nil {
+			return nil, errors.New("unable to generate amount")
+		}
+
+		periods[i] = types.Period{
+			Length: 1 + r.Int63n(maxVestingDuration/int64(numPeriods)),
+			Amount: sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)),
+		}
+	}
+
+	return periods, nil
+}
+
+// randomPeriodicVestingAccount returns a random periodic vesting account, if
+// there is any.
+func randomPeriodicVestingAccount(r *rand.Rand, ctx sdk.Context, ak keeper.AccountKeeper) (*types.PeriodicVestingAccount, bool) {
+	var accounts []*types.PeriodicVestingAccount
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if pva, ok := acc.(*types.PeriodicVestingAccount); ok {
+			accounts = append(accounts, pva)
+		}
+		return false
+	})
+
+	if len(accounts) == 0 {
+		return nil, false
+	}
+
+	return accounts[r.Intn(len(accounts))], true
+}
+
+// genAndDeliverTx delivers a transaction with the given message, signed by the
+// given account, which pays random fees out of its spendable coins besides the
+// vested amount.
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak keeper.AccountKeeper, bk types.BankKeeper,
+	msg sdk.Msg, amount sdk.Coins, signer simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+
+	var fees sdk.Coins
+	coins, hasNeg := bk.SpendableCoins(ctx, signer.Address).SafeSub(amount)
+	if !hasNeg {
+		var err error
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "fee error"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
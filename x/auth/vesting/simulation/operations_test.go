@@ -0,0 +1,200 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Time: time.Now()})
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		err := suite.app.BankKeeper.SetBalances(suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+// beginBlock begins a new block, the operations being delivered in its state.
+func (suite *SimTestSuite) beginBlock() sdk.Context {
+	app := suite.app
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: suite.ctx.BlockTime()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return app.BaseApp.NewContext(false, header)
+}
+
+// periodicVestingAccounts returns the periodic vesting accounts of the state.
+func (suite *SimTestSuite) periodicVestingAccounts(ctx sdk.Context) []*types.PeriodicVestingAccount {
+	var accounts []*types.PeriodicVestingAccount
+	suite.app.AccountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if pva, ok := acc.(*types.PeriodicVestingAccount); ok {
+			accounts = append(accounts, pva)
+		}
+		return false
+	})
+
+	return accounts
+}
+
+// TestWeightedOperations tests the weights of the operations.
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simappparams.DefaultWeightMsgCreateVestingAccount, types.ModuleName, types.TypeMsgCreateVestingAccount},
+		{simappparams.DefaultWeightMsgCreatePeriodicVestingAccount, types.ModuleName, types.TypeMsgCreatePeriodicVestingAccount},
+		{simappparams.DefaultWeightMsgCreateClawbackVestingAccount, types.ModuleName, types.TypeMsgCreateClawbackVestingAccount},
+		{simappparams.DefaultWeightMsgClawback, types.ModuleName, types.TypeMsgClawback},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateMsgCreateVestingAccount tests the normal scenario of a valid message of type TypeMsgCreateVestingAccount.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgCreateVestingAccount() {
+	app := suite.app
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	ctx := suite.beginBlock()
+
+	// execute operation
+	op := simulation.SimulateMsgCreateVestingAccount(app.AccountKeeper, app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	require.True(operationMsg.OK)
+	require.Equal(types.TypeMsgCreateVestingAccount, operationMsg.Name)
+	require.Len(futureOperations, 0)
+}
+
+// TestSimulateMsgCreatePeriodicVestingAccount tests the normal scenario of a valid message of type
+// TypeMsgCreatePeriodicVestingAccount, creating a periodic vesting account and then merging schedules into it.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgCreatePeriodicVestingAccount() {
+	app := suite.app
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	ctx := suite.beginBlock()
+
+	// execute operations
+	op := simulation.SimulateMsgCreatePeriodicVestingAccount(app.AccountKeeper, app.BankKeeper)
+	for i := 0; i < 10; i++ {
+		operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+		require.NoError(err)
+
+		require.True(operationMsg.OK)
+		require.Equal(types.TypeMsgCreatePeriodicVestingAccount, operationMsg.Name)
+		require.Len(futureOperations, 0)
+	}
+
+	// some schedules were merged into existing accounts
+	pvas := suite.periodicVestingAccounts(app.BaseApp.NewContext(false, tmproto.Header{}))
+	require.NotEmpty(pvas)
+	require.Less(len(pvas), 10)
+	for _, pva := range pvas {
+		require.NoError(pva.Validate())
+	}
+}
+
+// TestSimulateMsgClawback tests the normal scenario of valid messages of types TypeMsgCreateClawbackVestingAccount
+// and TypeMsgClawback, creating a clawback vesting account and then clawing back its unvested coins.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgClawback() {
+	app := suite.app
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	ctx := suite.beginBlock()
+
+	// no clawback vesting account to claw back from yet
+	op := simulation.SimulateMsgClawback(app.AccountKeeper, app.BankKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+	require.False(operationMsg.OK)
+
+	// execute operations
+	op = simulation.SimulateMsgCreateClawbackVestingAccount(app.AccountKeeper, app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	require.True(operationMsg.OK)
+	require.Equal(types.TypeMsgCreateClawbackVestingAccount, operationMsg.Name)
+	require.Len(futureOperations, 0)
+
+	op = simulation.SimulateMsgClawback(app.AccountKeeper, app.BankKeeper)
+	operationMsg, futureOperations, err = op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	require.True(operationMsg.OK)
+	require.Equal(types.TypeMsgClawback, operationMsg.Name)
+	require.Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
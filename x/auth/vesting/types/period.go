@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -26,3 +28,135 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the summed length of the vesting periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the summed amount of the vesting periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// MergePeriods merges two vesting schedules, each given by its start time and
+// its periods, into a single schedule which vests at any time the sum of what
+// both schedules vest. It returns the start time, the end time and the periods
+// of the merged schedule, which starts with the earliest of both schedules.
+func MergePeriods(startTimeA int64, periodsA Periods, startTimeB int64, periodsB Periods) (int64, int64, Periods) {
+	startTime := startTimeA
+	if startTimeB < startTime {
+		startTime = startTimeB
+	}
+
+	var (
+		merged      Periods
+		i, j        int
+		timeA       = startTimeA
+		timeB       = startTimeB
+		lastVesting = startTime
+	)
+
+	// add the next period of either schedule, in order of the time at which it
+	// vests, as a period of the merged schedule
+	for i < len(periodsA) || j < len(periodsB) {
+		var (
+			vestingTime int64
+			amount      sdk.Coins
+		)
+
+		switch {
+		case j == len(periodsB) || (i < len(periodsA) && timeA+periodsA[i].Length <= timeB+periodsB[j].Length):
+			timeA += periodsA[i].Length
+			vestingTime, amount = timeA, periodsA[i].Amount
+			i++
+
+		default:
+			timeB += periodsB[j].Length
+			vestingTime, amount = timeB, periodsB[j].Amount
+			j++
+		}
+
+		// coins vesting at the same time belong to the same period
+		if len(merged) > 0 && vestingTime == lastVesting {
+			merged[len(merged)-1].Amount = merged[len(merged)-1].Amount.Add(amount...)
+			continue
+		}
+
+		merged = append(merged, Period{Length: vestingTime - lastVesting, Amount: sdk.NewCoins(amount...)})
+		lastVesting = vestingTime
+	}
+
+	return startTime, lastVesting, merged
+}
+
+// ReadSchedule returns the coins vested at the given time by the schedule of
+// the given start time and periods. The coins of a period vest once its length
+// has elapsed since the end of the previous period.
+func ReadSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	var vested sdk.Coins
+	if readTime <= startTime {
+		return vested
+	}
+
+	periodEnd := startTime
+	for _, period := range periods {
+		periodEnd += period.Length
+		if readTime < periodEnd {
+			break
+		}
+
+		vested = vested.Add(period.Amount...)
+	}
+
+	return vested
+}
+
+// CoinsMin returns the minimum amount of each denom of coinsA and coinsB.
+func CoinsMin(coinsA, coinsB sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range coinsA {
+		if amount := sdk.MinInt(coin.Amount, coinsB.AmountOf(coin.Denom)); amount.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return min
+}
+
+// capPeriods returns the periods of a schedule which vests, at any time, the
+// minimum of what the given periods vest and the given amount.
+func capPeriods(periods Periods, max sdk.Coins) Periods {
+	var (
+		capped Periods
+		length int64
+		vested = sdk.NewCoins()
+	)
+
+	for _, period := range periods {
+		length += period.Length
+		next := vested.Add(period.Amount...)
+		amount := CoinsMin(next, max).Sub(CoinsMin(vested, max))
+		vested = next
+
+		// the length of the periods vesting nothing goes to the next period
+		if amount.IsZero() {
+			continue
+		}
+
+		capped = append(capped, Period{Length: length, Amount: amount})
+		length = 0
+	}
+
+	return capped
+}
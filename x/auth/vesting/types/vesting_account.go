@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -174,6 +175,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -441,6 +444,16 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant adds a vesting schedule, given by its start time and periods, to the
+// account. The schedule is merged with the one of the account, and its coins are
+// added to the original vesting coins. The delegated vesting and delegated free
+// coins are left as is: since the merged schedule vests the coins of the account
+// at the same times as before, it never unlocks coins that were locked.
+func (pva *PeriodicVestingAccount) AddGrant(startTime int64, periods Periods) {
+	pva.StartTime, pva.EndTime, pva.VestingPeriods = MergePeriods(pva.StartTime, pva.VestingPeriods, startTime, periods)
+	pva.OriginalVesting = pva.OriginalVesting.Add(periods.TotalAmount()...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -570,3 +583,212 @@ func (dva DelayedVestingAccount) String() string {
 	out, _ := dva.MarshalYAML()
 	return out.(string)
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64,
+	lockupPeriods, vestingPeriods Periods,
+) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         clawbackEndTime(startTime, lockupPeriods, vestingPeriods),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// clawbackEndTime returns the end time of the latest of the lockup and vesting
+// schedules.
+func clawbackEndTime(startTime int64, lockupPeriods, vestingPeriods Periods) int64 {
+	lockupEnd := startTime + lockupPeriods.TotalLength()
+	vestingEnd := startTime + vestingPeriods.TotalLength()
+	if lockupEnd > vestingEnd {
+		return lockupEnd
+	}
+
+	return vestingEnd
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule, regardless
+// of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule, regardless
+// of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// GetVestedCoins returns the total number of vested coins, which are the coins
+// both unlocked and vested. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	coins := CoinsMin(va.GetUnlockedOnly(blockTime), va.GetVestedOnly(blockTime))
+	if coins.IsZero() {
+		return nil
+	}
+
+	return coins
+}
+
+// GetVestingCoins returns the total number of vesting coins, which are the
+// coins either locked or not vested. If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the funder of the account, which can claw
+// back its unvested coins.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(va.FunderAddress)
+	return funder
+}
+
+// ComputeClawback removes from the account the coins which have not vested at
+// the given time, and returns them. The vesting schedule is truncated to the
+// periods which have vested, and the lockup schedule capped at the coins left.
+// The delegated vesting and delegated free coins must then be updated with
+// UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	// keep the periods vested at the given time, like ReadSchedule
+	vestedPeriods := 0
+	if clawbackTime > va.StartTime {
+		periodEnd := va.StartTime
+		for _, period := range va.VestingPeriods {
+			periodEnd += period.Length
+			if clawbackTime < periodEnd {
+				break
+			}
+
+			vestedPeriods++
+		}
+	}
+
+	vestingPeriods := make(Periods, vestedPeriods)
+	copy(vestingPeriods, va.VestingPeriods)
+	vested := vestingPeriods.TotalAmount()
+	unvested := va.OriginalVesting.Sub(vested)
+
+	va.OriginalVesting = vested
+	va.VestingPeriods = vestingPeriods
+	va.LockupPeriods = capPeriods(va.LockupPeriods, vested)
+	va.EndTime = clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods)
+
+	return unvested
+}
+
+// UpdateDelegation updates the delegated vesting and delegated free coins of
+// the account for a clawback, given the encumbered coins, which are the coins
+// still vesting once the clawback is computed, and the bonded, unbonding and
+// unbonded coins of the account. It returns the coins to claw back, capped at
+// the coins of the account. The coins lost to slashing since the delegations
+// were tracked remain delegated, and the delegated coins left once the
+// unbonded coins are clawed back first are vesting up to the encumbered coins.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(CoinsMin(delegated, oldDelegated))
+	total := delegated.Add(unbonded...)
+
+	toClawBack = CoinsMin(toClawBack, total)
+	newDelegated := CoinsMin(delegated, total.Sub(toClawBack)).Add(slashed...)
+
+	va.DelegatedVesting = CoinsMin(encumbered, newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.EndTime != clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods) {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
@@ -578,6 +578,322 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// delegate all the coins
+	pva.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, pva.DelegatedVesting)
+
+	// add a grant starting after 6 hours, vesting after 12 and 30 hours
+	grant := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 10)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+	}
+	pva.AddGrant(now.Add(6*time.Hour).Unix(), grant)
+	require.NoError(t, pva.Validate())
+
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 130)}, pva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 60)}},
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+	}, pva.VestingPeriods)
+
+	// the delegations are left as is
+	require.Equal(t, origCoins, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	// the coins of both schedules vest at the same times as before
+	require.Nil(t, pva.GetVestedCoins(now.Add(11*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 60)}, pva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 110)}, pva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, pva.OriginalVesting, pva.GetVestedCoins(now.Add(30*time.Hour)))
+
+	// the granted coins are locked until they vest
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 30)}, pva.LockedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), pva.LockedCoins(now.Add(12*time.Hour)))
+
+	// a grant starting before the account starts its schedule earlier
+	pva.AddGrant(now.Add(-12*time.Hour).Unix(), types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 5)}},
+	})
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Add(-12*time.Hour).Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 5)}, pva.GetVestedCoins(now))
+}
+
+func TestMergePeriods(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amount)} }
+
+	testCases := []struct {
+		name             string
+		startA, startB   int64
+		periodsA         types.Periods
+		periodsB         types.Periods
+		expStart, expEnd int64
+		expMergedPeriods types.Periods
+	}{
+		{
+			name:             "no periods",
+			startA:           10,
+			startB:           20,
+			expStart:         10,
+			expEnd:           10,
+			expMergedPeriods: nil,
+		},
+		{
+			name:             "one schedule",
+			startA:           10,
+			startB:           20,
+			periodsA:         types.Periods{{Length: 5, Amount: coins(1)}, {Length: 5, Amount: coins(2)}},
+			expStart:         10,
+			expEnd:           20,
+			expMergedPeriods: types.Periods{{Length: 5, Amount: coins(1)}, {Length: 5, Amount: coins(2)}},
+		},
+		{
+			name:             "disjoint schedules",
+			startA:           20,
+			startB:           0,
+			periodsA:         types.Periods{{Length: 5, Amount: coins(1)}},
+			periodsB:         types.Periods{{Length: 5, Amount: coins(2)}, {Length: 5, Amount: coins(3)}},
+			expStart:         0,
+			expEnd:           25,
+			expMergedPeriods: types.Periods{{Length: 5, Amount: coins(2)}, {Length: 5, Amount: coins(3)}, {Length: 15, Amount: coins(1)}},
+		},
+		{
+			name:             "interleaved schedules",
+			startA:           0,
+			startB:           3,
+			periodsA:         types.Periods{{Length: 5, Amount: coins(1)}, {Length: 5, Amount: coins(2)}},
+			periodsB:         types.Periods{{Length: 4, Amount: coins(3)}, {Length: 4, Amount: coins(4)}},
+			expStart:         0,
+			expEnd:           11,
+			expMergedPeriods: types.Periods{{Length: 5, Amount: coins(1)}, {Length: 2, Amount: coins(3)}, {Length: 3, Amount: coins(2)}, {Length: 1, Amount: coins(4)}},
+		},
+		{
+			name:             "simultaneous vesting",
+			startA:           0,
+			startB:           0,
+			periodsA:         types.Periods{{Length: 5, Amount: coins(1)}},
+			periodsB:         types.Periods{{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 2)}}},
+			expStart:         0,
+			expEnd:           5,
+			expMergedPeriods: types.Periods{{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 2), sdk.NewInt64Coin(stakeDenom, 1)}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, merged := types.MergePeriods(tc.startA, tc.periodsA, tc.startB, tc.periodsB)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expMergedPeriods, merged)
+			require.Equal(t, end-start, merged.TotalLength())
+			require.True(t, tc.periodsA.TotalAmount().Add(tc.periodsB.TotalAmount()...).IsEqual(merged.TotalAmount()))
+
+			// merging is symmetric
+			start, end, merged = types.MergePeriods(tc.startB, tc.periodsB, tc.startA, tc.periodsA)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+			require.Equal(t, tc.expMergedPeriods, merged)
+		})
+	}
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	// the coins unlock after 12 hours, and vest every 6 hours
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: origCoins},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+	require.Equal(t, funder, va.GetFunder())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require no coins vested while they are locked, even if vested
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, va.GetUnlockedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require the vested coins once unlocked
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Empty(t, va.GetVestingCoins(now.Add(24*time.Hour)))
+}
+
+func TestComputeClawback(t *testing.T) {
+	now := tmtime.Now()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 100)}
+
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(5 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+
+	// claw back after the first vesting period
+	unvested := va.ComputeClawback(now.Add(2 * time.Hour).Unix())
+	require.NoError(t, va.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 10), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: int64(1 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}, va.VestingPeriods)
+
+	// the lockup schedule keeps unlocking the vested coins at the same time
+	require.Equal(t, []types.Period{
+		{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}, va.LockupPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.Nil(t, va.GetVestedCoins(now.Add(11*time.Hour)))
+	require.Equal(t, va.OriginalVesting, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// nothing is left to claw back once all coins vested
+	require.Empty(t, va.ComputeClawback(now.Add(2*time.Hour).Unix()))
+
+	// everything is clawed back before the start time
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins, va.ComputeClawback(now.Unix()))
+	require.Empty(t, va.OriginalVesting)
+	require.Empty(t, va.LockupPeriods)
+	require.Empty(t, va.VestingPeriods)
+	require.Equal(t, now.Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	coins := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amount)} }
+
+	testCases := []struct {
+		name          string
+		encumbered    sdk.Coins
+		toClawBack    sdk.Coins
+		bonded        sdk.Coins
+		unbonding     sdk.Coins
+		unbonded      sdk.Coins
+		expClawBack   sdk.Coins
+		expDelVesting sdk.Coins
+		expDelFree    sdk.Coins
+	}{
+		{
+			name:          "claw back the unbonded coins first",
+			encumbered:    coins(0),
+			toClawBack:    coins(75),
+			bonded:        coins(50),
+			unbonding:     coins(10),
+			unbonded:      coins(40),
+			expClawBack:   coins(75),
+			expDelVesting: sdk.Coins{},
+			expDelFree:    coins(25),
+		},
+		{
+			name:          "delegated coins left are vesting up to the encumbered coins",
+			encumbered:    coins(20),
+			toClawBack:    coins(30),
+			bonded:        coins(60),
+			unbonding:     coins(0),
+			unbonded:      coins(40),
+			expClawBack:   coins(30),
+			expDelVesting: coins(20),
+			expDelFree:    coins(40),
+		},
+		{
+			name:          "slashed coins remain delegated",
+			encumbered:    coins(20),
+			toClawBack:    coins(75),
+			bonded:        coins(40),
+			unbonding:     coins(10),
+			unbonded:      coins(40),
+			expClawBack:   coins(75),
+			expDelVesting: coins(20),
+			expDelFree:    coins(5),
+		},
+		{
+			name:          "no more than the coins of the account are clawed back",
+			encumbered:    coins(0),
+			toClawBack:    coins(100),
+			bonded:        coins(30),
+			unbonding:     coins(0),
+			unbonded:      coins(40),
+			expClawBack:   coins(70),
+			expDelVesting: sdk.Coins{},
+			expDelFree:    coins(30),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, addr := testdata.KeyTestPubAddr()
+			_, _, funder := testdata.KeyTestPubAddr()
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, funder, coins(100), now.Unix(),
+				types.Periods{{Length: 0, Amount: coins(100)}},
+				types.Periods{{Length: 100, Amount: coins(100)}},
+			)
+
+			// delegate 60 vesting coins
+			va.TrackDelegation(now, coins(100), coins(60))
+			require.Equal(t, coins(60), va.DelegatedVesting)
+
+			toClawBack := va.UpdateDelegation(tc.encumbered, tc.toClawBack, tc.bonded, tc.unbonding, tc.unbonded)
+			require.True(t, tc.expClawBack.IsEqual(toClawBack), toClawBack)
+			require.True(t, tc.expDelVesting.IsEqual(va.DelegatedVesting), va.DelegatedVesting)
+			require.True(t, tc.expDelFree.IsEqual(va.DelegatedFree), va.DelegatedFree)
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -633,6 +949,27 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,3 +1044,24 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), types.Periods{types.Period{1800, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
@@ -0,0 +1,326 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	grantee sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic("grantee", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	s.grantee = info.GetAddress()
+
+	// the grantee pays the fees of the transactions it executes
+	_, err = banktestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		s.grantee,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(200))),
+		commonFlags(s.cfg)...,
+	)
+	s.Require().NoError(err)
+
+	out, err := s.execGrant(val, []string{
+		s.grantee.String(),
+		"send",
+		fmt.Sprintf("--%s=100%s", cli.FlagSpendLimit, s.cfg.BondDenom),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+	})
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func commonFlags(cfg network.Config) []string {
+	return []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+}
+
+func (s *IntegrationTestSuite) execGrant(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	args = append(args, commonFlags(s.cfg)...)
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdGrantAuthorization(), args)
+}
+
+func (s *IntegrationTestSuite) TestQueryAuthorization() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid granter address",
+			[]string{"invalid", s.grantee.String(), types.SendAuthorizationMethodName, fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"invalid grantee address",
+			[]string{val.Address.String(), "invalid", types.SendAuthorizationMethodName, fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"no authorization for method",
+			[]string{val.Address.String(), s.grantee.String(), "/cosmos.gov.v1beta1.Msg/SubmitProposal", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"valid query",
+			[]string{val.Address.String(), s.grantee.String(), types.SendAuthorizationMethodName, fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryAuthorization(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var grant types.AuthorizationGrant
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &grant), out.String())
+			s.Require().Equal(types.SendAuthorizationMethodName, grant.GetAuthorizationGrant().MethodName())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryAuthorizations() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid granter address",
+			[]string{"invalid", s.grantee.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"invalid grantee address",
+			[]string{val.Address.String(), "invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"valid query",
+			[]string{val.Address.String(), s.grantee.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryAuthorizations(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res types.QueryAuthorizationsResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().NotEmpty(res.Authorizations)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdGrantAuthorization() {
+	val := s.network.Validators[0]
+	from := fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid grantee address",
+			[]string{"invalid", "send", fmt.Sprintf("--%s=100%s", cli.FlagSpendLimit, s.cfg.BondDenom), from},
+			true, 0,
+		},
+		{
+			"invalid authorization type",
+			[]string{s.grantee.String(), "invalid", from},
+			true, 0,
+		},
+		{
+			"send authorization without spend limit",
+			[]string{s.grantee.String(), "send", from},
+			true, 0,
+		},
+		{
+			"expiration in the past",
+			[]string{s.grantee.String(), "generic", fmt.Sprintf("--%s=/cosmos.gov.v1beta1.Msg/Vote", cli.FlagMsgType), fmt.Sprintf("--%s=%d", cli.FlagExpiration, time.Now().Add(-time.Hour).Unix()), from},
+			false, types.ErrInvalidExpirationTime.ABCICode(),
+		},
+		{
+			"valid generic authorization",
+			[]string{s.grantee.String(), "generic", fmt.Sprintf("--%s=/cosmos.gov.v1beta1.Msg/Vote", cli.FlagMsgType), from},
+			false, 0,
+		},
+		{
+			"delegate authorization without validators",
+			[]string{s.grantee.String(), "delegate", from},
+			true, 0,
+		},
+		{
+			"valid delegate authorization",
+			[]string{s.grantee.String(), "delegate", fmt.Sprintf("--%s=%s", cli.FlagAllowedValidators, val.ValAddress), fmt.Sprintf("--%s=50%s", cli.FlagSpendLimit, s.cfg.BondDenom), from},
+			false, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := s.execGrant(val, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var txResp sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdExecAuthorization() {
+	val := s.network.Validators[0]
+	receiver := sdk.AccAddress("receiver____________")
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	generateOnly := []string{fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)}
+	legacyTx, err := banktestutil.MsgSendExec(val.ClientCtx, val.Address, receiver, amount, generateOnly...)
+	s.Require().NoError(err)
+	serviceMsgTx, err := banktestutil.ServiceMsgSendExec(val.ClientCtx, val.Address, receiver, amount, generateOnly...)
+	s.Require().NoError(err)
+	overLimit := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000)))
+	overLimitTx, err := banktestutil.MsgSendExec(val.ClientCtx, val.Address, receiver, overLimit, generateOnly...)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		txJSON       string
+		expectedCode uint32
+	}{
+		{"exec legacy msg within the spend limit", legacyTx.String(), 0},
+		{"exec service msg within the spend limit", serviceMsgTx.String(), 0},
+		{"exec above the spend limit", overLimitTx.String(), sdkerrors.ErrInsufficientFunds.ABCICode()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			txFile := testutil.WriteToNewTempFile(s.T(), tc.txJSON)
+			args := append([]string{txFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagFrom, s.grantee)}, commonFlags(s.cfg)...)
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdExecAuthorization(), args)
+			s.Require().NoError(err)
+
+			var txResp sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdRevokeAuthorization() {
+	val := s.network.Validators[0]
+	from := fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)
+	msgDeposit := "/cosmos.gov.v1beta1.Msg/Deposit"
+
+	_, err := s.execGrant(val, []string{s.grantee.String(), "generic", fmt.Sprintf("--%s=%s", cli.FlagMsgType, msgDeposit), from})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{"invalid grantee address", []string{"invalid", msgDeposit, from}, true, 0},
+		{"missing authorization", []string{s.grantee.String(), "/cosmos.gov.v1beta1.Msg/SubmitProposal", from}, false, types.ErrNoAuthorizationFound.ABCICode()},
+		{"valid revoke", []string{s.grantee.String(), msgDeposit, from}, false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := append(tc.args, commonFlags(s.cfg)...)
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdRevokeAuthorization(), args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var txResp sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
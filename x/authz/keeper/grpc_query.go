@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorization returns the authorization granted to the grantee by the granter
+// for the given method name.
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MethodName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty method-name")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorization, expiration := k.GetCleanAuthorization(ctx, grantee, granter, req.MethodName)
+	if authorization == nil {
+		return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MethodName)
+	}
+
+	authorizationGrant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationResponse{
+		Authorization: &authorizationGrant,
+	}, nil
+}
+
+// Authorizations returns the list of authorizations granted to the grantee by
+// the granter.
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	authStore := prefix.NewStore(store, types.GetAuthorizationsPrefix(grantee, granter))
+
+	var authorizations []*types.AuthorizationGrant
+	pageRes, err := query.FilteredPaginate(authStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var grant types.AuthorizationGrant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return false, err
+		}
+
+		// expired authorizations are pruned on access, so skip them here
+		if grant.Expiration.Before(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			authorizations = append(authorizations, &grant)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationsResponse{
+		Authorizations: authorizations,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func (s *TestSuite) TestGRPCQueryAuthorization() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	var (
+		req              *types.QueryAuthorizationRequest
+		expAuthorization types.Authorization
+	)
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		postTest func(res *types.QueryAuthorizationResponse)
+	}{
+		{
+			"fail invalid granter addr",
+			func() {
+				req = &types.QueryAuthorizationRequest{MethodName: types.SendAuthorizationMethodName}
+			},
+			false,
+			func(res *types.QueryAuthorizationResponse) {},
+		},
+		{
+			"fail invalid grantee addr",
+			func() {
+				req = &types.QueryAuthorizationRequest{
+					Granter:    addrs[0].String(),
+					MethodName: types.SendAuthorizationMethodName,
+				}
+			},
+			false,
+			func(res *types.QueryAuthorizationResponse) {},
+		},
+		{
+			"fail empty method name",
+			func() {
+				req = &types.QueryAuthorizationRequest{
+					Granter: addrs[0].String(),
+					Grantee: addrs[1].String(),
+				}
+			},
+			false,
+			func(res *types.QueryAuthorizationResponse) {},
+		},
+		{
+			"fail no authorization found",
+			func() {
+				req = &types.QueryAuthorizationRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MethodName: types.SendAuthorizationMethodName,
+				}
+			},
+			false,
+			func(res *types.QueryAuthorizationResponse) {},
+		},
+		{
+			"Success",
+			func() {
+				expAuthorization = types.NewSendAuthorization(someCoins)
+				err := app.AuthzKeeper.Grant(ctx, addrs[1], addrs[0], expAuthorization, oneHour)
+				s.Require().NoError(err)
+				req = &types.QueryAuthorizationRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MethodName: types.SendAuthorizationMethodName,
+				}
+			},
+			true,
+			func(res *types.QueryAuthorizationResponse) {
+				s.Require().Equal(expAuthorization.String(), res.Authorization.GetAuthorizationGrant().String())
+				s.Require().Equal(oneHour, res.Authorization.Expiration)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			tc.malleate()
+			result, err := queryClient.Authorization(gocontext.Background(), req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(result)
+			} else {
+				s.Require().Error(err)
+			}
+			tc.postTest(result)
+		})
+	}
+}
+
+func (s *TestSuite) TestGRPCQueryAuthorizations() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	var req *types.QueryAuthorizationsRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		postTest func(res *types.QueryAuthorizationsResponse)
+	}{
+		{
+			"fail invalid granter addr",
+			func() {
+				req = &types.QueryAuthorizationsRequest{}
+			},
+			false,
+			func(res *types.QueryAuthorizationsResponse) {},
+		},
+		{
+			"fail invalid grantee addr",
+			func() {
+				req = &types.QueryAuthorizationsRequest{
+					Granter: addrs[0].String(),
+				}
+			},
+			false,
+			func(res *types.QueryAuthorizationsResponse) {},
+		},
+		{
+			"no authorizations",
+			func() {
+				req = &types.QueryAuthorizationsRequest{
+					Granter: addrs[0].String(),
+					Grantee: addrs[1].String(),
+				}
+			},
+			true,
+			func(res *types.QueryAuthorizationsResponse) {
+				s.Require().Empty(res.Authorizations)
+			},
+		},
+		{
+			"paginated authorizations",
+			func() {
+				err := app.AuthzKeeper.Grant(ctx, addrs[1], addrs[0], types.NewSendAuthorization(someCoins), oneHour)
+				s.Require().NoError(err)
+				err = app.AuthzKeeper.Grant(ctx, addrs[1], addrs[0], types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/Vote"), oneHour)
+				s.Require().NoError(err)
+				req = &types.QueryAuthorizationsRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+			},
+			true,
+			func(res *types.QueryAuthorizationsResponse) {
+				s.Require().Len(res.Authorizations, 1)
+				s.Require().Equal(uint64(2), res.Pagination.Total)
+			},
+		},
+		{
+			"expired authorizations are skipped",
+			func() {
+				err := app.AuthzKeeper.Grant(ctx, addrs[2], addrs[0], types.NewSendAuthorization(someCoins), now.Add(-1))
+				s.Require().NoError(err)
+				req = &types.QueryAuthorizationsRequest{
+					Granter: addrs[0].String(),
+					Grantee: addrs[2].String(),
+				}
+			},
+			true,
+			func(res *types.QueryAuthorizationsResponse) {
+				s.Require().Empty(res.Authorizations)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			tc.malleate()
+			result, err := queryClient.Authorizations(sdk.WrapSDKContext(ctx), req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(result)
+			} else {
+				s.Require().Error(err)
+			}
+			tc.postTest(result)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations granted between accounts and dispatches
+// messages executed on behalf of a granter.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getAuthorizationGrant returns grant between granter and grantee for the given method name.
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, grantStoreKey []byte) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(grantStoreKey)
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated types.Authorization) error {
+	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, updated.MethodName())
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, "authorization not found")
+	}
+
+	msg, ok := updated.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", updated)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	grant.Authorization = any
+	store := ctx.KVStore(k.storeKey)
+	store.Set(grantStoreKey, k.cdc.MustMarshalBinaryBare(&grant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee. The events emitted by the
+// executed messages are added to the context's event manager.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, serviceMsgs []sdk.ServiceMsg) (*sdk.Result, error) {
+	txMsgData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, 0, len(serviceMsgs)),
+	}
+	events := sdk.EmptyEvents()
+
+	for i, serviceMsg := range serviceMsgs {
+		signers := serviceMsg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization can be given to msg with only one signer")
+		}
+		granter := signers[0]
+
+		// if granter != grantee then check authorization.Accept, otherwise we
+		// implicitly accept.
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, serviceMsg.MethodName)
+			if authorization == nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+
+			updated, del, err := authorization.Accept(ctx, serviceMsg)
+			if err != nil {
+				return nil, err
+			}
+
+			if del {
+				err = k.Revoke(ctx, grantee, granter, serviceMsg.MethodName)
+			} else if updated != nil {
+				err = k.update(ctx, grantee, granter, updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		handler := k.router.Handler(serviceMsg.MethodName)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s; message index: %d", serviceMsg.MethodName, i)
+		}
+
+		msgResult, err := handler(ctx, serviceMsg.Request)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		for _, event := range msgResult.GetEvents() {
+			events = events.AppendEvent(sdk.Event(event))
+		}
+		txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: serviceMsg.MethodName, Data: msgResult.Data})
+	}
+
+	data, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal msg data")
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return &sdk.Result{
+		Data:   data,
+		Events: events.ToABCIEvents(),
+	}, nil
+}
+
+// Grant method grants the provided authorization to the grantee on the granter's account with the provided expiration
+// time. If there is an existing authorization grant for the same method name, it will be overwritten.
+func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	methodName := authorization.MethodName()
+	store.Set(types.GetAuthorizationStoreKey(grantee, granter, methodName), bz)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventGrantAuthorization,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyGrantType, methodName),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+	return nil
+}
+
+// Revoke method revokes any authorization for the provided method name granted to the grantee by the granter.
+func (k Keeper) Revoke(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) error {
+	store := ctx.KVStore(k.storeKey)
+	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, methodName)
+	_, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, "authorization not found")
+	}
+	store.Delete(grantStoreKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRevokeAuthorization,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyGrantType, methodName),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+	return nil
+}
+
+// GetAuthorizations returns all the authorizations granted to the grantee by
+// the granter.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []types.Authorization) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAuthorizationsPrefix(grantee, granter))
+	defer iter.Close()
+
+	var authorization types.AuthorizationGrant
+	for ; iter.Valid(); iter.Next() {
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &authorization)
+		authorizations = append(authorizations, authorization.GetAuthorizationGrant())
+	}
+	return authorizations
+}
+
+// GetCleanAuthorization returns an `Authorization` and it's expiration time for
+// (grantee, granter, method name) grant. If there is no grant `nil` is returned.
+// If the grant is expired, the grant is revoked, removed from the storage, and `nil` is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) (types.Authorization, time.Time) {
+	grant, found := k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(grantee, granter, methodName))
+	if !found {
+		return nil, time.Time{}
+	}
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		k.Revoke(ctx, grantee, granter, methodName)
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants
+func (k Keeper) IterateGrants(ctx sdk.Context,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		granterAddr, granteeAddr := types.ExtractAddressesFromGrantKey(iter.Key())
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if handler(granterAddr, granteeAddr, grant) {
+			break
+		}
+	}
+}
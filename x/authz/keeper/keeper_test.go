@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	now          = time.Now().UTC()
+	oneHour      = now.Add(time.Hour)
+	oneYear      = now.AddDate(1, 0, 0)
+	someCoins    = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 123))
+	tenStake     = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	initialFunds = sdk.NewInt(30000000)
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = queryClient
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, initialFunds)
+}
+
+func (s *TestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Nil(authorization)
+	s.Require().Zero(expiration)
+
+	s.T().Log("verify save, get and delete")
+	x := types.NewSendAuthorization(someCoins)
+	err := app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, oneHour)
+	s.Require().NoError(err)
+
+	authorization, expiration = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(oneHour, expiration)
+	s.Require().Equal(authorization.MethodName(), types.SendAuthorizationMethodName)
+
+	err = app.AuthzKeeper.Revoke(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().NoError(err)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoking a missing authorization fails")
+	err = app.AuthzKeeper.Revoke(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Error(err)
+
+	s.T().Log("verify granting same authorization overwrites existing authorization")
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, oneHour)
+	s.Require().NoError(err)
+
+	y := types.NewSendAuthorization(tenStake)
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, y, oneYear)
+	s.Require().NoError(err)
+
+	authorization, expiration = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(oneYear, expiration)
+	s.Require().Equal(y.String(), authorization.String())
+
+	s.T().Log("verify expired authorization is removed")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx.WithBlockTime(oneYear.Add(time.Second)), granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify authorizations of a different recipient are isolated")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, recipientAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Nil(authorization)
+}
+
+func (s *TestSuite) TestKeeperIter() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+
+	s.T().Log("verify that no authorization returns nil")
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	s.Require().Empty(authorizations)
+
+	x := types.NewSendAuthorization(someCoins)
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, x, oneHour))
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/Vote"), oneHour))
+
+	authorizations = app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	s.Require().Len(authorizations, 2)
+
+	var count int
+	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		s.Require().Equal(granterAddr, granter)
+		s.Require().Equal(granteeAddr, grantee)
+		count++
+		return false
+	})
+	s.Require().Equal(2, count)
+}
+
+func (s *TestSuite) TestKeeperDispatchActions() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+
+	sendMsg := func(amount sdk.Coins) []sdk.ServiceMsg {
+		return []sdk.ServiceMsg{{
+			MethodName: types.SendAuthorizationMethodName,
+			Request:    banktypes.NewMsgSend(granterAddr, recipientAddr, amount),
+		}}
+	}
+
+	s.T().Log("verify dispatch fails with no authorization")
+	result, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(tenStake))
+	s.Require().Nil(result)
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch fails with an expired authorization")
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, types.NewSendAuthorization(someCoins), oneHour)
+	s.Require().NoError(err)
+
+	result, err = app.AuthzKeeper.DispatchActions(ctx.WithBlockTime(oneHour.Add(time.Second)), granteeAddr, sendMsg(tenStake))
+	s.Require().Nil(result)
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch executes the message and updates the authorization")
+	err = app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, types.NewSendAuthorization(someCoins), oneHour)
+	s.Require().NoError(err)
+
+	result, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(tenStake))
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(initialFunds.AddRaw(10), app.BankKeeper.GetBalance(ctx, recipientAddr, sdk.DefaultBondDenom).Amount)
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(types.NewSendAuthorization(someCoins.Sub(tenStake)).String(), authorization.String())
+
+	s.T().Log("verify dispatch fails when spending more than the limit")
+	result, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(someCoins))
+	s.Require().Nil(result)
+	s.Require().Error(err)
+
+	s.T().Log("verify the authorization is removed once the limit is spent")
+	result, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(someCoins.Sub(tenStake)))
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.SendAuthorizationMethodName)
+	s.Require().Nil(authorization)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the authz MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Grant implements the MsgServer.Grant method.
+func (k msgServer) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "authorization is not set")
+	}
+
+	// If the authorized method doesn't exist, we can't grant it.
+	methodName := authorization.MethodName()
+	if k.router.Handler(methodName) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist", methodName)
+	}
+
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, types.ErrInvalidExpirationTime
+	}
+
+	err = k.Keeper.Grant(ctx, grantee, granter, authorization, msg.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantResponse{}, nil
+}
+
+// Revoke implements the MsgServer.Revoke method.
+func (k msgServer) Revoke(goCtx context.Context, msg *types.MsgRevoke) (*types.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.Revoke(ctx, grantee, granter, msg.MethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetServiceMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := k.DispatchActions(ctx, grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventExecuteAuthorization,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, msg.Grantee),
+		),
+	)
+
+	return &types.MsgExecResponse{Result: result}, nil
+}
@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// genAuthorizationGrants returns a slice of randomly generated authorization grants.
+func genAuthorizationGrants(r *rand.Rand, accounts []simtypes.Account, genTime time.Time) []types.GrantAuthorization {
+	authorizations := make([]types.GrantAuthorization, len(accounts)-1)
+	for i := 0; i < len(accounts)-1; i++ {
+		granter := accounts[i]
+		grantee := accounts[i+1]
+		authorizations[i] = types.GrantAuthorization{
+			Granter:       granter.Address.String(),
+			Grantee:       grantee.Address.String(),
+			Authorization: generateRandomGrant(r),
+			Expiration:    genTime.AddDate(0, 0, 1+r.Intn(5)).UTC(),
+		}
+	}
+
+	return authorizations
+}
+
+func generateRandomGrant(r *rand.Rand) *codectypes.Any {
+	authorizations := make([]*codectypes.Any, 2)
+	authorizations[0] = newAnyAuthorization(types.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))))
+	authorizations[1] = newAnyAuthorization(types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/SubmitProposal"))
+
+	return authorizations[r.Intn(len(authorizations))]
+}
+
+func newAnyAuthorization(a types.Authorization) *codectypes.Any {
+	any, err := codectypes.NewAnyWithValue(a)
+	if err != nil {
+		panic(err)
+	}
+
+	return any
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.GrantAuthorization
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, "authz", &grants, simState.Rand,
+		func(r *rand.Rand) { grants = genAuthorizationGrants(r, simState.Accounts, simState.GenTimestamp) },
+	)
+
+	authzGrantsGenesis := types.NewGenesisState(grants)
+	bz, err := simState.Cdc.MarshalJSON(authzGrantsGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
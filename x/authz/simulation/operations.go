@@ -0,0 +1,279 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrant  = "op_weight_msg_grant"
+	OpWeightMsgRevoke = "op_weight_msg_revoke"
+	OpWeightMsgExec   = "op_weight_msg_exec"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrant  int
+		weightMsgRevoke int
+		weightMsgExec   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrant, &weightMsgGrant, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrant = simappparams.DefaultWeightMsgGrant
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevoke, &weightMsgRevoke, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevoke = simappparams.DefaultWeightMsgRevoke
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrant,
+			SimulateMsgGrant(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevoke,
+			SimulateMsgRevoke(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant with random values.
+// nolint: interfacer
+func SimulateMsgGrant(ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "granter and grantee are same"), nil, nil
+		}
+
+		granterAcc := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, granter.Address)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, err.Error()), nil, err
+		}
+
+		spendLimit := spendableCoins.Sub(fees)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "spend limit is empty"), nil, nil
+		}
+
+		expiration := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 365*24)) * time.Hour)
+		msg, err := types.NewMsgGrant(granter.Address, grantee.Address, generateRandomAuthorization(r, spendLimit), expiration)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{granterAcc.GetAccountNumber()},
+			[]uint64{granterAcc.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrant, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
+
+func generateRandomAuthorization(r *rand.Rand, spendLimit sdk.Coins) types.Authorization {
+	authorizations := make([]types.Authorization, 2)
+	authorizations[0] = types.NewSendAuthorization(spendLimit)
+	authorizations[1] = types.NewGenericAuthorization(types.SendAuthorizationMethodName)
+
+	return authorizations[r.Intn(len(authorizations))]
+}
+
+// SimulateMsgRevoke generates a MsgRevoke with random values.
+// nolint: interfacer
+func SimulateMsgRevoke(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var granterAddr, granteeAddr sdk.AccAddress
+		var grant types.AuthorizationGrant
+		hasGrant := false
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, g types.AuthorizationGrant) bool {
+			grant = g
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "no grants"), nil, nil
+		}
+
+		granter, ok := simtypes.FindAccount(accs, granterAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "account not found"), nil, nil
+		}
+
+		granterAcc := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, granter.Address)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, "fee error"), nil, err
+		}
+
+		msg := types.NewMsgRevoke(granterAddr, granteeAddr, grant.GetAuthorizationGrant().MethodName())
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{granterAcc.GetAccountNumber()},
+			[]uint64{granterAcc.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevoke, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec with random values.
+// nolint: interfacer
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var granterAddr, granteeAddr sdk.AccAddress
+		var sendAuth *types.SendAuthorization
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+			if grant.Expiration.Before(ctx.BlockTime()) {
+				return false
+			}
+
+			auth, ok := grant.GetAuthorizationGrant().(*types.SendAuthorization)
+			if !ok {
+				return false
+			}
+
+			sendAuth = auth
+			granterAddr = granter
+			granteeAddr = grantee
+			return true
+		})
+
+		if sendAuth == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no send grants"), nil, nil
+		}
+
+		if _, ok := simtypes.FindAccount(accs, granterAddr); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "granter account not found"), nil, nil
+		}
+
+		grantee, ok := simtypes.FindAccount(accs, granteeAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "grantee account not found"), nil, nil
+		}
+
+		granterSpendable := bk.SpendableCoins(ctx, granterAddr)
+		available := sdk.NewCoins()
+		for _, coin := range sendAuth.SpendLimit {
+			amount := sdk.MinInt(coin.Amount, granterSpendable.AmountOf(coin.Denom))
+			available = available.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+
+		coins := simtypes.RandSubsetCoins(r, available)
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no coins to send"), nil, nil
+		}
+
+		granteeAcc := ak.GetAccount(ctx, granteeAddr)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, granteeAddr))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "fee error"), nil, err
+		}
+
+		sendMsg := banktypes.NewMsgSend(granterAddr, granteeAddr, coins)
+		msg, err := types.NewMsgExec(granteeAddr, []sdk.ServiceMsg{{
+			MethodName: types.SendAuthorizationMethodName,
+			Request:    sendMsg,
+		}})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{granteeAcc.GetAccountNumber()},
+			[]uint64{granteeAcc.GetSequence()},
+			grantee.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
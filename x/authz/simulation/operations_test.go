@@ -0,0 +1,186 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Time: time.Now()})
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		err := suite.app.BankKeeper.SetBalances(suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+// TestWeightedOperations tests the weights of the operations.
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, cdc, app.AccountKeeper,
+		app.BankKeeper, app.AuthzKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simappparams.DefaultWeightMsgGrant, types.ModuleName, types.TypeMsgGrant},
+		{simappparams.DefaultWeightMsgRevoke, types.ModuleName, types.TypeMsgRevoke},
+		{simappparams.DefaultWeightMsgExec, types.ModuleName, types.TypeMsgExec},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateMsgGrant tests the normal scenario of a valid message of type TypeMsgGrant.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgGrant() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgGrant(app.AccountKeeper, app.BankKeeper, app.AuthzKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx.WithBlockTime(time.Now()), accounts, "")
+	require.NoError(err)
+
+	var msg types.MsgGrant
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.Equal(accounts[2].Address.String(), msg.Granter)
+	require.Equal(accounts[1].Address.String(), msg.Grantee)
+	require.Len(futureOperations, 0)
+}
+
+// TestSimulateMsgRevoke tests the normal scenario of a valid message of type TypeMsgRevoke.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgRevoke() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	initAmt := sdk.TokensFromConsensusPower(200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	granter, grantee := accounts[0], accounts[1]
+	err := app.AuthzKeeper.Grant(ctx, grantee.Address, granter.Address, types.NewSendAuthorization(initCoins), ctx.BlockTime().Add(time.Hour))
+	require.NoError(err)
+
+	// execute operation
+	op := simulation.SimulateMsgRevoke(app.AccountKeeper, app.BankKeeper, app.AuthzKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg types.MsgRevoke
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.Equal(granter.Address.String(), msg.Granter)
+	require.Equal(grantee.Address.String(), msg.Grantee)
+	require.Equal(types.SendAuthorizationMethodName, msg.MethodName)
+	require.Len(futureOperations, 0)
+}
+
+// TestSimulateMsgExec tests the normal scenario of a valid message of type TypeMsgExec.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgExec() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	initAmt := sdk.TokensFromConsensusPower(200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	granter, grantee := accounts[0], accounts[1]
+	err := app.AuthzKeeper.Grant(ctx, grantee.Address, granter.Address, types.NewSendAuthorization(initCoins), ctx.BlockTime().Add(time.Hour))
+	require.NoError(err)
+
+	// execute operation
+	op := simulation.SimulateMsgExec(app.AccountKeeper, app.BankKeeper, app.AuthzKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg types.MsgExec
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.Equal(grantee.Address.String(), msg.Grantee)
+	require.Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = AuthorizationGrant{}
+
+// NewAuthorizationGrant returns new AuthorizationGrant
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return AuthorizationGrant{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", authorization)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{
+		Expiration:    expiration,
+		Authorization: any,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (auth AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(auth.Authorization, &authorization)
+}
+
+// GetAuthorizationGrant returns the cached value from the AuthorizationGrant.Authorization if present.
+func (auth AuthorizationGrant) GetAuthorizationGrant() Authorization {
+	authorization, ok := auth.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
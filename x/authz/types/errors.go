@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	// ErrInvalidExpirationTime error if the set expiration time is in the past
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 2, "expiration time of authorization should be more than current time")
+	// ErrNoAuthorizationFound error if there is no authorization found given a grant key
+	ErrNoAuthorizationFound = sdkerrors.Register(ModuleName, 3, "authorization not found")
+	// ErrInvalidMethodName error if the method name of an authorization is empty or malformed
+	ErrInvalidMethodName = sdkerrors.Register(ModuleName, 4, "invalid method name")
+)
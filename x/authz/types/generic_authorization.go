@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(methodName string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MessageName: methodName,
+	}
+}
+
+// MethodName implements Authorization.MethodName.
+func (a GenericAuthorization) MethodName() string {
+	return a.MessageName
+}
+
+// Accept implements Authorization.Accept.
+func (a GenericAuthorization) Accept(_ sdk.Context, _ sdk.ServiceMsg) (updated Authorization, delete bool, err error) {
+	return &a, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if !isServiceMethodName(a.MessageName) {
+		return sdkerrors.Wrapf(ErrInvalidMethodName, "%q is not a fully-qualified Msg service method name", a.MessageName)
+	}
+	return nil
+}
+
+// isServiceMethodName checks if the given string looks like a fully-qualified
+// Msg service method name, i.e. /cosmos.bank.v1beta1.Msg/Send.
+func isServiceMethodName(methodName string) bool {
+	return strings.HasPrefix(methodName, "/") && strings.Count(methodName, "/") == 2 &&
+		!strings.HasSuffix(methodName, "/")
+}
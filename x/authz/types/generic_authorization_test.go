@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	authorization := types.NewGenericAuthorization(types.SendAuthorizationMethodName)
+	require.Equal(t, types.SendAuthorizationMethodName, authorization.MethodName())
+	require.NoError(t, authorization.ValidateBasic())
+
+	for _, methodName := range []string{"", "cosmos.bank.v1beta1.Msg/Send", "/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.Msg/"} {
+		require.Error(t, types.NewGenericAuthorization(methodName).ValidateBasic(), methodName)
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+// Keys for authz store
+// Items are stored with the following key: values
+//
+// - 0x01<granterAddress_Bytes><granteeAddress_Bytes><methodName_Bytes>: AuthorizationGrant
+var (
+	// GrantKey is the prefix for the authorization grants
+	GrantKey = []byte{0x01}
+)
+
+// GetAuthorizationStoreKey returns the store key of an authorization for the
+// given grantee, granter and method name.
+func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) []byte {
+	return append(append(append(GrantKey, granter.Bytes()...), grantee.Bytes()...), []byte(methodName)...)
+}
+
+// GetAuthorizationsPrefix returns the store key prefix of all authorizations
+// granted to the grantee by the granter.
+func GetAuthorizationsPrefix(grantee sdk.AccAddress, granter sdk.AccAddress) []byte {
+	return append(append(GrantKey, granter.Bytes()...), grantee.Bytes()...)
+}
+
+// ExtractAddressesFromGrantKey extracts the granter and grantee addresses from
+// an authorization store key.
+func ExtractAddressesFromGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
+	// key is of format:
+	// 0x01<granterAddress_Bytes><granteeAddress_Bytes><methodName_Bytes>
+	granterAddr = sdk.AccAddress(key[1 : sdk.AddrLen+1])
+	granteeAddr = sdk.AccAddress(key[sdk.AddrLen+1 : 2*sdk.AddrLen+1])
+	return granterAddr, granteeAddr
+}
@@ -0,0 +1,275 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_ sdk.Msg                       = &MsgGrant{}
+	_ sdk.Msg                       = &MsgRevoke{}
+	_ sdk.Msg                       = &MsgExec{}
+	_ types.UnpackInterfacesMessage = &MsgGrant{}
+	_ types.UnpackInterfacesMessage = &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant
+//nolint:interfacer
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) (*MsgGrant, error) {
+	m := &MsgGrant{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		Expiration: expiration,
+	}
+	err := m.SetAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrant) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+	// the expiration is checked against the block time when the grant is
+	// stored, here we only make sure it was set
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration time must be set")
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "authorization is not set")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// GetGrantAuthorization returns the cache value from the MsgGrant.Authorization if present.
+func (msg *MsgGrant) GetGrantAuthorization() Authorization {
+	authorization, ok := msg.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgGrant.Authorization.
+func (msg *MsgGrant) SetAuthorization(authorization Authorization) error {
+	m, ok := authorization.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", m)
+	}
+	any, err := types.NewAnyWithValue(m)
+	if err != nil {
+		return err
+	}
+	msg.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevoke creates a new MsgRevoke
+//nolint:interfacer
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, methodName string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MethodName: methodName,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevoke) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+	if msg.MethodName == "" {
+		return sdkerrors.Wrap(ErrInvalidMethodName, "missing method name")
+	}
+
+	return nil
+}
+
+// NewMsgExec creates a new MsgExec
+//nolint:interfacer
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.ServiceMsg) (MsgExec, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		if err != nil {
+			return MsgExec{}, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// GetServiceMsgs returns the cache values from the MsgExec.Msgs if present.
+func (msg MsgExec) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		msgReq, ok := msgAny.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", msgAny)
+		}
+		srvMsg := sdk.ServiceMsg{
+			MethodName: msgAny.TypeUrl,
+			Request:    msgReq,
+		}
+
+		msgs[i] = srvMsg
+	}
+
+	return msgs, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	for _, msgAny := range msg.Msgs {
+		if !isServiceMethodName(msgAny.TypeUrl) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a Msg service method", msgAny.TypeUrl)
+		}
+	}
+
+	msgs, err := msg.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		// only Msg service requests can be executed on behalf of a granter,
+		// so each Any's type URL must be a fully-qualified method name
+		if !isServiceMethodName(x.TypeUrl) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a Msg service method", x.TypeUrl)
+		}
+
+		var msgExecAuthorized sdk.MsgRequest
+		err := unpacker.UnpackAny(x, &msgExecAuthorized)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 1000))
+	granter  = sdk.AccAddress("_______granter______")
+	grantee  = sdk.AccAddress("_______grantee______")
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.ServiceMsg
+		expectPass bool
+	}{
+		{"nil grantee address", nil, []sdk.ServiceMsg{}, false},
+		{"zero-messages test", grantee, []sdk.ServiceMsg{}, false},
+		{"invalid inner message", grantee, []sdk.ServiceMsg{{
+			MethodName: types.SendAuthorizationMethodName,
+			Request:    banktypes.NewMsgSend(granter, grantee, sdk.Coins{}),
+		}}, false},
+		{"valid test", grantee, []sdk.ServiceMsg{{
+			MethodName: types.SendAuthorizationMethodName,
+			Request:    banktypes.NewMsgSend(granter, grantee, coinsPos),
+		}}, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgExec(tc.grantee, tc.msgs)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		methodName       string
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, types.SendAuthorizationMethodName, false},
+		{"nil grantee address", granter, nil, types.SendAuthorizationMethodName, false},
+		{"nil granter and grantee address", nil, nil, types.SendAuthorizationMethodName, false},
+		{"same granter and grantee", granter, granter, types.SendAuthorizationMethodName, false},
+		{"missing method name", granter, grantee, "", false},
+		{"valid test case", granter, grantee, types.SendAuthorizationMethodName, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.methodName)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, types.NewSendAuthorization(coinsPos), expiration, false},
+		{"nil grantee address", granter, nil, types.NewSendAuthorization(coinsPos), expiration, false},
+		{"nil granter and grantee address", nil, nil, types.NewSendAuthorization(coinsPos), expiration, false},
+		{"same granter and grantee", granter, granter, types.NewSendAuthorization(coinsPos), expiration, false},
+		{"missing expiration", granter, grantee, types.NewSendAuthorization(coinsPos), time.Time{}, false},
+		{"invalid authorization", granter, grantee, types.NewGenericAuthorization("invalid"), expiration, false},
+		{"valid test case", granter, grantee, types.NewSendAuthorization(coinsPos), expiration, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &QueryAuthorizationResponse{}
+	_ types.UnpackInterfacesMessage = &QueryAuthorizationsResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Authorization != nil {
+		return m.Authorization.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, authorization := range m.Authorizations {
+		if err := authorization.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SendAuthorizationMethodName is the Msg service method authorized by a
+// SendAuthorization.
+const SendAuthorizationMethodName = "/cosmos.bank.v1beta1.Msg/Send"
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MethodName implements Authorization.MethodName.
+func (a SendAuthorization) MethodName() string {
+	return SendAuthorizationMethodName
+}
+
+// Accept implements Authorization.Accept.
+func (a SendAuthorization) Accept(_ sdk.Context, msg sdk.ServiceMsg) (updated Authorization, delete bool, err error) {
+	switch msg := msg.Request.(type) {
+	case *banktypes.MsgSend:
+		limitLeft, isNegative := a.SpendLimit.SafeSub(msg.Amount)
+		if isNegative {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		}
+		if limitLeft.IsZero() {
+			return nil, true, nil
+		}
+
+		return &SendAuthorization{SpendLimit: limitLeft}, false, nil
+
+	default:
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch: expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", a.SpendLimit)
+	}
+	if !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be negative or empty")
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Msg service methods authorized by a StakeAuthorization.
+const (
+	DelegateMethodName        = "/cosmos.staking.v1beta1.Msg/Delegate"
+	UndelegateMethodName      = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	BeginRedelegateMethodName = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+)
+
+var _ Authorization = &StakeAuthorization{}
+
+// NewStakeAuthorization creates a new StakeAuthorization object. Exactly one of
+// allowed or denied must be non-empty.
+func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
+	allowedValidators, deniedValidators, err := validateAndBech32fy(allowed, denied)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := StakeAuthorization{}
+	if allowedValidators != nil {
+		authorization.Validators = &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: allowedValidators}}
+	} else {
+		authorization.Validators = &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: deniedValidators}}
+	}
+
+	if amount != nil {
+		authorization.MaxTokens = amount
+	}
+	authorization.AuthorizationType = authzType
+
+	return &authorization, nil
+}
+
+// MethodName implements Authorization.MethodName.
+func (a StakeAuthorization) MethodName() string {
+	methodName, err := normalizeAuthzType(a.AuthorizationType)
+	if err != nil {
+		return ""
+	}
+	return methodName
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a StakeAuthorization) ValidateBasic() error {
+	if a.MaxTokens != nil && a.MaxTokens.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "negative coin amount: %v", a.MaxTokens)
+	}
+	if a.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "unknown authorization type")
+	}
+	if a.GetAllowList() == nil && a.GetDenyList() == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either allowed or denied validators must be set")
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept.
+func (a StakeAuthorization) Accept(_ sdk.Context, msg sdk.ServiceMsg) (updated Authorization, delete bool, err error) {
+	var validatorAddress string
+	var amount sdk.Coin
+
+	switch msg := msg.Request.(type) {
+	case *stakingtypes.MsgDelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *stakingtypes.MsgUndelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *stakingtypes.MsgBeginRedelegate:
+		validatorAddress = msg.ValidatorDstAddress
+		amount = msg.Amount
+	default:
+		return nil, false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown msg type")
+	}
+
+	isValidatorExists := false
+	allowedList := a.GetAllowList().GetAddress()
+	for _, validator := range allowedList {
+		if validator == validatorAddress {
+			isValidatorExists = true
+			break
+		}
+	}
+
+	denyList := a.GetDenyList().GetAddress()
+	for _, validator := range denyList {
+		if validator == validatorAddress {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validator)
+		}
+	}
+
+	if len(allowedList) > 0 && !isValidatorExists {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	if a.MaxTokens == nil {
+		return &StakeAuthorization{Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType()}, false, nil
+	}
+
+	if a.MaxTokens.Denom != amount.Denom || a.MaxTokens.IsLT(amount) {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "delegation amount %s exceeds max tokens %s", amount, a.MaxTokens)
+	}
+
+	limitLeft := a.MaxTokens.Sub(amount)
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return &StakeAuthorization{
+		Validators:        a.GetValidators(),
+		AuthorizationType: a.GetAuthorizationType(),
+		MaxTokens:         &limitLeft,
+	}, false, nil
+}
+
+func validateAndBech32fy(allowed []sdk.ValAddress, denied []sdk.ValAddress) ([]string, []string, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "both allowed & deny list cannot be empty")
+	}
+
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both allowed & deny list")
+	}
+
+	allowedValidators := make([]string, len(allowed))
+	if len(allowed) > 0 {
+		for i, validator := range allowed {
+			allowedValidators[i] = validator.String()
+		}
+		return allowedValidators, nil, nil
+	}
+
+	deniedValidators := make([]string, len(denied))
+	for i, validator := range denied {
+		deniedValidators[i] = validator.String()
+	}
+
+	return nil, deniedValidators, nil
+}
+
+func normalizeAuthzType(authzType AuthorizationType) (string, error) {
+	switch authzType {
+	case AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return DelegateMethodName, nil
+	case AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return UndelegateMethodName, nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return BeginRedelegateMethodName, nil
+	default:
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type %T", authzType)
+	}
+}
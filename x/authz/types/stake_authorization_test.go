@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	coin100 = sdk.NewInt64Coin("steak", 100)
+	coin50  = sdk.NewInt64Coin("steak", 50)
+	delAddr = sdk.AccAddress("_____delegator _____")
+	val1    = sdk.ValAddress("_____validator1_____")
+	val2    = sdk.ValAddress("_____validator2_____")
+	val3    = sdk.ValAddress("_____validator3_____")
+)
+
+func TestNewStakeAuthorization(t *testing.T) {
+	_, err := types.NewStakeAuthorization([]sdk.ValAddress{}, []sdk.ValAddress{}, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err, "one of the validator lists must be set")
+
+	_, err = types.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{val2}, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err, "allow and deny lists are mutually exclusive")
+
+	authorization, err := types.NewStakeAuthorization([]sdk.ValAddress{val1}, nil, types.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, &coin100)
+	require.NoError(t, err)
+	require.Error(t, authorization.ValidateBasic(), "authorization type must be set")
+
+	authorization, err = types.NewStakeAuthorization(nil, []sdk.ValAddress{val1}, types.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.BeginRedelegateMethodName, authorization.MethodName())
+	require.NoError(t, authorization.ValidateBasic())
+}
+
+func TestStakeAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	testCases := []struct {
+		msg        string
+		allowed    []sdk.ValAddress
+		denied     []sdk.ValAddress
+		authzType  types.AuthorizationType
+		limit      *sdk.Coin
+		srvMsg     sdk.ServiceMsg
+		expectErr  bool
+		isDelete   bool
+		expectLeft *sdk.Coin
+	}{
+		{
+			"delegate to allowed validator, limit left",
+			[]sdk.ValAddress{val1, val2}, nil, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			sdk.ServiceMsg{MethodName: types.DelegateMethodName, Request: stakingtypes.NewMsgDelegate(delAddr, val1, coin50)},
+			false, false, &coin50,
+		},
+		{
+			"delegate full limit deletes authorization",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			sdk.ServiceMsg{MethodName: types.DelegateMethodName, Request: stakingtypes.NewMsgDelegate(delAddr, val1, coin100)},
+			false, true, nil,
+		},
+		{
+			"delegate more than the limit",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin50,
+			sdk.ServiceMsg{MethodName: types.DelegateMethodName, Request: stakingtypes.NewMsgDelegate(delAddr, val1, coin100)},
+			true, false, nil,
+		},
+		{
+			"delegate to validator outside the allow list",
+			[]sdk.ValAddress{val1}, nil, types.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100,
+			sdk.ServiceMsg{MethodName: types.DelegateMethodName, Request: stakingtypes.NewMsgDelegate(delAddr, val3, coin50)},
+			true, false, nil,
+		},
+		{
+			"undelegate from denied validator",
+			nil, []sdk.ValAddress{val1}, types.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, &coin100,
+			sdk.ServiceMsg{MethodName: types.UndelegateMethodName, Request: stakingtypes.NewMsgUndelegate(delAddr, val1, coin50)},
+			true, false, nil,
+		},
+		{
+			"redelegate without limit",
+			nil, []sdk.ValAddress{val1}, types.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, nil,
+			sdk.ServiceMsg{MethodName: types.BeginRedelegateMethodName, Request: stakingtypes.NewMsgBeginRedelegate(delAddr, val1, val2, coin100)},
+			false, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			authorization, err := types.NewStakeAuthorization(tc.allowed, tc.denied, tc.authzType, tc.limit)
+			require.NoError(t, err)
+
+			updated, del, err := authorization.Accept(ctx, tc.srvMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.isDelete, del)
+			if tc.isDelete {
+				require.Nil(t, updated)
+				return
+			}
+
+			stakeAuth, ok := updated.(*types.StakeAuthorization)
+			require.True(t, ok)
+			require.Equal(t, tc.expectLeft, stakeAuth.MaxTokens)
+		})
+	}
+}
@@ -21,6 +21,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+var atomMetadata = types.Metadata{
+	Description: "The native staking token of the Cosmos Hub.",
+	DenomUnits: []*types.DenomUnit{
+		{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+		{Denom: "atom", Exponent: 6, Aliases: []string{}},
+	},
+	Base:    "uatom",
+	Display: "atom",
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -34,10 +44,19 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 1
 
+	var bankGenesis types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &bankGenesis))
+
+	bankGenesis.DenomMetadata = []types.Metadata{atomMetadata}
+
+	bankGenesisBz, err := cfg.Codec.MarshalJSON(&bankGenesis)
+	s.Require().NoError(err)
+	cfg.GenesisState[types.ModuleName] = bankGenesisBz
+
 	s.cfg = cfg
 	s.network = network.New(s.T(), cfg)
 
-	_, err := s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 }
 
@@ -138,7 +157,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 				Supply: sdk.NewCoins(
 					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
 					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(10))),
-				)},
+				),
+				Pagination: &query.PageResponse{Total: 0},
+			},
 		},
 		{
 			name: "total supply of a specific denomination",
@@ -181,6 +202,71 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdDenomsMetadata() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{
+			"all denoms client metadata",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			&types.QueryDenomsMetadataResponse{},
+			&types.QueryDenomsMetadataResponse{
+				Metadatas:  []types.Metadata{atomMetadata},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"client metadata of a specific denomination",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagDenom, atomMetadata.Base),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			&types.QueryDenomMetadataResponse{},
+			&types.QueryDenomMetadataResponse{
+				Metadata: atomMetadata,
+			},
+		},
+		{
+			"client metadata of a bogus denom",
+			[]string{
+				fmt.Sprintf("--%s=foobar", cli.FlagDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDenomsMetadata()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected, tc.respType)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
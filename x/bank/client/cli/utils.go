@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseUpdateDenomMetadataProposalWithDeposit reads and parses an UpdateDenomMetadataProposalWithDeposit from a file.
+func ParseUpdateDenomMetadataProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.UpdateDenomMetadataProposalWithDeposit, error) {
+	proposal := types.UpdateDenomMetadataProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleUpdateDenomMetadataProposal is a handler for executing a passed update
+// denom metadata proposal
+func HandleUpdateDenomMetadataProposal(ctx sdk.Context, k Keeper, p *types.UpdateDenomMetadataProposal) error {
+	if err := p.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, p.Metadata)
+
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+	logger.Info("updated denom metadata", "base", p.Metadata.Base, "display", p.Metadata.Display)
+
+	return nil
+}
@@ -1,5 +1,36 @@
 package v040
 
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 const (
 	ModuleName = "bank"
 )
+
+// KVStore keys
+var (
+	// SupplyKey is the key of the single Supply object holding the total supply
+	// of every denom.
+	SupplyKey = []byte{0x00}
+)
+
+// SupplyI defines an inflationary supply interface for modules that handle
+// token supply. It is only kept to decode the v0.40 Supply object stored
+// under SupplyKey.
+type SupplyI interface {
+	proto.Message
+}
+
+// RegisterInterfaces registers the v0.40 SupplyI interface and its
+// implementation so that the legacy Supply object can be decoded.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"cosmos.bank.v1beta1.SupplyI",
+		(*SupplyI)(nil),
+		&types.Supply{},
+	)
+}
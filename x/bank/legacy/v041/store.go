@@ -0,0 +1,67 @@
+package v041
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v040"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// KVStore keys
+var (
+	// SupplyKey is the prefix of the per-denom supply store.
+	SupplyKey = []byte{0x00}
+)
+
+// migrateSupply migrates the supply to be stored by denom key instead of in a
+// single blob.
+func migrateSupply(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	oldSupplyBz := store.Get(v040bank.SupplyKey)
+	if oldSupplyBz == nil {
+		return nil
+	}
+
+	var oldSupplyI v040bank.SupplyI
+	if err := cdc.UnmarshalInterface(oldSupplyBz, &oldSupplyI); err != nil {
+		return err
+	}
+
+	oldSupply, ok := oldSupplyI.(*types.Supply)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", (*types.Supply)(nil), oldSupplyI)
+	}
+
+	// We delete the single key holding the whole blob.
+	store.Delete(v040bank.SupplyKey)
+
+	// We add a new key for each denom.
+	supplyStore := prefix.NewStore(store, SupplyKey)
+	for _, coin := range oldSupply.Total {
+		// Zero supplies are not stored, the same as for newly minted denoms.
+		if coin.IsZero() {
+			continue
+		}
+
+		coinBz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		supplyStore.Set([]byte(coin.Denom), coinBz)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Change the total supply from a single Supply object to one key per denom.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateSupply(store, cdc)
+}
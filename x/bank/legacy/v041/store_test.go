@@ -0,0 +1,61 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v040"
+	v041bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSupplyMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	oldFooCoin := sdk.NewCoin("foo", sdk.NewInt(100))
+	oldBarCoin := sdk.NewCoin("bar", sdk.NewInt(200))
+	oldFooBarCoin := sdk.NewCoin("foobar", sdk.NewInt(0)) // to ensure the zero denom coins pruned.
+
+	// Old supply was stored as a single blob under the `SupplyKey`.
+	var oldSupply v040bank.SupplyI = &types.Supply{Total: sdk.Coins{oldFooCoin, oldBarCoin, oldFooBarCoin}}
+	oldSupplyBz, err := encCfg.Marshaler.MarshalInterface(oldSupply)
+	require.NoError(t, err)
+
+	store := ctx.KVStore(bankKey)
+	store.Set(v040bank.SupplyKey, oldSupplyBz)
+
+	// Run migration.
+	err = v041bank.MigrateStore(ctx, bankKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	// New supply is indexed by denom.
+	supplyStore := prefix.NewStore(store, v041bank.SupplyKey)
+	bz := supplyStore.Get([]byte("foo"))
+	var amount sdk.Int
+	require.NoError(t, amount.Unmarshal(bz))
+	require.Equal(t, "100", amount.String())
+
+	bz = supplyStore.Get([]byte("bar"))
+	require.NoError(t, amount.Unmarshal(bz))
+	require.Equal(t, "200", amount.String())
+
+	require.False(t, supplyStore.Has([]byte("foobar")))
+
+	// The old blob has been removed.
+	require.False(t, store.Has(v040bank.SupplyKey))
+}
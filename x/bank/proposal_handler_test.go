@@ -0,0 +1,52 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func testMetadata() types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+}
+
+func TestUpdateDenomMetadataProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := bank.NewUpdateDenomMetadataProposalHandler(app.BankKeeper)
+
+	metadata := testMetadata()
+	require.NoError(t, hdlr(ctx, types.NewUpdateDenomMetadataProposal("title", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// updating existing metadata overwrites it
+	metadata.Description = "Cosmos Hub atoms"
+	metadata.DenomUnits = metadata.DenomUnits[:2]
+	metadata.Display = "matom"
+	require.NoError(t, hdlr(ctx, types.NewUpdateDenomMetadataProposal("title", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// invalid metadata is rejected and the stored metadata is left untouched
+	invalid := testMetadata()
+	invalid.Display = "katom"
+	require.Error(t, hdlr(ctx, types.NewUpdateDenomMetadataProposal("title", "description", invalid)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// other proposal types are rejected
+	require.Error(t, hdlr(ctx, distrtypes.NewCommunityPoolSpendProposal("title", "description", nil, nil)))
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
@@ -14,17 +13,16 @@ import (
 )
 
 func TestDecodeStore(t *testing.T) {
-	app := simapp.Setup(false)
-	dec := simulation.NewDecodeStore(app.BankKeeper)
+	dec := simulation.NewDecodeStore()
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	supply := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
 
-	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
+	supplyBz, err := supply.Amount.Marshal()
 	require.NoError(t, err)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.SupplyKey, Value: supplyBz},
+			{Key: append(types.SupplyKey, []byte(supply.Denom)...), Value: supplyBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -33,7 +31,7 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
 		{"other", ""},
 	}
 
@@ -211,12 +211,17 @@ var xxx_messageInfo_Output proto.InternalMessageInfo
 
 // Supply represents a struct that passively keeps track of the total supply
 // amounts in the network.
+// This message is deprecated now that supply is indexed by denom. It is only
+// kept to decode the legacy store in migrations.
+//
+// Deprecated: Do not use.
 type Supply struct {
 	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *Supply) Reset()      { *m = Supply{} }
-func (*Supply) ProtoMessage() {}
+func (m *Supply) Reset()         { *m = Supply{} }
+func (m *Supply) String() string { return proto.CompactTextString(m) }
+func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{4}
 }
@@ -390,6 +395,90 @@ func (m *Metadata) GetDisplay() string {
 	return ""
 }
 
+// UpdateDenomMetadataProposal is a gov Content type for adding or updating the
+// Metadata of a coin denomination.
+type UpdateDenomMetadataProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *UpdateDenomMetadataProposal) Reset()      { *m = UpdateDenomMetadataProposal{} }
+func (*UpdateDenomMetadataProposal) ProtoMessage() {}
+func (*UpdateDenomMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *UpdateDenomMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDenomMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDenomMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDenomMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDenomMetadataProposal.Merge(m, src)
+}
+func (m *UpdateDenomMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDenomMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDenomMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDenomMetadataProposal proto.InternalMessageInfo
+
+// UpdateDenomMetadataProposalWithDeposit defines an UpdateDenomMetadataProposal
+// with a deposit
+type UpdateDenomMetadataProposalWithDeposit struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Metadata    Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
+	Deposit     string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *UpdateDenomMetadataProposalWithDeposit) Reset() {
+	*m = UpdateDenomMetadataProposalWithDeposit{}
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*UpdateDenomMetadataProposalWithDeposit) ProtoMessage()    {}
+func (*UpdateDenomMetadataProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDenomMetadataProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDenomMetadataProposalWithDeposit.Merge(m, src)
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDenomMetadataProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDenomMetadataProposalWithDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -398,48 +487,60 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*UpdateDenomMetadataProposal)(nil), "cosmos.bank.v1beta1.UpdateDenomMetadataProposal")
+	proto.RegisterType((*UpdateDenomMetadataProposalWithDeposit)(nil), "cosmos.bank.v1beta1.UpdateDenomMetadataProposalWithDeposit")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0x3e, 0x37, 0x4d, 0x48, 0x1d, 0x58, 0x4c, 0x86, 0x6b, 0x24, 0xee, 0x8e, 0x93, 0x90, 0x52,
-	0x44, 0x2f, 0x14, 0xc4, 0x92, 0x05, 0x29, 0xa5, 0x42, 0x1d, 0x10, 0xe8, 0x2a, 0x84, 0x04, 0x43,
-	0xe4, 0xc4, 0x6e, 0xb0, 0x7a, 0x67, 0x9f, 0x62, 0x1f, 0x6a, 0xfe, 0x03, 0x26, 0x60, 0x44, 0x62,
-	0xe9, 0xcc, 0x88, 0xf8, 0x23, 0x3a, 0x56, 0xb0, 0x30, 0x05, 0x94, 0x2c, 0xcc, 0xfd, 0x0b, 0x90,
-	0xed, 0xcb, 0x8f, 0x4a, 0x01, 0x31, 0x30, 0x30, 0x9d, 0x9f, 0xdf, 0xf7, 0xbe, 0xf7, 0xe9, 0x7b,
-	0xcf, 0x07, 0xbd, 0xbe, 0x90, 0xa9, 0x90, 0xad, 0x1e, 0xe6, 0x47, 0xad, 0x57, 0x3b, 0x3d, 0xaa,
-	0xf0, 0x8e, 0x09, 0xa2, 0x6c, 0x28, 0x94, 0x40, 0x57, 0x6d, 0x3e, 0x32, 0x57, 0x45, 0xbe, 0x51,
-	0x1f, 0x88, 0x81, 0x30, 0xf9, 0x96, 0x3e, 0x59, 0x68, 0x63, 0xd3, 0x42, 0xbb, 0x36, 0x51, 0xd4,
-	0xd9, 0xd4, 0xa2, 0x8b, 0xa4, 0xf3, 0x2e, 0x7d, 0xc1, 0xb8, 0xcd, 0x87, 0x5f, 0x01, 0xac, 0x3c,
-	0xc1, 0x43, 0x9c, 0x4a, 0x74, 0x08, 0x2f, 0x4b, 0xca, 0x49, 0x97, 0x72, 0xdc, 0x4b, 0x28, 0x71,
-	0x41, 0x50, 0x6a, 0xd6, 0xee, 0x04, 0xd1, 0x0a, 0x1d, 0xd1, 0x01, 0xe5, 0x64, 0xcf, 0xe2, 0x3a,
-	0xd7, 0xcf, 0xc7, 0xfe, 0xb5, 0x11, 0x4e, 0x93, 0x76, 0xb8, 0x5c, 0x7f, 0x4b, 0xa4, 0x4c, 0xd1,
-	0x34, 0x53, 0xa3, 0x30, 0xae, 0xc9, 0x05, 0x1e, 0xbd, 0x80, 0x75, 0x42, 0x0f, 0x71, 0x9e, 0xa8,
-	0xee, 0x85, 0x7e, 0x6b, 0x01, 0x68, 0x56, 0x3b, 0x5b, 0xe7, 0x63, 0xff, 0x86, 0x65, 0x5b, 0x85,
-	0x5a, 0x66, 0x45, 0x05, 0x60, 0x49, 0x4c, 0x7b, 0xfd, 0xfd, 0x89, 0xef, 0x84, 0x0f, 0x61, 0x6d,
-	0xe9, 0x12, 0xd5, 0x61, 0x99, 0x50, 0x2e, 0x52, 0x17, 0x04, 0xa0, 0xb9, 0x11, 0xdb, 0x00, 0xb9,
-	0xf0, 0xd2, 0x85, 0xd6, 0xf1, 0x2c, 0x6c, 0x57, 0x35, 0xc9, 0xcf, 0x13, 0x1f, 0x84, 0x6f, 0x00,
-	0x2c, 0xef, 0xf3, 0x2c, 0x57, 0x1a, 0x8d, 0x09, 0x19, 0x52, 0x29, 0x0b, 0x96, 0x59, 0x88, 0x30,
-	0x2c, 0x6b, 0x43, 0xa5, 0xbb, 0x66, 0x0c, 0xdb, 0x5c, 0x18, 0x26, 0xe9, 0xdc, 0xb0, 0x5d, 0xc1,
-	0x78, 0xe7, 0xf6, 0xe9, 0xd8, 0x77, 0x3e, 0x7e, 0xf7, 0x9b, 0x03, 0xa6, 0x5e, 0xe6, 0xbd, 0xa8,
-	0x2f, 0xd2, 0x62, 0x5a, 0xc5, 0x67, 0x5b, 0x92, 0xa3, 0x96, 0x1a, 0x65, 0x54, 0x9a, 0x02, 0x19,
-	0x5b, 0xe6, 0x76, 0xf5, 0xb5, 0x15, 0xe4, 0x84, 0x6f, 0x01, 0xac, 0x3c, 0xce, 0xd5, 0x7f, 0xa4,
-	0xe8, 0x13, 0x80, 0x95, 0x83, 0x3c, 0xcb, 0x92, 0x91, 0xee, 0xab, 0x84, 0xc2, 0x49, 0xb1, 0x3a,
-	0xff, 0xb6, 0xaf, 0x61, 0x6e, 0xef, 0xe9, 0xbe, 0xb3, 0xf1, 0x7c, 0xf9, 0xbc, 0x7d, 0xef, 0xe6,
-	0x1f, 0x19, 0x8e, 0xed, 0xf3, 0xa2, 0xc7, 0x99, 0x18, 0x2a, 0x4a, 0x22, 0x2b, 0x74, 0x3f, 0x7c,
-	0x06, 0x37, 0x1e, 0xe8, 0x25, 0x78, 0xca, 0x99, 0xfa, 0xcd, 0x7a, 0x34, 0x60, 0x55, 0x97, 0x71,
-	0xca, 0x95, 0xd9, 0x8f, 0x2b, 0xf1, 0x3c, 0x36, 0xd6, 0x27, 0x0c, 0x4b, 0x2a, 0xdd, 0x52, 0x50,
-	0x32, 0xd6, 0xdb, 0x30, 0xfc, 0x00, 0x60, 0xf5, 0x11, 0x55, 0x98, 0x60, 0x85, 0x51, 0x00, 0x6b,
-	0x84, 0xca, 0xfe, 0x90, 0x65, 0x8a, 0x09, 0x5e, 0xd0, 0x2f, 0x5f, 0xa1, 0xfb, 0x1a, 0xc1, 0x45,
-	0xda, 0xcd, 0x39, 0x53, 0xb3, 0x79, 0x79, 0x2b, 0x9f, 0xdc, 0x5c, 0x6f, 0x0c, 0xc9, 0xec, 0x28,
-	0x11, 0x82, 0xeb, 0xda, 0x5d, 0xb7, 0x64, 0xb8, 0xcd, 0x59, 0xab, 0x23, 0x4c, 0x66, 0x09, 0x1e,
-	0xb9, 0xeb, 0x76, 0x31, 0x8a, 0xb0, 0xb3, 0x7b, 0x3a, 0xf1, 0xc0, 0xd9, 0xc4, 0x03, 0x3f, 0x26,
-	0x1e, 0x78, 0x37, 0xf5, 0x9c, 0xb3, 0xa9, 0xe7, 0x7c, 0x9b, 0x7a, 0xce, 0xf3, 0xad, 0xbf, 0xb1,
-	0xd1, 0xcc, 0xa3, 0x57, 0x31, 0x7f, 0x8e, 0xbb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x72,
-	0xf6, 0xc6, 0xc1, 0x04, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb4, 0xa5, 0x94, 0x29, 0xdf, 0x1f, 0x19, 0x89, 0x2e, 0x18, 0x76, 0xeb, 0x26, 0x92,
+	0x62, 0xa0, 0x05, 0xf4, 0x40, 0x7a, 0x31, 0x59, 0x50, 0xc3, 0xc1, 0x48, 0x96, 0x10, 0x12, 0x3d,
+	0x34, 0xd3, 0xce, 0x50, 0x36, 0xec, 0xce, 0x6c, 0x3a, 0x53, 0x42, 0xff, 0x03, 0x4f, 0xea, 0xc9,
+	0x90, 0x78, 0xe1, 0xe0, 0xc9, 0xab, 0xfe, 0x11, 0x1c, 0x89, 0x5e, 0x3c, 0x55, 0x03, 0x17, 0xcf,
+	0xfd, 0x0b, 0xcc, 0xce, 0xec, 0xf6, 0x87, 0xa9, 0x44, 0x0f, 0x26, 0x9e, 0x76, 0xdf, 0xbc, 0xf7,
+	0x3e, 0xef, 0xf3, 0x79, 0xf3, 0xde, 0x40, 0xb3, 0xc1, 0x45, 0xc0, 0x45, 0xa5, 0x8e, 0xd9, 0x61,
+	0xe5, 0x68, 0xb5, 0x4e, 0x25, 0x5e, 0x55, 0x46, 0x39, 0x6c, 0x71, 0xc9, 0xd1, 0x35, 0xed, 0x2f,
+	0xab, 0xa3, 0xd8, 0x3f, 0x37, 0xd3, 0xe4, 0x4d, 0xae, 0xfc, 0x95, 0xe8, 0x4f, 0x87, 0xce, 0xcd,
+	0xea, 0xd0, 0x9a, 0x76, 0xc4, 0x79, 0xda, 0x35, 0xa8, 0x22, 0x68, 0xbf, 0x4a, 0x83, 0x7b, 0x4c,
+	0xfb, 0xed, 0x4f, 0x00, 0xe6, 0xb6, 0x71, 0x0b, 0x07, 0x02, 0xed, 0xc3, 0x69, 0x41, 0x19, 0xa9,
+	0x51, 0x86, 0xeb, 0x3e, 0x25, 0x06, 0x28, 0x66, 0x4a, 0x85, 0xb5, 0x62, 0x79, 0x0c, 0x8f, 0xf2,
+	0x0e, 0x65, 0xe4, 0x81, 0x8e, 0x73, 0x6e, 0xf5, 0xba, 0xd6, 0x7c, 0x07, 0x07, 0x7e, 0xd5, 0x1e,
+	0xce, 0x5f, 0xe2, 0x81, 0x27, 0x69, 0x10, 0xca, 0x8e, 0xed, 0x16, 0xc4, 0x20, 0x1e, 0x3d, 0x83,
+	0x33, 0x84, 0xee, 0xe3, 0xb6, 0x2f, 0x6b, 0x23, 0xf5, 0xd2, 0x45, 0x50, 0xca, 0x3b, 0x8b, 0xbd,
+	0xae, 0x75, 0x5b, 0xa3, 0x8d, 0x8b, 0x1a, 0x46, 0x45, 0x71, 0xc0, 0x10, 0x99, 0x6a, 0xf6, 0xe4,
+	0xd4, 0x4a, 0xd9, 0x8f, 0x60, 0x61, 0xe8, 0x10, 0xcd, 0xc0, 0x09, 0x42, 0x19, 0x0f, 0x0c, 0x50,
+	0x04, 0xa5, 0x29, 0x57, 0x1b, 0xc8, 0x80, 0x93, 0x23, 0xa5, 0xdd, 0xc4, 0xac, 0xe6, 0x23, 0x90,
+	0x6f, 0xa7, 0x16, 0xb0, 0x5f, 0x00, 0x38, 0xb1, 0xc5, 0xc2, 0xb6, 0x8c, 0xa2, 0x31, 0x21, 0x2d,
+	0x2a, 0x44, 0x8c, 0x92, 0x98, 0x08, 0xc3, 0x89, 0xa8, 0xa1, 0xc2, 0x48, 0xab, 0x86, 0xcd, 0x0e,
+	0x1a, 0x26, 0x68, 0xbf, 0x61, 0x1b, 0xdc, 0x63, 0xce, 0xca, 0x59, 0xd7, 0x4a, 0xbd, 0xfb, 0x62,
+	0x95, 0x9a, 0x9e, 0x3c, 0x68, 0xd7, 0xcb, 0x0d, 0x1e, 0xc4, 0xb7, 0x15, 0x7f, 0x96, 0x05, 0x39,
+	0xac, 0xc8, 0x4e, 0x48, 0x85, 0x4a, 0x10, 0xae, 0x46, 0xae, 0xe6, 0x9f, 0x6b, 0x42, 0x29, 0xfb,
+	0x25, 0x80, 0xb9, 0x27, 0x6d, 0xf9, 0x17, 0x31, 0x7a, 0x0f, 0x60, 0x6e, 0xa7, 0x1d, 0x86, 0x7e,
+	0x27, 0xaa, 0x2b, 0xb9, 0xc4, 0xbe, 0x01, 0xfe, 0x40, 0x5d, 0x85, 0x5c, 0x7d, 0x18, 0xd7, 0x05,
+	0x1f, 0x3f, 0x2c, 0xaf, 0xdf, 0xb9, 0x32, 0xfb, 0x58, 0xaf, 0x96, 0x4f, 0x9b, 0xb8, 0xd1, 0xa9,
+	0x1c, 0xad, 0xdc, 0x5b, 0x29, 0x6b, 0x9e, 0x5b, 0x06, 0xb0, 0xf7, 0xe0, 0xd4, 0x66, 0x34, 0x05,
+	0xbb, 0xcc, 0x93, 0x3f, 0x99, 0x8f, 0x39, 0x98, 0xa7, 0xc7, 0x21, 0x67, 0x94, 0x49, 0x35, 0x20,
+	0xff, 0xb8, 0x7d, 0x5b, 0xf5, 0xde, 0xf7, 0xb0, 0xa0, 0xc2, 0xc8, 0x14, 0x33, 0xaa, 0xf7, 0xda,
+	0xb4, 0xdf, 0x00, 0x98, 0x7f, 0x4c, 0x25, 0x26, 0x58, 0x62, 0x54, 0x84, 0x05, 0x42, 0x45, 0xa3,
+	0xe5, 0x85, 0xd2, 0xe3, 0x2c, 0x86, 0x1f, 0x3e, 0x42, 0xf7, 0xa3, 0x08, 0xc6, 0x83, 0x5a, 0x9b,
+	0x79, 0x32, 0xb9, 0x30, 0x73, 0xec, 0xce, 0xf5, 0xf9, 0xba, 0x90, 0x24, 0xbf, 0x02, 0x21, 0x98,
+	0x8d, 0xda, 0x6b, 0x64, 0x14, 0xb6, 0xfa, 0x8f, 0xd8, 0x11, 0x4f, 0x84, 0x3e, 0xee, 0x18, 0x59,
+	0x3d, 0x19, 0xb1, 0x69, 0xbf, 0x05, 0xf0, 0xe6, 0x6e, 0x48, 0xb0, 0xa4, 0x0a, 0x2d, 0x21, 0xba,
+	0xdd, 0xe2, 0x21, 0x17, 0xd8, 0x8f, 0x3a, 0x21, 0x3d, 0xe9, 0xd3, 0xa4, 0x13, 0xca, 0xf8, 0x51,
+	0x46, 0x7a, 0x9c, 0x8c, 0x7c, 0x10, 0x63, 0x29, 0x26, 0x85, 0xb5, 0xf9, 0xb1, 0x1a, 0x92, 0x82,
+	0x4e, 0x36, 0x1a, 0x00, 0xb7, 0x9f, 0x54, 0x9d, 0x8e, 0xee, 0xf5, 0x24, 0x99, 0xa9, 0xd7, 0x69,
+	0xb8, 0x70, 0x05, 0xcd, 0x3d, 0x4f, 0x1e, 0x6c, 0xd2, 0x90, 0x0b, 0x4f, 0xa2, 0x85, 0x11, 0xc6,
+	0xce, 0xff, 0xbd, 0xae, 0x35, 0xad, 0x9f, 0x0f, 0x75, 0x6c, 0x27, 0x1a, 0xd6, 0xc7, 0x68, 0x70,
+	0xae, 0xf7, 0xba, 0x16, 0x4a, 0x1e, 0x9b, 0xbe, 0xd3, 0x1e, 0xd5, 0xe6, 0xfe, 0xae, 0xb6, 0x1b,
+	0x91, 0xb6, 0x5e, 0xd7, 0xfa, 0x4f, 0x23, 0x27, 0xc9, 0xf6, 0x40, 0x2e, 0x5a, 0x82, 0x93, 0x44,
+	0x0b, 0xd0, 0x37, 0xe4, 0xa0, 0x5e, 0xd7, 0xfa, 0x37, 0x61, 0xa2, 0x1c, 0xb6, 0x9b, 0x84, 0xe8,
+	0x65, 0x3b, 0x39, 0xb5, 0x80, 0xb3, 0x71, 0x76, 0x61, 0x82, 0xf3, 0x0b, 0x13, 0x7c, 0xbd, 0x30,
+	0xc1, 0xab, 0x4b, 0x33, 0x75, 0x7e, 0x69, 0xa6, 0x3e, 0x5f, 0x9a, 0xa9, 0xa7, 0x8b, 0xbf, 0xb2,
+	0x0b, 0x6a, 0xa1, 0xea, 0x39, 0xf5, 0xf4, 0xdf, 0xfd, 0x3e, 0x00, 0xa4, 0x1e, 0xa2, 0x42, 0x82,
+	0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -812,6 +913,107 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateDenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDenomMetadataProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDenomMetadataProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDenomMetadataProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -959,6 +1161,48 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *UpdateDenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *UpdateDenomMetadataProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1821,6 +2065,338 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateDenomMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDenomMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDenomMetadataProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDenomMetadataProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDenomMetadataProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
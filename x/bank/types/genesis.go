@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,7 +46,24 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return NewSupply(data.Supply).ValidateBasic()
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadata[metadata.Base] = true
+	}
+
+	if !data.Supply.IsValid() {
+		return fmt.Errorf("invalid total supply: %s", data.Supply)
+	}
+
+	return nil
 }
 
 // NewGenesisState creates a new genesis state.
@@ -60,7 +78,7 @@ func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomM
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, DefaultSupply().GetTotal(), []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.NewCoins(), []Metadata{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
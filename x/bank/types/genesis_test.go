@@ -51,3 +51,34 @@ func TestMarshalJSONMetaData(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGenesisDenomMetadata(t *testing.T) {
+	invalidMetadata := atomMetadata()
+	invalidMetadata.DenomUnits = nil
+
+	testCases := []struct {
+		name     string
+		metadata []types.Metadata
+		expErr   bool
+	}{
+		{"no metadata", nil, false},
+		{"valid metadata", []types.Metadata{atomMetadata()}, false},
+		{"duplicate metadata", []types.Metadata{atomMetadata(), atomMetadata()}, true},
+		{"invalid metadata", []types.Metadata{invalidMetadata}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.DenomMetadata = tc.metadata
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a basic validation of the coin metadata fields. It checks:
+//   - Base and Display denominations are valid coin denominations
+//   - The first DenomUnit is the Base denomination with exponent 0
+//   - DenomUnits are sorted by strictly increasing exponent
+//   - DenomUnit denominations and aliases are unique
+//   - Display is one of the DenomUnit denominations
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid metadata base denom: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid metadata display denom: %s", err)
+	}
+
+	if len(m.DenomUnits) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "metadata of %s has no denom units", m.Base)
+	}
+
+	var hasDisplay bool
+	seenUnits := make(map[string]bool)
+
+	for i, denomUnit := range m.DenomUnits {
+		if i == 0 {
+			if denomUnit.Denom != m.Base {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the first denom unit must be the base denom %s, got %s", m.Base, denomUnit.Denom)
+			}
+			if denomUnit.Exponent != 0 {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the base denom unit %s must have exponent 0, got %d", m.Base, denomUnit.Exponent)
+			}
+		} else if denomUnit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "denom units must be sorted by strictly increasing exponent, got %d after %d", denomUnit.Exponent, m.DenomUnits[i-1].Exponent)
+		}
+
+		if err := sdk.ValidateDenom(denomUnit.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid denom unit: %s", err)
+		}
+
+		if seenUnits[denomUnit.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "duplicate denom unit %s", denomUnit.Denom)
+		}
+		seenUnits[denomUnit.Denom] = true
+
+		for _, alias := range denomUnit.Aliases {
+			if strings.TrimSpace(alias) == "" {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "denom unit %s has a blank alias", denomUnit.Denom)
+			}
+
+			if seenUnits[alias] {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "duplicate denom unit alias %s", alias)
+			}
+			seenUnits[alias] = true
+		}
+
+		if denomUnit.Denom == m.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasDisplay {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "display denom %s is not one of the denom units", m.Display)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadata() types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), []string{"microatom"}},
+			{"matom", uint32(3), []string{"milliatom"}},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+}
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.Metadata)
+		expErr   bool
+	}{
+		{"valid metadata", func(*types.Metadata) {}, false},
+		{"invalid base denom", func(m *types.Metadata) { m.Base = "" }, true},
+		{"invalid display denom", func(m *types.Metadata) { m.Display = "" }, true},
+		{"no denom units", func(m *types.Metadata) { m.DenomUnits = nil }, true},
+		{
+			"first denom unit is not the base denom",
+			func(m *types.Metadata) { m.DenomUnits = m.DenomUnits[1:] },
+			true,
+		},
+		{
+			"base denom unit with non-zero exponent",
+			func(m *types.Metadata) { m.DenomUnits[0].Exponent = 1 },
+			true,
+		},
+		{
+			"denom units not sorted by exponent",
+			func(m *types.Metadata) { m.DenomUnits[1], m.DenomUnits[2] = m.DenomUnits[2], m.DenomUnits[1] },
+			true,
+		},
+		{
+			"duplicate exponent",
+			func(m *types.Metadata) { m.DenomUnits[2].Exponent = 3 },
+			true,
+		},
+		{
+			"invalid denom unit",
+			func(m *types.Metadata) { m.DenomUnits[1].Denom = "m" },
+			true,
+		},
+		{
+			"duplicate denom unit",
+			func(m *types.Metadata) {
+				m.DenomUnits = append(m.DenomUnits, &types.DenomUnit{Denom: "atom", Exponent: 9})
+			},
+			true,
+		},
+		{
+			"alias shadows a denom unit",
+			func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{"atom"} },
+			true,
+		},
+		{
+			"blank alias",
+			func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{" "} },
+			true,
+		},
+		{
+			"display is not a denom unit",
+			func(m *types.Metadata) { m.Display = "katom" },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := atomMetadata()
+			tc.malleate(&metadata)
+
+			err := metadata.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateDenomMetadataProposalValidateBasic(t *testing.T) {
+	invalidMetadata := atomMetadata()
+	invalidMetadata.Display = "katom"
+
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateDenomMetadataProposal
+		expErr   bool
+	}{
+		{"valid proposal", types.NewUpdateDenomMetadataProposal("title", "description", atomMetadata()), false},
+		{"empty title", types.NewUpdateDenomMetadataProposal("", "description", atomMetadata()), true},
+		{"invalid metadata", types.NewUpdateDenomMetadataProposal("title", "description", invalidMetadata), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+				require.Equal(t, types.ProposalTypeUpdateDenomMetadata, tc.proposal.ProposalType())
+			}
+		})
+	}
+}
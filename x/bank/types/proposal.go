@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateDenomMetadata defines the type for an UpdateDenomMetadataProposal
+	ProposalTypeUpdateDenomMetadata = "UpdateDenomMetadata"
+)
+
+// Assert UpdateDenomMetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &UpdateDenomMetadataProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(&UpdateDenomMetadataProposal{}, "cosmos-sdk/UpdateDenomMetadataProposal")
+}
+
+// NewUpdateDenomMetadataProposal creates a new proposal to add or update the
+// metadata of a coin denomination.
+func NewUpdateDenomMetadataProposal(title, description string, metadata Metadata) *UpdateDenomMetadataProposal {
+	return &UpdateDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of an update denom metadata proposal.
+func (udmp *UpdateDenomMetadataProposal) GetTitle() string { return udmp.Title }
+
+// GetDescription returns the description of an update denom metadata proposal.
+func (udmp *UpdateDenomMetadataProposal) GetDescription() string { return udmp.Description }
+
+// ProposalRoute returns the routing key of an update denom metadata proposal.
+func (udmp *UpdateDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update denom metadata proposal.
+func (udmp *UpdateDenomMetadataProposal) ProposalType() string {
+	return ProposalTypeUpdateDenomMetadata
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (udmp *UpdateDenomMetadataProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(udmp)
+	if err != nil {
+		return err
+	}
+
+	return udmp.Metadata.Validate()
+}
+
+// String implements the Stringer interface.
+func (udmp UpdateDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Metadata:    %s
+`, udmp.Title, udmp.Description, udmp.Metadata.String()))
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// Flag names and values
+const (
+	FlagBrokenOnly = "broken-only"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(GetCmdQueryInvariantCheck())
+
+	return queryCmd
+}
+
+// GetCmdQueryInvariantCheck implements the query invariant check command.
+func GetCmdQueryInvariantCheck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariant-check",
+		Args:  cobra.NoArgs,
+		Short: "Query the results of the latest background invariant check of the node",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the results of the latest invariant check run in the background by the
+invariant monitor of the queried node. The results are local to the node.
+
+Example:
+$ %s query %s invariant-check --broken-only
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			brokenOnly, err := cmd.Flags().GetBool(FlagBrokenOnly)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InvariantCheck(
+				context.Background(),
+				&types.QueryInvariantCheckRequest{BrokenOnly: brokenOnly},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagBrokenOnly, false, "Only return the broken invariants")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InvariantCheck implements the Query/InvariantCheck gRPC method
+func (k Keeper) InvariantCheck(_ context.Context, req *types.QueryInvariantCheckRequest) (*types.QueryInvariantCheckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.monitor == nil {
+		return nil, status.Error(codes.FailedPrecondition, "invariant monitor is disabled")
+	}
+
+	latest := k.monitor.Latest()
+	res := &types.QueryInvariantCheckResponse{
+		Height:  latest.Height,
+		Results: make([]types.InvariantResult, 0, len(latest.Results)),
+		Error:   latest.Error,
+	}
+	for _, result := range latest.Results {
+		if !req.BrokenOnly || result.Broken {
+			res.Results = append(res.Results, result)
+		}
+	}
+
+	return res, nil
+}
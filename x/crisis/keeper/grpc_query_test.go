@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestGRPCQueryInvariantCheck(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CrisisKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := app.CrisisKeeper.InvariantCheck(gocontext.Background(), nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the monitor is disabled
+	_, err = queryClient.InvariantCheck(gocontext.Background(), &types.QueryInvariantCheckRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+
+	monitor := keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, []string{"testModule"})
+	app.CrisisKeeper.SetInvariantMonitor(monitor)
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CrisisKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	// no check has completed yet
+	res, err := queryClient.InvariantCheck(gocontext.Background(), &types.QueryInvariantCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Height)
+	require.Empty(t, res.Results)
+
+	require.True(t, monitor.Check(app.LastBlockHeight(), app.CrisisKeeper.Routes()))
+	monitor.Wait()
+
+	res, err = queryClient.InvariantCheck(gocontext.Background(), &types.QueryInvariantCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), res.Height)
+	require.Equal(t, []types.InvariantResult{
+		{Module: "testModule", Route: "testRoute1"},
+		{Module: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+	}, res.Results)
+
+	res, err = queryClient.InvariantCheck(gocontext.Background(), &types.QueryInvariantCheckRequest{BrokenOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.InvariantResult{
+		{Module: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+	}, res.Results)
+}
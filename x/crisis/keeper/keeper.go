@@ -16,6 +16,7 @@ type Keeper struct {
 	routes         []types.InvarRoute
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
+	monitor        *InvariantMonitor
 
 	supplyKeeper types.SupplyKeeper
 
@@ -93,6 +94,26 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
+// SetInvariantMonitor sets the monitor checking the invariants in the background.
+func (k *Keeper) SetInvariantMonitor(monitor *InvariantMonitor) {
+	k.monitor = monitor
+}
+
+// InvariantMonitor returns the monitor checking the invariants in the
+// background, or nil if there is none.
+func (k Keeper) InvariantMonitor() *InvariantMonitor { return k.monitor }
+
+// MonitorInvariants starts checking the invariants in the background against
+// the state committed by the previous block, if the monitor is set and due.
+func (k Keeper) MonitorInvariants(ctx sdk.Context) {
+	height := ctx.BlockHeight() - 1
+	if k.monitor == nil || height < 1 || height%int64(k.monitor.Period()) != 0 {
+		return
+	}
+
+	k.monitor.Check(height, k.Routes())
+}
+
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account.
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
 	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// InvariantMonitor checks the registered invariants in the background against
+// the state committed at a past height. Unlike AssertInvariants, it never
+// halts the chain: broken invariants are reported through telemetry, the logs
+// and the InvariantCheck gRPC query. The state is read from an immutable
+// version of the multi-store, so block execution goes on during the check.
+type InvariantMonitor struct {
+	cms        sdk.CommitMultiStore
+	logger     log.Logger
+	period     uint
+	invariants map[string]bool
+
+	mtx     sync.RWMutex
+	running bool
+	overrun bool // the running check outlived the period
+	latest  types.QueryInvariantCheckResponse
+	wg      sync.WaitGroup
+}
+
+// NewInvariantMonitor returns an InvariantMonitor which checks the state
+// committed at every height that is a multiple of period, reading it from cms.
+// The checked invariants are selected by module name ("bank") or full route
+// ("bank/total-supply"); all invariants are checked if none is given.
+//
+// The pruning options of cms must keep at least period recent heights, so that
+// the checked state is not pruned before the next check is due.
+func NewInvariantMonitor(cms sdk.CommitMultiStore, logger log.Logger, period uint, invariants []string) *InvariantMonitor {
+	if period == 0 {
+		panic("invariant monitor period must be positive")
+	}
+
+	// heights are only all kept if every one of them is
+	if pruning := cms.GetPruning(); pruning.KeepEvery != 1 && pruning.KeepRecent < uint64(period) {
+		panic(fmt.Sprintf(
+			"invariant monitor period %d exceeds the %d recent heights kept by the pruning options",
+			period, pruning.KeepRecent,
+		))
+	}
+
+	selected := make(map[string]bool, len(invariants))
+	for _, invariant := range invariants {
+		selected[invariant] = true
+	}
+
+	return &InvariantMonitor{
+		cms:        cms,
+		logger:     logger.With("module", "x/"+types.ModuleName),
+		period:     period,
+		invariants: selected,
+	}
+}
+
+// Period returns the number of blocks between two checks.
+func (m *InvariantMonitor) Period() uint { return m.period }
+
+// Selects returns whether the monitor checks the invariant of the given route.
+func (m *InvariantMonitor) Selects(route types.InvarRoute) bool {
+	return len(m.invariants) == 0 || m.invariants[route.ModuleName] || m.invariants[route.FullRoute()]
+}
+
+// Check starts checking the given invariants in the background against the
+// state committed at height. It returns false, without checking anything, if
+// the previous check is still running. It must not be called concurrently
+// with a commit, since the committed state is opened before Check returns.
+func (m *InvariantMonitor) Check(height int64, routes []types.InvarRoute) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.running {
+		m.logger.Info("skipped invariant check; previous check still running", "height", height)
+		m.overrun = true
+		return false
+	}
+
+	selected := make([]types.InvarRoute, 0, len(routes))
+	for _, route := range routes {
+		if m.Selects(route) {
+			selected = append(selected, route)
+		}
+	}
+
+	ms, err := m.cacheMultiStore(height)
+	if err != nil {
+		m.logger.Error("failed to check invariants", "height", height, "err", err)
+		telemetry.IncrCounter(1, types.ModuleName, "invariant_monitor", "failed")
+
+		m.latest = types.QueryInvariantCheckResponse{Height: height, Error: err.Error()}
+		return true
+	}
+
+	m.running = true
+	m.overrun = false
+	m.wg.Add(1)
+
+	go func() {
+		defer m.wg.Done()
+
+		latest, panicked := m.check(ms, height, selected)
+
+		m.mtx.Lock()
+		defer m.mtx.Unlock()
+
+		// the state at height may be pruned once the next check is due, and
+		// reading pruned state panics, so the invariants which panicked during
+		// a check which outlived the period are not reported as broken
+		if m.overrun {
+			for _, i := range panicked {
+				latest.Results[i].Broken = false
+				latest.Results[i].Inconclusive = true
+			}
+		}
+
+		m.report(latest)
+		m.latest = latest
+		m.running = false
+	}()
+
+	return true
+}
+
+// Wait blocks until the running check, if any, completes.
+func (m *InvariantMonitor) Wait() {
+	m.wg.Wait()
+}
+
+// Latest returns the results of the latest completed check.
+func (m *InvariantMonitor) Latest() types.QueryInvariantCheckResponse {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.latest
+}
+
+// cacheMultiStore opens the state committed at height.
+func (m *InvariantMonitor) cacheMultiStore(height int64) (sdk.CacheMultiStore, error) {
+	// missing versions of the IAVL stores are loaded as empty stores
+	if last := m.cms.LastCommitID().Version; height < 1 || height > last {
+		return nil, fmt.Errorf("height %d is not committed; latest committed height is %d", height, last)
+	}
+
+	return m.cms.CacheMultiStoreWithVersion(height)
+}
+
+// check checks the given invariants against the state committed at height,
+// opened in ms. It also returns the indexes of the results of the invariants
+// which panicked.
+func (m *InvariantMonitor) check(
+	ms sdk.CacheMultiStore, height int64, routes []types.InvarRoute,
+) (latest types.QueryInvariantCheckResponse, panicked []int) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "invariant_monitor", "check")
+
+	latest = types.QueryInvariantCheckResponse{Height: height}
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: height}, false, m.logger)
+
+	latest.Results = make([]types.InvariantResult, len(routes))
+	for i, route := range routes {
+		result, ok := m.checkInvariant(ctx, route)
+		latest.Results[i] = result
+		if !ok {
+			panicked = append(panicked, i)
+		}
+	}
+
+	return latest, panicked
+}
+
+// report reports the broken and inconclusive invariants of a completed check.
+func (m *InvariantMonitor) report(latest types.QueryInvariantCheckResponse) {
+	broken, inconclusive := 0, 0
+	for _, result := range latest.Results {
+		if result.Inconclusive {
+			inconclusive++
+			m.logger.Error(
+				"invariant check inconclusive; the checked state may have been pruned",
+				"height", latest.Height,
+				"invariant", types.NewInvarRoute(result.Module, result.Route, nil).FullRoute(),
+				"msg", result.Message,
+			)
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "invariant_monitor", "inconclusive"},
+				1,
+				[]metrics.Label{
+					telemetry.NewLabel("module", result.Module),
+					telemetry.NewLabel("route", result.Route),
+				},
+			)
+			continue
+		}
+		if !result.Broken {
+			continue
+		}
+
+		broken++
+		m.logger.Error(
+			"invariant broken",
+			"height", latest.Height,
+			"invariant", types.NewInvarRoute(result.Module, result.Route, nil).FullRoute(),
+			"msg", result.Message,
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "invariant_monitor", "broken"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("module", result.Module),
+				telemetry.NewLabel("route", result.Route),
+			},
+		)
+	}
+
+	telemetry.ModuleSetGauge(types.ModuleName, float32(latest.Height), "invariant_monitor", "height")
+	m.logger.Info(
+		"checked invariants",
+		"height", latest.Height, "checked", len(latest.Results), "broken", broken, "inconclusive", inconclusive,
+	)
+}
+
+// checkInvariant checks a single invariant. An invariant which panics is
+// reported as broken, and ok is false.
+func (m *InvariantMonitor) checkInvariant(ctx sdk.Context, route types.InvarRoute) (result types.InvariantResult, ok bool) {
+	result = types.InvariantResult{
+		Module: route.ModuleName,
+		Route:  route.Route,
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result.Broken = true
+			result.Message = fmt.Sprintf("invariant panicked: %v", r)
+			ok = false
+		}
+	}()
+
+	result.Message, result.Broken = route.Invar(ctx)
+	return result, true
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestInvariantMonitorSelects(t *testing.T) {
+	app := simapp.Setup(false)
+
+	monitor := keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, nil)
+	for _, route := range app.CrisisKeeper.Routes() {
+		require.True(t, monitor.Selects(route), route.FullRoute())
+	}
+
+	monitor = keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, []string{"bank", "staking/module-accounts"})
+	require.True(t, monitor.Selects(types.NewInvarRoute("bank", "total-supply", nil)))
+	require.True(t, monitor.Selects(types.NewInvarRoute("bank", "nonnegative-outstanding", nil)))
+	require.True(t, monitor.Selects(types.NewInvarRoute("staking", "module-accounts", nil)))
+	require.False(t, monitor.Selects(types.NewInvarRoute("staking", "nonnegative-power", nil)))
+	require.False(t, monitor.Selects(types.NewInvarRoute("distribution", "module-accounts", nil)))
+
+	require.Panics(t, func() { keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 0, nil) })
+}
+
+func TestInvariantMonitorPruning(t *testing.T) {
+	app := simapp.Setup(false)
+	cms := app.CommitMultiStore()
+
+	// the checked heights must be kept until the next check is due
+	cms.SetPruning(storetypes.PruneEverything)
+	require.Panics(t, func() { keeper.NewInvariantMonitor(cms, log.NewNopLogger(), 1, nil) })
+
+	cms.SetPruning(storetypes.PruneDefault)
+	require.NotPanics(t, func() { keeper.NewInvariantMonitor(cms, log.NewNopLogger(), 100, nil) })
+	require.Panics(t, func() { keeper.NewInvariantMonitor(cms, log.NewNopLogger(), 101, nil) })
+
+	cms.SetPruning(storetypes.PruneNothing)
+	require.NotPanics(t, func() { keeper.NewInvariantMonitor(cms, log.NewNopLogger(), 1000, nil) })
+}
+
+func TestInvariantMonitorCheck(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute3", func(sdk.Context) (string, bool) { panic("panicked") })
+
+	monitor := keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, nil)
+	require.True(t, monitor.Check(app.LastBlockHeight(), app.CrisisKeeper.Routes()))
+	monitor.Wait()
+
+	latest := monitor.Latest()
+	require.Equal(t, app.LastBlockHeight(), latest.Height)
+	require.Empty(t, latest.Error)
+	require.Len(t, latest.Results, len(app.CrisisKeeper.Routes()))
+
+	for _, result := range latest.Results {
+		switch result.Route {
+		case "testRoute2":
+			require.True(t, result.Broken)
+			require.Equal(t, "broken", result.Message)
+		case "testRoute3":
+			require.True(t, result.Broken)
+			require.Equal(t, "invariant panicked: panicked", result.Message)
+		default:
+			require.False(t, result.Broken, "%s/%s: %s", result.Module, result.Route, result.Message)
+		}
+	}
+
+	// only the selected invariants are checked
+	monitor = keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, []string{"testModule/testRoute2"})
+	require.True(t, monitor.Check(app.LastBlockHeight(), app.CrisisKeeper.Routes()))
+	monitor.Wait()
+	require.Equal(t, []types.InvariantResult{
+		{Module: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+	}, monitor.Latest().Results)
+
+	// a height which was not committed cannot be checked
+	require.True(t, monitor.Check(app.LastBlockHeight()+10, app.CrisisKeeper.Routes()))
+	monitor.Wait()
+	latest = monitor.Latest()
+	require.Equal(t, app.LastBlockHeight()+10, latest.Height)
+	require.NotEmpty(t, latest.Error)
+	require.Empty(t, latest.Results)
+}
+
+func TestInvariantMonitorCheckOverrun(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	// the invariant panics once the next check was due, as if the checked state was pruned
+	unblock := make(chan struct{})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) {
+		<-unblock
+		panic("pruned")
+	})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+
+	monitor := keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 1, []string{"testModule"})
+	require.True(t, monitor.Check(app.LastBlockHeight(), app.CrisisKeeper.Routes()))
+	require.False(t, monitor.Check(app.LastBlockHeight(), app.CrisisKeeper.Routes()))
+	close(unblock)
+	monitor.Wait()
+
+	// the results of the invariants which did not panic are still reported
+	latest := monitor.Latest()
+	require.Equal(t, app.LastBlockHeight(), latest.Height)
+	require.Empty(t, latest.Error)
+	require.Equal(t, []types.InvariantResult{
+		{Module: "testModule", Route: "testRoute", Message: "invariant panicked: pruned", Inconclusive: true},
+		{Module: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+	}, latest.Results)
+}
+
+func TestEndBlockerMonitorInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+	monitor := keeper.NewInvariantMonitor(app.CommitMultiStore(), log.NewNopLogger(), 2, []string{"testModule"})
+	app.CrisisKeeper.SetInvariantMonitor(monitor)
+	require.Equal(t, monitor, app.CrisisKeeper.InvariantMonitor())
+
+	// the state committed at height 1 is not due
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.NotPanics(t, func() { crisis.EndBlocker(app.BaseApp.NewContext(false, header), app.CrisisKeeper) })
+	monitor.Wait()
+	require.Equal(t, int64(0), monitor.Latest().Height)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the state committed at height 2 is checked, without halting
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.NotPanics(t, func() { crisis.EndBlocker(app.BaseApp.NewContext(false, header), app.CrisisKeeper) })
+	monitor.Wait()
+
+	latest := monitor.Latest()
+	require.Equal(t, int64(2), latest.Height)
+	require.Equal(t, []types.InvariantResult{
+		{Module: "testModule", Route: "testRoute", Broken: true, Message: "broken"},
+	}, latest.Results)
+}
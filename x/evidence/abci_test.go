@@ -0,0 +1,81 @@
+package evidence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestBeginBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.SlashFractionDoubleSign = sdk.NewDecWithPrec(5, 2)
+	slashingParams.SlashFractionLightClientAttack = sdk.NewDecWithPrec(2, 1)
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	// bond three validators
+	power := int64(100)
+	pks := simapp.CreateTestPubKeys(3)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for _, pk := range pks {
+		tstaking.CreateValidatorWithValPower(sdk.ValAddress(pk.Address()), pk, power, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	oldTokens := make([]sdk.Int, len(pks))
+	for i, pk := range pks {
+		oldTokens[i] = app.StakingKeeper.Validator(ctx, sdk.ValAddress(pk.Address())).GetTokens()
+
+		// handle a signature to set signing info
+		app.SlashingKeeper.HandleValidatorSignature(ctx, pk.Address(), power, true)
+	}
+
+	// the first validator double signed, the other two took part in a light
+	// client attack
+	newEvidence := func(evidenceType abci.EvidenceType, i int) abci.Evidence {
+		return abci.Evidence{
+			Type:             evidenceType,
+			Validator:        abci.Validator{Address: pks[i].Address(), Power: power},
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockTime(),
+			TotalVotingPower: power * int64(len(pks)),
+		}
+	}
+	ctx = ctx.WithBlockHeight(2)
+	evidence.BeginBlocker(ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{
+			newEvidence(abci.EvidenceType_DUPLICATE_VOTE, 0),
+			newEvidence(abci.EvidenceType_LIGHT_CLIENT_ATTACK, 1),
+			newEvidence(abci.EvidenceType_LIGHT_CLIENT_ATTACK, 2),
+		},
+	}, app.EvidenceKeeper)
+
+	slashFractions := []sdk.Dec{
+		slashingParams.SlashFractionDoubleSign,
+		slashingParams.SlashFractionLightClientAttack,
+		slashingParams.SlashFractionLightClientAttack,
+	}
+	for i, pk := range pks {
+		validator := app.StakingKeeper.Validator(ctx, sdk.ValAddress(pk.Address()))
+
+		// every validator should be jailed and tombstoned
+		require.True(t, validator.IsJailed())
+		require.True(t, app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(pk.Address())))
+
+		// and slashed by the fraction of its infraction
+		slashAmt := oldTokens[i].ToDec().Mul(slashFractions[i]).TruncateInt()
+		require.Equal(t, oldTokens[i].Sub(slashAmt), validator.GetTokens())
+	}
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -23,6 +25,28 @@ import (
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
 func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
+	k.handleInfraction(ctx, evidence, k.slashingKeeper.SlashFractionDoubleSign(ctx))
+}
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. Tendermint reports one piece of evidence for every validator that
+// took part in the attack, each of which is handled like an equivocation: the
+// validator is slashed by the light client attack slash fraction, jailed and
+// tombstoned. The same validity constraints as for equivocation apply.
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	k.handleInfraction(ctx, evidence, k.slashingKeeper.SlashFractionLightClientAttack(ctx))
+}
+
+// infractionEvidence defines the evidence handled by handleInfraction.
+type infractionEvidence interface {
+	exported.ValidatorEvidence
+
+	GetTime() time.Time
+}
+
+// handleInfraction slashes the validator named by the evidence by slashFraction,
+// then jails and tombstones it, unless the evidence is invalid.
+func (k Keeper) handleInfraction(ctx sdk.Context, evidence infractionEvidence, slashFraction sdk.Dec) {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
 
@@ -52,7 +76,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	if cp != nil && cp.Evidence != nil {
 		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
 			logger.Info(
-				"ignored equivocation; evidence too old",
+				"ignored evidence; evidence too old",
+				"type", evidence.Type(),
 				"validator", consAddr,
 				"infraction_height", infractionHeight,
 				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
@@ -77,7 +102,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// ignore if the validator is already tombstoned
 	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
 		logger.Info(
-			"ignored equivocation; validator already tombstoned",
+			"ignored evidence; validator already tombstoned",
+			"type", evidence.Type(),
 			"validator", consAddr,
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
@@ -86,7 +112,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	}
 
 	logger.Info(
-		"confirmed equivocation",
+		"confirmed evidence",
+		"type", evidence.Type(),
 		"validator", consAddr,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
@@ -107,7 +134,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		slashFraction,
 		evidence.GetValidatorPower(), distributionHeight,
 	)
 
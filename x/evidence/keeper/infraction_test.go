@@ -107,3 +107,47 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	slashingParams := suite.app.SlashingKeeper.GetParams(ctx)
+	slashingParams.SlashFractionLightClientAttack = sdk.NewDecWithPrec(1, 1)
+	suite.app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.LightClientAttack{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+		TotalPower:       power,
+	}
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// should be jailed and tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	// tokens should be slashed by the light client attack slash fraction
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	slashAmt := oldTokens.ToDec().Mul(slashingParams.SlashFractionLightClientAttack).TruncateInt()
+	suite.Equal(oldTokens.Sub(slashAmt), newTokens)
+
+	// submit duplicate evidence
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// tokens should be the same (capped slash)
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+}
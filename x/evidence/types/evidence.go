@@ -15,11 +15,16 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "light_client_attack"
+	TypeLightClientAttack  = "light_client_attack"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -85,14 +90,94 @@ func (e Equivocation) GetValidatorPower() int64 {
 // GetTotalPower is a no-op for the Equivocation type.
 func (e Equivocation) GetTotalPower() int64 { return 0 }
 
-// FromABCIEvidence converts a Tendermint concrete Evidence type to
-// SDK Evidence using Equivocation as the concrete type.
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack
+// object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.IsZero() {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator that took
+// part in the LightClientAttack.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total voting power of the validator set at the
+// height of the LightClientAttack infraction.
+func (e LightClientAttack) GetTotalPower() int64 { return e.TotalPower }
+
+// FromABCIEvidence converts a Tendermint concrete Evidence type to SDK Evidence.
+// Light client attack evidence is converted to a LightClientAttack, any other
+// evidence uses Equivocation as the concrete type.
 func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 	consAddr, err := sdk.Bech32ifyAddressBytes(sdk.Bech32PrefixConsAddr, e.Validator.Address)
 	if err != nil {
 		panic(err)
 	}
 
+	if e.Type == abci.EvidenceType_LIGHT_CLIENT_ATTACK {
+		return &LightClientAttack{
+			Height:           e.Height,
+			Power:            e.Validator.Power,
+			ConsensusAddress: consAddr,
+			Time:             e.Time,
+			TotalPower:       e.TotalVotingPower,
+		}
+	}
+
 	return &Equivocation{
 		Height:           e.Height,
 		Power:            e.Validator.Power,
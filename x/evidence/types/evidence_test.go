@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -57,3 +58,88 @@ func TestEquivocationValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestLightClientAttack_Valid(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	addr := sdk.ConsAddress("foo_________________")
+
+	e := types.LightClientAttack{
+		Height:           100,
+		Time:             n,
+		Power:            1000000,
+		ConsensusAddress: addr.String(),
+		TotalPower:       3000000,
+	}
+
+	require.Equal(t, e.GetTotalPower(), e.TotalPower)
+	require.Equal(t, e.GetValidatorPower(), e.Power)
+	require.Equal(t, e.GetTime(), e.Time)
+	require.Equal(t, e.GetConsensusAddress().String(), e.ConsensusAddress)
+	require.Equal(t, e.GetHeight(), e.Height)
+	require.Equal(t, e.Type(), types.TypeLightClientAttack)
+	require.Equal(t, e.Route(), types.RouteLightClientAttack)
+	require.Equal(t, e.Hash().String(), "C8BB2F84F8C0C561847B438AB97AADA8466C68807EFF676E0302B3B40EA02035")
+	require.Equal(t, e.String(), "height: 100\ntime: 2006-01-02T15:04:05Z\npower: 1000000\nconsensus_address: cosmosvalcons1vehk7h6lta047h6lta047h6lta047h6l8m4r53\ntotal_power: 3000000\n")
+	require.NoError(t, e.ValidateBasic())
+}
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, addr.String(), 3000000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, addr.String(), 3000000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, addr.String(), 3000000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr.String(), 3000000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, addr.String(), 999999}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, "", 3000000}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestFromABCIEvidence(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	addr := sdk.ConsAddress("foo_________________")
+	validator := abci.Validator{Address: addr, Power: 1000000}
+
+	e := types.FromABCIEvidence(abci.Evidence{
+		Type:             abci.EvidenceType_DUPLICATE_VOTE,
+		Validator:        validator,
+		Height:           100,
+		Time:             n,
+		TotalVotingPower: 3000000,
+	})
+	require.Equal(t, &types.Equivocation{
+		Height:           100,
+		Time:             n,
+		Power:            1000000,
+		ConsensusAddress: addr.String(),
+	}, e)
+
+	e = types.FromABCIEvidence(abci.Evidence{
+		Type:             abci.EvidenceType_LIGHT_CLIENT_ATTACK,
+		Validator:        validator,
+		Height:           100,
+		Time:             n,
+		TotalVotingPower: 3000000,
+	})
+	require.Equal(t, &types.LightClientAttack{
+		Height:           100,
+		Time:             n,
+		Power:            1000000,
+		ConsensusAddress: addr.String(),
+		TotalPower:       3000000,
+	}, e)
+}
@@ -24,6 +24,7 @@ type (
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
+		SlashFractionLightClientAttack(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
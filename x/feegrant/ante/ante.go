@@ -0,0 +1,26 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, and deducts fees from the
+// fee granter, if one is set and has granted an allowance to the fee payer,
+// or from the first signer otherwise.
+//
+// It is a drop-in replacement for auth's NewAnteHandler in applications which
+// include the x/feegrant module.
+func NewAnteHandler(
+	ak authante.AccountKeeper, bankKeeper types.BankKeeper, feeGrantKeeper keeper.Keeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return authante.NewAnteHandlerWithFeeDecorator(
+		ak, NewDeductGrantedFeeDecorator(ak, bankKeeper, feeGrantKeeper), sigGasConsumer, signModeHandler,
+	)
+}
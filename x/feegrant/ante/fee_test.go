@@ -0,0 +1,153 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// AnteTestSuite is a test suite to be used with the fee grant ante handler tests.
+type AnteTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	clientCtx client.Context
+}
+
+// SetupTest setups a new test, with new app and context.
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+	suite.app.AccountKeeper.SetParams(suite.ctx, authtypes.DefaultParams())
+
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	suite.clientCtx = client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+}
+
+func (suite *AnteTestSuite) TestDeductFeesNoDelegation() {
+	app, ctx := suite.app, suite.ctx
+	dfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
+	anteHandler := sdk.ChainAnteDecorators(dfd)
+
+	// payer has enough funds to pay for its own fees
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	suite.fundAccount(addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999)))
+
+	// payer has no funds at all
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+
+	// granter with funds, which allows grantee to spend up to 500atom
+	_, _, addr3 := testdata.KeyTestPubAddr()
+	suite.fundAccount(addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999)))
+
+	// grantee without any funds of its own
+	_, _, addr4 := testdata.KeyTestPubAddr()
+	err := app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr3, addr4, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	})
+	suite.Require().NoError(err)
+
+	// granter without any funds who allows addr2 to spend
+	_, _, addr5 := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr5))
+	err = app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr5, addr2, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	})
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		signer  sdk.AccAddress
+		feeAcc  sdk.AccAddress
+		fee     int64
+		valid   bool
+		balance sdk.AccAddress
+	}{
+		"paying with good funds": {
+			signer: addr1,
+			fee:    50,
+			valid:  true,
+		},
+		"paying with no funds": {
+			signer: addr2,
+			fee:    50,
+			valid:  false,
+		},
+		"valid fee grant": {
+			signer: addr4,
+			feeAcc: addr3,
+			fee:    50,
+			valid:  true,
+		},
+		"no fee grant": {
+			signer: addr1,
+			feeAcc: addr3,
+			fee:    2,
+			valid:  false,
+		},
+		"fee over allowance": {
+			signer: addr4,
+			feeAcc: addr3,
+			fee:    1000,
+			valid:  false,
+		},
+		"granter has no funds": {
+			signer: addr2,
+			feeAcc: addr5,
+			fee:    50,
+			valid:  false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		suite.Run(name, func() {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(tc.signer)))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetFeeGranter(tc.feeAcc)
+
+			_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+			if tc.valid {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// the grantee's fees were paid out of the granter's account
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 99949)),
+		app.BankKeeper.GetAllBalances(ctx, addr3),
+	)
+	allowance, err := app.FeeGrantKeeper.GetFeeAllowance(ctx, addr3, addr4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 450)),
+		allowance.(*types.BasicFeeAllowance).SpendLimit,
+	)
+}
+
+func (suite *AnteTestSuite) fundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, addr, coins))
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
@@ -0,0 +1,479 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg          network.Config
+	network      *network.Network
+	addedGranter sdk.AccAddress
+	addedGrantee sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	granter := val.Address
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic("grantee", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	grantee := info.GetAddress()
+
+	args := append(
+		[]string{
+			granter.String(),
+			grantee.String(),
+			fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+			fmt.Sprintf("--%s=%s", cli.FlagExpiration, time.Now().Add(24*time.Hour).UTC().Format(time.RFC3339)),
+		},
+		commonFlags(s.cfg)...,
+	)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdFeeGrant(), args)
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	s.addedGranter = granter
+	s.addedGrantee = grantee
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func commonFlags(cfg network.Config) []string {
+	return []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdGetFeeGrant() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	grantee := s.addedGrantee
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"wrong grantee",
+			[]string{
+				granter.String(),
+				"wrong_grantee",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"non existed grant",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid req",
+			[]string{
+				granter.String(),
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var grant types.FeeAllowanceGrant
+			s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &grant), out.String())
+			s.Require().Equal(granter.String(), grant.Granter)
+			s.Require().Equal(grantee.String(), grant.Grantee)
+
+			allowance, err := grant.GetFeeGrant()
+			s.Require().NoError(err)
+			s.Require().Equal(
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+				allowance.(*types.BasicFeeAllowance).SpendLimit,
+			)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCmdGetFeeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.addedGrantee
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		resp         *types.QueryFeeAllowancesResponse
+		expectLength int
+	}{
+		{
+			"wrong grantee",
+			[]string{
+				"wrong_grantee",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, 0,
+		},
+		{
+			"non existed grantee",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &types.QueryFeeAllowancesResponse{}, 0,
+		},
+		{
+			"valid req",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &types.QueryFeeAllowancesResponse{}, 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrants()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.resp), out.String())
+			s.Require().Len(tc.resp.FeeAllowances, tc.expectLength)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	alreadyExistedGrantee := s.addedGrantee
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"wrong granter address",
+			append(
+				[]string{
+					"wrong_granter",
+					"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"wrong grantee address",
+			append(
+				[]string{
+					granter.String(),
+					"wrong_grantee",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"self grant",
+			append(
+				[]string{
+					granter.String(),
+					granter.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"valid basic fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"try to add existed grant",
+			append(
+				[]string{
+					granter.String(),
+					alreadyExistedGrantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"invalid number of args(periodic fee grant)",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"period mentioned and period limit omitted, invalid periodic grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 10*60*60),
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"valid periodic fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 10*60*60),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid filtered fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1qk93t4j0yyzgqgt6k5qf8deh8fq6smpn3ntu3x",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.gov.v1beta1.MsgVote"),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	clientCtx := val.ClientCtx
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1p9qh4ldfd6n0qehujsal4k7g0e37kel90rc4ts")
+	s.Require().NoError(err)
+
+	// create a grant to be revoked
+	args := append(
+		[]string{
+			granter.String(),
+			grantee.String(),
+			fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+		},
+		commonFlags(s.cfg)...,
+	)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdFeeGrant(), args)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid grantee",
+			append(
+				[]string{
+					granter.String(),
+					"wrong_grantee",
+				},
+				commonFlags(s.cfg)...,
+			),
+			true, nil, 0,
+		},
+		{
+			"valid revoke",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"non existed grant",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+				},
+				commonFlags(s.cfg)...,
+			),
+			false, &sdk.TxResponse{}, types.ErrNoAllowance.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdRevokeFeegrant()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	granter := s.addedGranter
+	grantee := s.addedGrantee
+
+	// give the grantee just enough to cover the send amount, so the tx only
+	// succeeds when the fees are deducted from the granter's allowance
+	_, err := banktestutil.MsgSendExec(
+		clientCtx, val.Address, grantee,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+		commonFlags(s.cfg)...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	out, err := banktestutil.MsgSendExec(
+		clientCtx, grantee, granter,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+		append(commonFlags(s.cfg), fmt.Sprintf("--%s=%s", flags.FlagFeeAccount, granter.String()))...,
+	)
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+	msgSrvr     types.MsgServer
+	atom        sdk.Coins
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = queryClient
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	suite.atom = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(555)))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	basic := &types.BasicFeeAllowance{SpendLimit: suite.atom}
+	basic2 := &types.BasicFeeAllowance{SpendLimit: eth}
+
+	// let's set up some initial state here
+	err := k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], basic)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic2)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[3], basic)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[3], suite.addrs[0], basic2)
+	suite.Require().NoError(err)
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[2]))
+	suite.Require().Error(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[2]))
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic2)
+	suite.Require().NoError(err)
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr (basic2)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+		},
+		"addr revoked and added": {
+			granter:   suite.addrs[0],
+			grantee:   suite.addrs[2],
+			allowance: basic,
+		},
+		"addr never there": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[3],
+		},
+		"addr modified": {
+			granter:   suite.addrs[1],
+			grantee:   suite.addrs[2],
+			allowance: basic2,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow, _ := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+
+			if tc.allowance == nil {
+				suite.Nil(allow)
+				return
+			}
+			suite.NotNil(allow)
+			suite.Equal(tc.allowance, allow)
+		})
+	}
+
+	grant1, err := types.NewFeeAllowanceGrant(suite.addrs[3], suite.addrs[0], basic2)
+	suite.NoError(err)
+
+	grant2, err := types.NewFeeAllowanceGrant(suite.addrs[1], suite.addrs[2], basic2)
+	suite.NoError(err)
+
+	grant3, err := types.NewFeeAllowanceGrant(suite.addrs[0], suite.addrs[2], basic)
+	suite.NoError(err)
+
+	allCases := map[string]struct {
+		grantee sdk.AccAddress
+		grants  []types.FeeAllowanceGrant
+	}{
+		"addr2 has none": {
+			grantee: suite.addrs[1],
+		},
+		"addr has one": {
+			grantee: suite.addrs[0],
+			grants:  []types.FeeAllowanceGrant{grant1},
+		},
+		"addr3 has two": {
+			grantee: suite.addrs[2],
+			grants:  []types.FeeAllowanceGrant{grant3, grant2},
+		},
+	}
+
+	for name, tc := range allCases {
+		tc := tc
+		suite.Run(name, func() {
+			var grants []types.FeeAllowanceGrant
+			err := k.IterateAllGranteeFeeAllowances(ctx, tc.grantee, func(grant types.FeeAllowanceGrant) bool {
+				grants = append(grants, grant)
+				return false
+			})
+			suite.NoError(err)
+			suite.Equal(len(tc.grants), len(grants))
+			for i := range tc.grants {
+				suite.Equal(tc.grants[i].Granter, grants[i].Granter)
+				suite.Equal(tc.grants[i].Grantee, grants[i].Grantee)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	blockTime := ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+
+	future := &types.BasicFeeAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 554)),
+		Expiration: &oneYear,
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     suite.atom,
+			allowed: true,
+			final:   nil,
+		},
+		"too high": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"wrong denom": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     eth,
+			allowed: false,
+			final:   future,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			err := k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], &types.BasicFeeAllowance{
+				SpendLimit: suite.atom,
+				Expiration: &oneYear,
+			})
+			suite.Require().NoError(err)
+
+			err = k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, []sdk.Msg{})
+			if tc.allowed {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+
+			loaded, _ := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.final == nil {
+				suite.Nil(loaded)
+				return
+			}
+			suite.Equal(tc.final, loaded)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	basic := &types.BasicFeeAllowance{SpendLimit: suite.atom}
+
+	msg, err := types.NewMsgGrantAllowance(basic, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.GrantAllowance(ctx, msg)
+	suite.Require().NoError(err)
+
+	// a second grant between the same accounts is rejected
+	_, err = suite.msgSrvr.GrantAllowance(ctx, msg)
+	suite.Require().Error(err)
+
+	revoke := types.NewMsgRevokeAllowance(suite.addrs[0], suite.addrs[1])
+	_, err = suite.msgSrvr.RevokeAllowance(ctx, &revoke)
+	suite.Require().NoError(err)
+
+	// nothing left to revoke
+	_, err = suite.msgSrvr.RevokeAllowance(ctx, &revoke)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	k := suite.app.FeeGrantKeeper
+	basic := &types.BasicFeeAllowance{SpendLimit: suite.atom}
+	suite.Require().NoError(k.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], basic))
+
+	genesis, err := k.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genesis.FeeAllowances, 1)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+
+	// import into a fresh store
+	suite.SetupTest()
+	k = suite.app.FeeGrantKeeper
+	suite.Require().NoError(k.InitGenesis(suite.ctx, genesis))
+
+	allowance, err := k.GetFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(basic, allowance)
+
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
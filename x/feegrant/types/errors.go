@@ -0,0 +1,28 @@
+// DONTCOVER
+
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codes for feegrant errors
+const (
+	DefaultCodespace = ModuleName
+)
+
+// x/feegrant module sentinel errors
+var (
+	// ErrFeeLimitExceeded error if there are not enough allowance to cover the fees
+	ErrFeeLimitExceeded = sdkerrors.Register(DefaultCodespace, 2, "fee limit exceeded")
+	// ErrFeeLimitExpired error if the allowance has expired
+	ErrFeeLimitExpired = sdkerrors.Register(DefaultCodespace, 3, "fee allowance expired")
+	// ErrInvalidDuration error if the Duration is invalid or doesn't match the expiration
+	ErrInvalidDuration = sdkerrors.Register(DefaultCodespace, 4, "invalid duration")
+	// ErrNoAllowance error if there is no allowance for that pair
+	ErrNoAllowance = sdkerrors.Register(DefaultCodespace, 5, "no allowance")
+	// ErrNoMessages error if there are no allowed messages in a message-filtered allowance
+	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
+	// ErrMessageNotAllowed error if a message is not covered by a message-filtered allowance
+	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+)
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestFilteredFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	sendMsg := &banktypes.MsgSend{}
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+
+	cases := map[string]struct {
+		allowance   types.FeeAllowanceI
+		allowedMsgs []string
+		msgs        []sdk.Msg
+		valid       bool
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"no messages": {
+			allowance: &types.BasicFeeAllowance{SpendLimit: atom},
+		},
+		"msg not allowed": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom},
+			allowedMsgs: []string{"/cosmos.gov.v1beta1.MsgVote"},
+			msgs:        []sdk.Msg{sendMsg},
+			valid:       true,
+		},
+		"msg allowed": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sendMsg},
+			valid:       true,
+			accept:      true,
+			remains:     leftAtom,
+		},
+		"service msg allowed": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: sendMsg}},
+			valid:       true,
+			accept:      true,
+			remains:     leftAtom,
+		},
+		"inner allowance used up": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: smallAtom},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sendMsg},
+			valid:       true,
+			accept:      true,
+			remove:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := types.NewAllowedMsgFeeAllowance(tc.allowance, tc.allowedMsgs)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := allowance.Accept(ctx, smallAtom, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				inner, err := allowance.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, inner.(*types.BasicFeeAllowance).SpendLimit)
+			}
+		})
+	}
+}
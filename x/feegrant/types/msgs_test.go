@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 2))
+	basic := &types.BasicFeeAllowance{SpendLimit: atom}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		grant   *types.BasicFeeAllowance
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			grant:   basic,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+			grant:   basic,
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+			grant:   basic,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			grant:   basic,
+		},
+		"invalid allowance": {
+			grantee: addr,
+			granter: addr2,
+			grant:   &types.BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.ZeroInt()}}},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantAllowance(tc.grant, tc.granter, tc.grantee)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgGrantAllowance, msg.Type())
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tc.grant, allowance)
+		})
+	}
+}
+
+func TestMsgRevokeAllowance(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: sdk.AccAddress{},
+		},
+		"no granter": {
+			granter: sdk.AccAddress{},
+			grantee: addr,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgRevokeAllowance(tc.granter, tc.grantee)
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, types.TypeMsgRevokeAllowance, msg.Type())
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryFeeAllowanceResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryFeeAllowancesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.FeeAllowance == nil {
+		return nil
+	}
+
+	return m.FeeAllowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range m.FeeAllowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	v038 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v038"
 	v039 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v039"
 	v040 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v040"
+	v041 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v041"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -31,6 +32,7 @@ var migrationMap = types.MigrationMap{
 	"v0.38": v038.Migrate, // NOTE: v0.37 and v0.38 are genesis compatible
 	"v0.39": v039.Migrate,
 	"v0.40": v040.Migrate,
+	"v0.41": v041.Migrate,
 }
 
 // GetMigrationCallback returns a MigrationCallback for a given version.
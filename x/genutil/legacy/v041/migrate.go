@@ -0,0 +1,47 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	v040gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v040"
+	v041gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v041"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v040slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v040"
+	v041slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v041"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Migrate migrates exported state from v0.40 to a v0.41 genesis state.
+func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
+	v041Codec := clientCtx.JSONMarshaler
+
+	// Migrate x/gov.
+	if appState[v040gov.ModuleName] != nil {
+		// unmarshal relative source genesis application state
+		var govGenState govtypes.GenesisState
+		v041Codec.MustUnmarshalJSON(appState[v040gov.ModuleName], &govGenState)
+
+		// delete deprecated x/gov genesis state
+		delete(appState, v040gov.ModuleName)
+
+		// Migrate relative source genesis application state and marshal it into
+		// the respective key.
+		appState[govtypes.ModuleName] = v041Codec.MustMarshalJSON(v041gov.Migrate(&govGenState))
+	}
+
+	// Migrate x/slashing.
+	if appState[v040slashing.ModuleName] != nil {
+		// unmarshal relative source genesis application state
+		var slashingGenState slashingtypes.GenesisState
+		v041Codec.MustUnmarshalJSON(appState[v040slashing.ModuleName], &slashingGenState)
+
+		// delete deprecated x/slashing genesis state
+		delete(appState, v040slashing.ModuleName)
+
+		// Migrate relative source genesis application state and marshal it into
+		// the respective key.
+		appState[slashingtypes.ModuleName] = v041Codec.MustMarshalJSON(v041slashing.Migrate(&slashingGenState))
+	}
+
+	return appState
+}
@@ -78,35 +78,30 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
 		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
 	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
 	for len(votes) < totalLimit {
-		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
+		// search for both the votes and the weighted votes
+		txs, hasMore, err := combineEvents(
+			clientCtx, nextTxPage,
+			voteEvents(types.TypeMsgVote, params.ProposalID),
+			voteEvents(types.TypeMsgVoteWeighted, params.ProposalID),
+		)
 		if err != nil {
 			return nil, err
 		}
 		nextTxPage++
-		for _, info := range searchResult.Txs {
+		for _, info := range txs {
 			for _, msg := range info.GetTx().GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
-		if len(searchResult.Txs) != defaultLimit {
+		if !hasMore {
 			break
 		}
 	}
@@ -127,28 +122,24 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
-	if err != nil {
-		return nil, err
-	}
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.GetTx().GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Option:     voteMsg.Option,
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := append(
+			voteEvents(msgType, params.ProposalID),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		)
+
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.GetTx().GetMsgs() {
+				// there should only be a single vote under the given conditions
+				vote, ok := voteFromMsg(msg, params.ProposalID)
+				if !ok {
+					continue
 				}
 
 				bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
@@ -164,6 +155,62 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteEvents returns the events of the txs voting on the given proposal with
+// messages of the given type.
+func voteEvents(msgType string, proposalID uint64) []string {
+	return []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+	}
+}
+
+// combineEvents queries the given page of txs matching each group of events
+// and concatenates the results. It also reports whether any of the groups may
+// have more txs on the next page.
+func combineEvents(clientCtx client.Context, page int, eventGroups ...[]string) ([]*sdk.TxResponse, bool, error) {
+	var (
+		txs     []*sdk.TxResponse
+		hasMore bool
+	)
+	for _, events := range eventGroups {
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, page, defaultLimit, "")
+		if err != nil {
+			return nil, false, err
+		}
+
+		txs = append(txs, searchResult.Txs...)
+		if len(searchResult.Txs) == defaultLimit {
+			hasMore = true
+		}
+	}
+
+	return txs, hasMore, nil
+}
+
+// voteFromMsg builds the vote on the given proposal cast by a MsgVote or a
+// MsgVoteWeighted. It returns false for any other message.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Option:     msg.Option,
+			Options:    types.NewNonSplitVoteOption(msg.Option),
+		}, true
+
+	case *types.MsgVoteWeighted:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    msg.Options,
+		}, true
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositParams) ([]byte, error) {
@@ -2,6 +2,7 @@ package utils_test
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,7 +19,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+var messageAction = regexp.MustCompile(`message\.action='(.*?)'`)
+
 type TxSearchMock struct {
+	txConfig client.TxConfig
 	mock.Client
 	txs []tmtypes.Tx
 }
@@ -32,12 +36,28 @@ func (mock TxSearchMock) TxSearch(ctx context.Context, query string, prove bool,
 		*perPage = 0
 	}
 
-	start, end := client.Paginate(len(mock.txs), *page, *perPage, 100)
+	// only keep the txs with a message of the searched type
+	msgType := messageAction.FindStringSubmatch(query)[1]
+	matchingTxs := make([]tmtypes.Tx, 0)
+	for _, tx := range mock.txs {
+		sdkTx, err := mock.txConfig.TxDecoder()(tx)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range sdkTx.GetMsgs() {
+			if msg.Type() == msgType {
+				matchingTxs = append(matchingTxs, tx)
+				break
+			}
+		}
+	}
+
+	start, end := client.Paginate(len(matchingTxs), *page, *perPage, 100)
 	if start < 0 || end < 0 {
 		// nil result with nil error crashes utils.QueryTxsByEvents
 		return &ctypes.ResultTxSearch{}, nil
 	}
-	txs := mock.txs[start:end]
+	txs := matchingTxs[start:end]
 	rst := &ctypes.ResultTxSearch{Txs: make([]*ctypes.ResultTx, len(txs)), TotalCount: len(txs)}
 	for i := range txs {
 		rst.Txs[i] = &ctypes.ResultTx{Tx: txs[i]}
@@ -77,6 +97,17 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	yesVote := func(voter sdk.AccAddress) types.Vote {
+		return types.Vote{
+			Voter:   voter.String(),
+			Option:  types.OptionYes,
+			Options: types.NewNonSplitVoteOption(types.OptionYes),
+		}
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -87,8 +118,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				yesVote(acc1),
+				yesVote(acc2)},
 		},
 		{
 			description: "2MsgPerTx1Chunk",
@@ -99,8 +130,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				yesVote(acc1),
+				yesVote(acc1)},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -111,8 +142,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				yesVote(acc2),
+				yesVote(acc2)},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -121,7 +152,20 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{yesVote(acc1)},
+		},
+		{
+			description: "WeightedAndLegacyVotes",
+			page:        1,
+			limit:       2,
+			msgs: [][]sdk.Msg{
+				{types.NewMsgVoteWeighted(acc1, 0, weightedOptions)},
+				acc2Msgs[:1],
+			},
+			votes: []types.Vote{
+				yesVote(acc2),
+				{Voter: acc1.String(), Options: weightedOptions},
+			},
 		},
 		{
 			description: "InvalidPage",
@@ -148,7 +192,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			)
 
 			encodingConfig := simapp.MakeTestEncodingConfig()
-			cli := TxSearchMock{txs: marshalled}
+			cli := TxSearchMock{txConfig: encodingConfig.TxConfig, txs: marshalled}
 			clientCtx := client.Context{}.
 				WithLegacyAmino(cdc).
 				WithClient(cli).
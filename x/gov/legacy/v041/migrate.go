@@ -0,0 +1,26 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Migrate accepts exported v0.40 x/gov genesis state and migrates it to
+// v0.41 x/gov genesis state. The migration includes:
+//
+// - Convert the single option of each vote into weighted vote options.
+func Migrate(oldGovState *types.GenesisState) *types.GenesisState {
+	newVotes := make(types.Votes, len(oldGovState.Votes))
+	for i, oldVote := range oldGovState.Votes {
+		newVotes[i] = migrateVote(oldVote)
+	}
+
+	return &types.GenesisState{
+		StartingProposalId: oldGovState.StartingProposalId,
+		Deposits:           oldGovState.Deposits,
+		Votes:              newVotes,
+		Proposals:          oldGovState.Proposals,
+		DepositParams:      oldGovState.DepositParams,
+		VotingParams:       oldGovState.VotingParams,
+		TallyParams:        oldGovState.TallyParams,
+	}
+}
@@ -0,0 +1,57 @@
+package v041_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	v041gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithJSONMarshaler(encodingConfig.Marshaler)
+
+	govGenState := types.DefaultGenesisState()
+	govGenState.Votes = types.Votes{
+		{ProposalId: 1, Voter: "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh", Option: types.OptionAbstain},
+	}
+
+	migrated := v041gov.Migrate(govGenState)
+
+	bz, err := clientCtx.JSONMarshaler.MarshalJSON(migrated)
+	require.NoError(t, err)
+
+	// Indent the JSON bz correctly.
+	var jsonObj map[string]interface{}
+	err = json.Unmarshal(bz, &jsonObj)
+	require.NoError(t, err)
+	indentedBz, err := json.MarshalIndent(jsonObj["votes"], "", "  ")
+	require.NoError(t, err)
+
+	// Make sure about:
+	// - the single option is converted into a non-split weighted option.
+	expected := `[
+  {
+    "option": "VOTE_OPTION_UNSPECIFIED",
+    "options": [
+      {
+        "option": "VOTE_OPTION_ABSTAIN",
+        "weight": "1.000000000000000000"
+      }
+    ],
+    "proposal_id": "1",
+    "voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+  }
+]`
+
+	require.Equal(t, expected, string(indentedBz))
+}
@@ -0,0 +1,59 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// migrateVote converts a vote holding a single option into a non-split
+// weighted vote.
+func migrateVote(oldVote types.Vote) types.Vote {
+	if len(oldVote.Options) > 0 {
+		oldVote.Option = types.OptionEmpty
+		return oldVote
+	}
+
+	return types.Vote{
+		ProposalId: oldVote.ProposalId,
+		Voter:      oldVote.Voter,
+		Options:    types.NewNonSplitVoteOption(oldVote.Option),
+	}
+}
+
+// migrateStoreVotes migrates all votes to store their weighted options
+// instead of the deprecated single option.
+func migrateStoreVotes(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	votesStore := prefix.NewStore(store, types.VotesKeyPrefix)
+
+	iterator := votesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var oldVote types.Vote
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &oldVote); err != nil {
+			return err
+		}
+
+		newVote := migrateVote(oldVote)
+		bz, err := cdc.MarshalBinaryBare(&newVote)
+		if err != nil {
+			return err
+		}
+
+		votesStore.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Change votes to store a list of weighted vote options.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateStoreVotes(store, cdc)
+}
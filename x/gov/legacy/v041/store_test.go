@@ -0,0 +1,61 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(govKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	// Old votes were stored with a single option.
+	oldVote := types.Vote{ProposalId: 1, Voter: addr1.String(), Option: types.OptionNoWithVeto}
+	// Votes already holding weighted options are left untouched.
+	weightedVote := types.Vote{
+		ProposalId: 1,
+		Voter:      addr2.String(),
+		Options: types.WeightedVoteOptions{
+			{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+			{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+		},
+	}
+
+	store := ctx.KVStore(govKey)
+	store.Set(types.VoteKey(1, addr1), encCfg.Marshaler.MustMarshalBinaryBare(&oldVote))
+	store.Set(types.VoteKey(1, addr2), encCfg.Marshaler.MustMarshalBinaryBare(&weightedVote))
+
+	// Run migration.
+	err := v041gov.MigrateStore(ctx, govKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	var newVote types.Vote
+	encCfg.Marshaler.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addr1)), &newVote)
+	require.Equal(t, types.OptionEmpty, newVote.Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNoWithVeto).String(), types.WeightedVoteOptions(newVote.Options).String())
+
+	var newWeightedVote types.Vote
+	encCfg.Marshaler.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addr2)), &newWeightedVote)
+	require.Equal(t, types.OptionEmpty, newWeightedVote.Option)
+	require.Equal(t, types.WeightedVoteOptions(weightedVote.Options).String(), types.WeightedVoteOptions(newWeightedVote.Options).String())
+}
@@ -121,6 +121,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -131,7 +169,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +209,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +254,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +294,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,15 +326,21 @@ var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
-	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1. In all
+	// other cases, this field will default to VOTE_OPTION_UNSPECIFIED.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	// options is the list of weighted vote options of the voter. Their weights
+	// sum to 1.
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +381,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +419,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +463,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +495,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
@@ -464,94 +509,98 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x6c, 0xdb, 0xd4,
-	0x17, 0x8e, 0xd3, 0xbf, 0xb9, 0x49, 0x5b, 0xef, 0x36, 0x6b, 0x53, 0xff, 0xf6, 0xb3, 0x8d, 0x41,
-	0xa8, 0x9a, 0xb6, 0x74, 0x2b, 0x08, 0x44, 0x27, 0x21, 0x92, 0xc6, 0x63, 0x41, 0x53, 0x12, 0x39,
-	0x5e, 0xa6, 0x8d, 0x07, 0xcb, 0x49, 0xee, 0x52, 0x43, 0xec, 0x1b, 0xe2, 0x9b, 0xd2, 0x88, 0x17,
-	0x1e, 0xa7, 0x20, 0xa1, 0xbd, 0x31, 0x09, 0x45, 0x9a, 0xc4, 0x1b, 0xcf, 0x3c, 0xf3, 0x5c, 0x21,
-	0x24, 0x26, 0x9e, 0x26, 0x90, 0x32, 0xd6, 0x49, 0x68, 0xea, 0x63, 0x1f, 0x78, 0x46, 0xf6, 0xbd,
-	0x6e, 0x9c, 0xa4, 0xa2, 0x84, 0xa7, 0xd9, 0xe7, 0x9e, 0xef, 0xfb, 0xce, 0xfd, 0x7c, 0xce, 0xc9,
-	0x0a, 0x2e, 0xd5, 0xb0, 0x6b, 0x63, 0x77, 0xab, 0x81, 0xf7, 0xb7, 0xf6, 0xaf, 0x57, 0x11, 0x31,
-	0xaf, 0x7b, 0xcf, 0xe9, 0x56, 0x1b, 0x13, 0x0c, 0x21, 0x3d, 0x4d, 0x7b, 0x11, 0x76, 0x2a, 0x88,
-	0x0c, 0x51, 0x35, 0x5d, 0x74, 0x0a, 0xa9, 0x61, 0xcb, 0xa1, 0x18, 0x21, 0xd9, 0xc0, 0x0d, 0xec,
-	0x3f, 0x6e, 0x79, 0x4f, 0x2c, 0xba, 0x41, 0x51, 0x06, 0x3d, 0x60, 0xb4, 0xf4, 0x48, 0x6a, 0x60,
-	0xdc, 0x68, 0xa2, 0x2d, 0xff, 0xad, 0xda, 0x79, 0xb0, 0x45, 0x2c, 0x1b, 0xb9, 0xc4, 0xb4, 0x5b,
-	0x01, 0x76, 0x3c, 0xc1, 0x74, 0xba, 0xec, 0x48, 0x1c, 0x3f, 0xaa, 0x77, 0xda, 0x26, 0xb1, 0x30,
-	0x2b, 0x46, 0xb9, 0x0b, 0x12, 0x3a, 0x3a, 0x20, 0xa5, 0x36, 0x6e, 0x61, 0xd7, 0x6c, 0xc2, 0x24,
-	0x98, 0x23, 0x16, 0x69, 0xa2, 0x14, 0x27, 0x73, 0x9b, 0x31, 0x8d, 0xbe, 0x40, 0x19, 0xc4, 0xeb,
-	0xc8, 0xad, 0xb5, 0xad, 0x96, 0x07, 0x4d, 0x45, 0xfd, 0xb3, 0x70, 0x68, 0x67, 0xe5, 0xd5, 0x13,
-	0x89, 0xfb, 0xf5, 0x87, 0xab, 0x0b, 0xbb, 0xd8, 0x21, 0xc8, 0x21, 0xca, 0x2f, 0x1c, 0x58, 0xc8,
-	0xa1, 0x16, 0x76, 0x2d, 0x02, 0xdf, 0x05, 0xf1, 0x16, 0x13, 0x30, 0xac, 0xba, 0x4f, 0x3d, 0x9b,
-	0x5d, 0x3b, 0x19, 0x48, 0xb0, 0x6b, 0xda, 0xcd, 0x1d, 0x25, 0x74, 0xa8, 0x68, 0x20, 0x78, 0xcb,
-	0xd7, 0xe1, 0x25, 0x10, 0xab, 0x53, 0x0e, 0xdc, 0x66, 0xaa, 0xc3, 0x00, 0xac, 0x81, 0x79, 0xd3,
-	0xc6, 0x1d, 0x87, 0xa4, 0x66, 0xe4, 0x99, 0xcd, 0xf8, 0xf6, 0x46, 0x9a, 0xd9, 0xe6, 0x39, 0x1f,
-	0x7c, 0x8e, 0xf4, 0x2e, 0xb6, 0x9c, 0xec, 0xb5, 0xc3, 0x81, 0x14, 0xf9, 0xfe, 0xb9, 0xb4, 0xd9,
-	0xb0, 0xc8, 0x5e, 0xa7, 0x9a, 0xae, 0x61, 0x9b, 0x79, 0xcc, 0xfe, 0xb9, 0xea, 0xd6, 0x3f, 0xdd,
-	0x22, 0xdd, 0x16, 0x72, 0x7d, 0x80, 0xab, 0x31, 0xea, 0x9d, 0xc5, 0x87, 0x4f, 0xa4, 0xc8, 0xab,
-	0x27, 0x52, 0x44, 0xf9, 0x6b, 0x1e, 0x2c, 0x9e, 0xfa, 0xf4, 0xf6, 0x59, 0x57, 0x5a, 0x3d, 0x1e,
-	0x48, 0x51, 0xab, 0x7e, 0x32, 0x90, 0x62, 0xf4, 0x62, 0xe3, 0xf7, 0xb9, 0x01, 0x16, 0x6a, 0xd4,
-	0x1f, 0xff, 0x36, 0xf1, 0xed, 0x64, 0x9a, 0x7e, 0x9f, 0x74, 0xf0, 0x7d, 0xd2, 0x19, 0xa7, 0x9b,
-	0x8d, 0xff, 0x34, 0x34, 0x52, 0x0b, 0x10, 0xb0, 0x02, 0xe6, 0x5d, 0x62, 0x92, 0x8e, 0x9b, 0x9a,
-	0x91, 0xb9, 0xcd, 0xe5, 0x6d, 0x25, 0x3d, 0xd9, 0x7c, 0xe9, 0xa0, 0xc0, 0xb2, 0x9f, 0x99, 0x15,
-	0x4e, 0x06, 0xd2, 0xda, 0x98, 0xc9, 0x94, 0x44, 0xd1, 0x18, 0x1b, 0x6c, 0x01, 0xf8, 0xc0, 0x72,
-	0xcc, 0xa6, 0x41, 0xcc, 0x66, 0xb3, 0x6b, 0xb4, 0x91, 0xdb, 0x69, 0x92, 0xd4, 0xac, 0x5f, 0x9f,
-	0x74, 0x96, 0x86, 0xee, 0xe5, 0x69, 0x7e, 0x5a, 0xf6, 0x35, 0xcf, 0xd8, 0x93, 0x81, 0xb4, 0x41,
-	0x45, 0x26, 0x89, 0x14, 0x8d, 0xf7, 0x83, 0x21, 0x10, 0xfc, 0x18, 0xc4, 0xdd, 0x4e, 0xd5, 0xb6,
-	0x88, 0xe1, 0x75, 0x72, 0x6a, 0xce, 0x97, 0x12, 0x26, 0xac, 0xd0, 0x83, 0x36, 0xcf, 0x8a, 0x4c,
-	0x85, 0xf5, 0x4b, 0x08, 0xac, 0x3c, 0x7a, 0x2e, 0x71, 0x1a, 0xa0, 0x11, 0x0f, 0x00, 0x2d, 0xc0,
-	0xb3, 0x16, 0x31, 0x90, 0x53, 0xa7, 0x0a, 0xf3, 0xe7, 0x2a, 0xbc, 0xce, 0x14, 0xd6, 0xa9, 0xc2,
-	0x38, 0x03, 0x95, 0x59, 0x66, 0x61, 0xd5, 0xa9, 0xfb, 0x52, 0x0f, 0x39, 0xb0, 0x44, 0x30, 0x31,
-	0x9b, 0x06, 0x3b, 0x48, 0x2d, 0x9c, 0xd7, 0x88, 0xb7, 0x98, 0x4e, 0x92, 0xea, 0x8c, 0xa0, 0x95,
-	0xa9, 0x1a, 0x34, 0xe1, 0x63, 0x83, 0x11, 0x6b, 0x82, 0x0b, 0xfb, 0x98, 0x58, 0x4e, 0xc3, 0xfb,
-	0xbc, 0x6d, 0x66, 0xec, 0xe2, 0xb9, 0xd7, 0x7e, 0x83, 0x95, 0x93, 0xa2, 0xe5, 0x4c, 0x50, 0xd0,
-	0x7b, 0xaf, 0xd0, 0x78, 0xd9, 0x0b, 0xfb, 0x17, 0x7f, 0x00, 0x58, 0x68, 0x68, 0x71, 0xec, 0x5c,
-	0x2d, 0x85, 0x69, 0xad, 0x8d, 0x68, 0x8d, 0x3a, 0xbc, 0x44, 0xa3, 0xcc, 0xe0, 0x9d, 0x59, 0x6f,
-	0xab, 0x28, 0x87, 0x51, 0x10, 0x0f, 0xb7, 0xcf, 0x07, 0x60, 0xa6, 0x8b, 0x5c, 0xba, 0xa1, 0xb2,
-	0x69, 0x8f, 0xf5, 0xb7, 0x81, 0xf4, 0xe6, 0xbf, 0x30, 0x2e, 0xef, 0x10, 0xcd, 0x83, 0xc2, 0x5b,
-	0x60, 0xc1, 0xac, 0xba, 0xc4, 0xb4, 0xd8, 0x2e, 0x9b, 0x9a, 0x25, 0x80, 0xc3, 0xf7, 0x41, 0xd4,
-	0xc1, 0xfe, 0x40, 0x4e, 0x4f, 0x12, 0x75, 0x30, 0x6c, 0x80, 0x84, 0x83, 0x8d, 0xcf, 0x2d, 0xb2,
-	0x67, 0xec, 0x23, 0x82, 0xfd, 0xb1, 0x8b, 0x65, 0xd5, 0xe9, 0x98, 0x4e, 0x06, 0xd2, 0x2a, 0x35,
-	0x35, 0xcc, 0xa5, 0x68, 0xc0, 0xc1, 0x77, 0x2d, 0xb2, 0x57, 0x41, 0x04, 0x33, 0x2b, 0xbf, 0xe1,
-	0xc0, 0x6c, 0x05, 0x13, 0xf4, 0xdf, 0x57, 0x72, 0x12, 0xcc, 0xed, 0x63, 0x82, 0x82, 0x75, 0x4c,
-	0x5f, 0xe0, 0x3b, 0x60, 0x1e, 0xd3, 0xdf, 0x06, 0xba, 0x9b, 0xc4, 0xb3, 0xf6, 0x86, 0x27, 0x5c,
-	0xf4, 0xb3, 0x34, 0x96, 0xbd, 0xb3, 0xf8, 0x38, 0xd8, 0xae, 0x3f, 0x46, 0xc1, 0x12, 0x6b, 0xe6,
-	0x92, 0xd9, 0x36, 0x6d, 0x17, 0x7e, 0xcb, 0x81, 0xb8, 0x6d, 0x39, 0xa7, 0xb3, 0xc5, 0x9d, 0x37,
-	0x5b, 0x86, 0xe7, 0xda, 0xf1, 0x40, 0xba, 0x18, 0x42, 0x5d, 0xc1, 0xb6, 0x45, 0x90, 0xdd, 0x22,
-	0xdd, 0xe1, 0xdd, 0x42, 0xc7, 0xd3, 0x8d, 0x1c, 0xb0, 0x2d, 0x27, 0x18, 0xb8, 0xaf, 0x39, 0x00,
-	0x6d, 0xf3, 0x20, 0x20, 0x32, 0x5a, 0xa8, 0x6d, 0xe1, 0x3a, 0x5b, 0xeb, 0x1b, 0x13, 0x63, 0x90,
-	0x63, 0x3f, 0xbb, 0xf4, 0xd3, 0x1e, 0x0f, 0xa4, 0x4b, 0x93, 0xe0, 0x91, 0x5a, 0xd9, 0x42, 0x9d,
-	0xcc, 0x52, 0x1e, 0x7b, 0x83, 0xc2, 0xdb, 0xe6, 0x41, 0x60, 0x17, 0x0d, 0x7f, 0xc5, 0x81, 0x44,
-	0xc5, 0x9f, 0x1e, 0xe6, 0xdf, 0x17, 0x80, 0x4d, 0x53, 0x50, 0x1b, 0x77, 0x5e, 0x6d, 0x37, 0x58,
-	0x6d, 0xeb, 0x23, 0xb8, 0x91, 0xb2, 0x92, 0x23, 0xc3, 0x1b, 0xae, 0x28, 0x41, 0x63, 0xac, 0x9a,
-	0xdf, 0x83, 0x99, 0x65, 0xc5, 0xdc, 0x07, 0xf3, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0xfb, 0x55, 0x24,
-	0xb2, 0xd9, 0x29, 0x3a, 0x3c, 0x87, 0x6a, 0xc7, 0x03, 0x89, 0xa7, 0xf8, 0x61, 0x35, 0x1a, 0x63,
-	0x84, 0x35, 0x10, 0x23, 0x7b, 0x6d, 0xe4, 0xee, 0xe1, 0x26, 0xfd, 0x00, 0x89, 0xa9, 0x06, 0x88,
-	0xd2, 0xaf, 0x9e, 0x52, 0x84, 0x14, 0x86, 0xbc, 0xb0, 0xc7, 0x81, 0x65, 0x6f, 0xaa, 0x8c, 0xa1,
-	0xd4, 0x8c, 0x2f, 0x55, 0x9b, 0x5a, 0x2a, 0x35, 0xca, 0x33, 0xe2, 0xef, 0x45, 0xe6, 0xef, 0x48,
-	0x86, 0xa2, 0x2d, 0x79, 0x01, 0x3d, 0x78, 0xbf, 0xfc, 0x27, 0x07, 0xc0, 0x70, 0x9a, 0xe0, 0x15,
-	0xb0, 0x5e, 0x29, 0xea, 0xaa, 0x51, 0x2c, 0xe9, 0xf9, 0x62, 0xc1, 0xb8, 0x53, 0x28, 0x97, 0xd4,
-	0xdd, 0xfc, 0xcd, 0xbc, 0x9a, 0xe3, 0x23, 0xc2, 0x4a, 0xaf, 0x2f, 0xc7, 0x69, 0xa2, 0xea, 0x89,
-	0x40, 0x05, 0xac, 0x84, 0xb3, 0xef, 0xa9, 0x65, 0x9e, 0x13, 0x96, 0x7a, 0x7d, 0x39, 0x46, 0xb3,
-	0xee, 0x21, 0x17, 0x5e, 0x06, 0xab, 0xe1, 0x9c, 0x4c, 0xb6, 0xac, 0x67, 0xf2, 0x05, 0x3e, 0x2a,
-	0x5c, 0xe8, 0xf5, 0xe5, 0x25, 0x9a, 0x97, 0x61, 0x2b, 0x50, 0x06, 0xcb, 0xe1, 0xdc, 0x42, 0x91,
-	0x9f, 0x11, 0x12, 0xbd, 0xbe, 0xbc, 0x48, 0xd3, 0x0a, 0x18, 0x6e, 0x83, 0xd4, 0x68, 0x86, 0x71,
-	0x37, 0xaf, 0xdf, 0x32, 0x2a, 0xaa, 0x5e, 0xe4, 0x67, 0x85, 0x64, 0xaf, 0x2f, 0xf3, 0x41, 0x6e,
-	0xb0, 0xaf, 0x84, 0xd9, 0x87, 0xdf, 0x89, 0x91, 0xcb, 0x3f, 0x47, 0xc1, 0xf2, 0xe8, 0x7f, 0x69,
-	0x60, 0x1a, 0xfc, 0xaf, 0xa4, 0x15, 0x4b, 0xc5, 0x72, 0xe6, 0xb6, 0x51, 0xd6, 0x33, 0xfa, 0x9d,
-	0xf2, 0xd8, 0x85, 0xfd, 0xab, 0xd0, 0xe4, 0x82, 0xd5, 0x84, 0x37, 0x80, 0x38, 0x9e, 0x9f, 0x53,
-	0x4b, 0xc5, 0x72, 0x5e, 0x37, 0x4a, 0xaa, 0x96, 0x2f, 0xe6, 0x78, 0x4e, 0x58, 0xef, 0xf5, 0xe5,
-	0x55, 0x0a, 0x19, 0x19, 0x2a, 0xf8, 0x1e, 0xf8, 0xff, 0x38, 0xb8, 0x52, 0xd4, 0xf3, 0x85, 0x0f,
-	0x03, 0x6c, 0x54, 0x58, 0xeb, 0xf5, 0x65, 0x48, 0xb1, 0x95, 0xd0, 0x04, 0xc0, 0x2b, 0x60, 0x6d,
-	0x1c, 0x5a, 0xca, 0x94, 0xcb, 0x6a, 0x8e, 0x9f, 0x11, 0xf8, 0x5e, 0x5f, 0x4e, 0x50, 0x4c, 0xc9,
-	0x74, 0x5d, 0x54, 0x87, 0xd7, 0x40, 0x6a, 0x3c, 0x5b, 0x53, 0x3f, 0x52, 0x77, 0x75, 0x35, 0xc7,
-	0xcf, 0x0a, 0xb0, 0xd7, 0x97, 0x97, 0x69, 0xbe, 0x86, 0x3e, 0x41, 0x35, 0x82, 0xce, 0xe4, 0xbf,
-	0x99, 0xc9, 0xdf, 0x56, 0x73, 0xfc, 0x5c, 0x98, 0xff, 0xa6, 0x69, 0x35, 0x51, 0x9d, 0xda, 0x99,
-	0x2d, 0x1c, 0xbe, 0x10, 0x23, 0xcf, 0x5e, 0x88, 0x91, 0x2f, 0x8f, 0xc4, 0xc8, 0xe1, 0x91, 0xc8,
-	0x3d, 0x3d, 0x12, 0xb9, 0x3f, 0x8e, 0x44, 0xee, 0xd1, 0x4b, 0x31, 0xf2, 0xf4, 0xa5, 0x18, 0x79,
-	0xf6, 0x52, 0x8c, 0xdc, 0xff, 0xe7, 0x85, 0x78, 0xe0, 0xff, 0x29, 0xe4, 0xf7, 0x73, 0x75, 0xde,
-	0xdf, 0x21, 0x6f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xef, 0x91, 0x01, 0x67, 0x25, 0x0d, 0x00,
-	0x00,
+	// 1447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x6c, 0xdb, 0x54,
+	0x18, 0x8e, 0x93, 0x34, 0x6d, 0x5e, 0xd2, 0xd6, 0x7b, 0xed, 0xda, 0x34, 0x0c, 0x3b, 0x18, 0x34,
+	0x55, 0xd3, 0x96, 0x6e, 0x05, 0x81, 0xe8, 0x24, 0x20, 0x6e, 0x5c, 0x16, 0x34, 0x25, 0x91, 0x93,
+	0xa5, 0xda, 0x38, 0x58, 0x6e, 0xf2, 0x96, 0x1a, 0x62, 0xbf, 0x10, 0xbf, 0x74, 0xad, 0xb8, 0x70,
+	0x9c, 0x82, 0x84, 0x76, 0x9c, 0x84, 0x22, 0x4d, 0x42, 0x5c, 0x38, 0x73, 0xe6, 0x5c, 0x21, 0x24,
+	0x26, 0x4e, 0x13, 0x48, 0x19, 0x6b, 0x25, 0x34, 0xf5, 0xd8, 0x03, 0x67, 0x64, 0xbf, 0xe7, 0xc4,
+	0x49, 0x2a, 0xba, 0xec, 0x54, 0xfb, 0x7f, 0xff, 0xf7, 0xfd, 0xff, 0xff, 0xe5, 0xff, 0xff, 0xe7,
+	0x82, 0x4b, 0x55, 0x6c, 0x9b, 0xd8, 0x5e, 0xab, 0xe3, 0xbd, 0xb5, 0xbd, 0x1b, 0x3b, 0x88, 0xe8,
+	0x37, 0x9c, 0xe7, 0x74, 0xb3, 0x85, 0x09, 0x86, 0x90, 0x9e, 0xa6, 0x1d, 0x0b, 0x3b, 0x4d, 0x0a,
+	0x0c, 0xb1, 0xa3, 0xdb, 0xa8, 0x0f, 0xa9, 0x62, 0xc3, 0xa2, 0x98, 0xe4, 0x62, 0x1d, 0xd7, 0xb1,
+	0xfb, 0xb8, 0xe6, 0x3c, 0x31, 0xeb, 0x0a, 0x45, 0x69, 0xf4, 0x80, 0xd1, 0xd2, 0x23, 0xb1, 0x8e,
+	0x71, 0xbd, 0x81, 0xd6, 0xdc, 0xb7, 0x9d, 0xf6, 0xfd, 0x35, 0x62, 0x98, 0xc8, 0x26, 0xba, 0xd9,
+	0xf4, 0xb0, 0xa3, 0x0e, 0xba, 0x75, 0xc0, 0x8e, 0x84, 0xd1, 0xa3, 0x5a, 0xbb, 0xa5, 0x13, 0x03,
+	0xb3, 0x64, 0xa4, 0x1f, 0x39, 0x00, 0xb7, 0x91, 0x51, 0xdf, 0x25, 0xa8, 0x56, 0xc1, 0x04, 0x15,
+	0x9a, 0xce, 0x21, 0x7c, 0x1f, 0x44, 0xb0, 0xfb, 0x94, 0xe0, 0x52, 0xdc, 0xea, 0xdc, 0xba, 0x90,
+	0x1e, 0x2f, 0x34, 0x3d, 0xf0, 0x57, 0x99, 0x37, 0xdc, 0x06, 0x91, 0x07, 0x2e, 0x5b, 0x22, 0x98,
+	0xe2, 0x56, 0xa3, 0xf2, 0xc7, 0x87, 0x3d, 0x31, 0xf0, 0x67, 0x4f, 0xbc, 0x5c, 0x37, 0xc8, 0x6e,
+	0x7b, 0x27, 0x5d, 0xc5, 0x26, 0xab, 0x8d, 0xfd, 0xb9, 0x66, 0xd7, 0xbe, 0x5c, 0x23, 0x07, 0x4d,
+	0x64, 0xa7, 0xb3, 0xa8, 0x7a, 0xda, 0x13, 0x67, 0x0f, 0x74, 0xb3, 0xb1, 0x21, 0x51, 0x16, 0x49,
+	0x65, 0x74, 0xd2, 0x36, 0x88, 0x97, 0xd1, 0x3e, 0x29, 0xb6, 0x70, 0x13, 0xdb, 0x7a, 0x03, 0x2e,
+	0x82, 0x29, 0x62, 0x90, 0x06, 0x72, 0xf3, 0x8b, 0xaa, 0xf4, 0x05, 0xa6, 0x40, 0xac, 0x86, 0xec,
+	0x6a, 0xcb, 0xa0, 0xb9, 0xbb, 0x39, 0xa8, 0x7e, 0xd3, 0xc6, 0xfc, 0xcb, 0x27, 0x22, 0xf7, 0xc7,
+	0xcf, 0xd7, 0xa6, 0x37, 0xb1, 0x45, 0x90, 0x45, 0xa4, 0xdf, 0x39, 0x30, 0x9d, 0x45, 0x4d, 0x6c,
+	0x1b, 0x04, 0x7e, 0x00, 0x62, 0x4d, 0x16, 0x40, 0x33, 0x6a, 0x2e, 0x75, 0x58, 0x5e, 0x3a, 0xed,
+	0x89, 0x90, 0x26, 0xe5, 0x3b, 0x94, 0x54, 0xe0, 0xbd, 0xe5, 0x6a, 0xf0, 0x12, 0x88, 0xd6, 0x28,
+	0x07, 0x6e, 0xb1, 0xa8, 0x03, 0x03, 0xac, 0x82, 0x88, 0x6e, 0xe2, 0xb6, 0x45, 0x12, 0xa1, 0x54,
+	0x68, 0x35, 0xb6, 0xbe, 0xe2, 0x89, 0xe9, 0x74, 0x48, 0x5f, 0xcd, 0x4d, 0x6c, 0x58, 0xf2, 0x75,
+	0x47, 0xaf, 0x9f, 0x9e, 0x8b, 0xab, 0xaf, 0xa0, 0x97, 0x03, 0xb0, 0x55, 0x46, 0xbd, 0x31, 0xf3,
+	0xf0, 0x89, 0x18, 0x78, 0xf9, 0x44, 0x0c, 0x48, 0xff, 0x46, 0xc0, 0x4c, 0x5f, 0xa7, 0xf7, 0xce,
+	0x2a, 0x69, 0xe1, 0xa4, 0x27, 0x06, 0x8d, 0xda, 0x69, 0x4f, 0x8c, 0xd2, 0xc2, 0x46, 0xeb, 0xb9,
+	0x09, 0xa6, 0xab, 0x54, 0x1f, 0xb7, 0x9a, 0xd8, 0xfa, 0x62, 0x9a, 0xf6, 0x51, 0xda, 0xeb, 0xa3,
+	0x74, 0xc6, 0x3a, 0x90, 0x63, 0xbf, 0x0e, 0x84, 0x54, 0x3d, 0x04, 0xac, 0x80, 0x88, 0x4d, 0x74,
+	0xd2, 0xb6, 0x13, 0x21, 0xb7, 0x77, 0xa4, 0xb3, 0x7a, 0xc7, 0x4b, 0xb0, 0xe4, 0x7a, 0xca, 0xc9,
+	0xd3, 0x9e, 0xb8, 0x34, 0x22, 0x32, 0x25, 0x91, 0x54, 0xc6, 0x06, 0x9b, 0x00, 0xde, 0x37, 0x2c,
+	0xbd, 0xa1, 0x11, 0xbd, 0xd1, 0x38, 0xd0, 0x5a, 0xc8, 0x6e, 0x37, 0x48, 0x22, 0xec, 0xe6, 0x27,
+	0x9e, 0x15, 0xa3, 0xec, 0xf8, 0xa9, 0xae, 0x9b, 0xfc, 0x96, 0x23, 0xec, 0x69, 0x4f, 0x5c, 0xa1,
+	0x41, 0xc6, 0x89, 0x24, 0x95, 0x77, 0x8d, 0x3e, 0x10, 0xfc, 0x1c, 0xc4, 0xec, 0xf6, 0x8e, 0x69,
+	0x10, 0xcd, 0x99, 0xb8, 0xc4, 0x94, 0x1b, 0x2a, 0x39, 0x26, 0x45, 0xd9, 0x1b, 0x47, 0x59, 0x60,
+	0x51, 0x58, 0xbf, 0xf8, 0xc0, 0xd2, 0xa3, 0xe7, 0x22, 0xa7, 0x02, 0x6a, 0x71, 0x00, 0xd0, 0x00,
+	0x3c, 0x6b, 0x11, 0x0d, 0x59, 0x35, 0x1a, 0x21, 0x72, 0x6e, 0x84, 0xb7, 0x59, 0x84, 0x65, 0x1a,
+	0x61, 0x94, 0x81, 0x86, 0x99, 0x63, 0x66, 0xc5, 0xaa, 0xb9, 0xa1, 0x1e, 0x72, 0x60, 0x96, 0x60,
+	0xa2, 0x37, 0x34, 0x76, 0x90, 0x98, 0x3e, 0xaf, 0x11, 0x6f, 0xb1, 0x38, 0x8b, 0x34, 0xce, 0x10,
+	0x5a, 0x9a, 0xa8, 0x41, 0xe3, 0x2e, 0xd6, 0x1b, 0xb1, 0x06, 0xb8, 0xb0, 0x87, 0x89, 0x61, 0xd5,
+	0x9d, 0x9f, 0xb7, 0xc5, 0x84, 0x9d, 0x39, 0xb7, 0xec, 0x77, 0x58, 0x3a, 0x09, 0x9a, 0xce, 0x18,
+	0x05, 0xad, 0x7b, 0x9e, 0xda, 0x4b, 0x8e, 0xd9, 0x2d, 0xfc, 0x3e, 0x60, 0xa6, 0x81, 0xc4, 0xd1,
+	0x73, 0x63, 0x49, 0x2c, 0xd6, 0xd2, 0x50, 0xac, 0x61, 0x85, 0x67, 0xa9, 0x95, 0x09, 0xbc, 0x11,
+	0x76, 0xb6, 0x8a, 0x74, 0x18, 0x04, 0x31, 0x7f, 0xfb, 0x7c, 0x02, 0x42, 0x07, 0xc8, 0xa6, 0x1b,
+	0x4a, 0x4e, 0x4f, 0xb0, 0x09, 0x73, 0x16, 0x51, 0x1d, 0x28, 0xbc, 0x05, 0xa6, 0xf5, 0x1d, 0x9b,
+	0xe8, 0x06, 0xdb, 0x65, 0x13, 0xb3, 0x78, 0x70, 0xf8, 0x11, 0x08, 0x5a, 0x38, 0x11, 0x7a, 0x2d,
+	0x92, 0xa0, 0x85, 0x61, 0x1d, 0xc4, 0x2d, 0xac, 0x3d, 0x30, 0xc8, 0xae, 0xb6, 0x87, 0x08, 0x76,
+	0xc7, 0x2e, 0x2a, 0x2b, 0x93, 0x31, 0x9d, 0xf6, 0xc4, 0x05, 0x2a, 0xaa, 0x9f, 0x4b, 0x52, 0x81,
+	0x85, 0xb7, 0x0d, 0xb2, 0x5b, 0x41, 0x04, 0x33, 0x29, 0x8f, 0x39, 0x10, 0x76, 0xae, 0x97, 0xd7,
+	0x5f, 0xc9, 0x8b, 0x60, 0x6a, 0x0f, 0x13, 0xe4, 0xad, 0x63, 0xfa, 0x02, 0x37, 0xfa, 0xf7, 0x5a,
+	0xe8, 0x55, 0xee, 0x35, 0x39, 0x98, 0xe0, 0xfa, 0x77, 0xdb, 0x16, 0x98, 0xa6, 0x4f, 0x76, 0x22,
+	0xec, 0x8e, 0xcf, 0xe5, 0xb3, 0xc0, 0xe3, 0x97, 0xa9, 0x1c, 0x76, 0x54, 0x52, 0x3d, 0xf0, 0xc6,
+	0xcc, 0x63, 0x6f, 0x53, 0xff, 0x12, 0x04, 0xb3, 0x6c, 0x30, 0x8a, 0x7a, 0x4b, 0x37, 0x6d, 0xf8,
+	0x3d, 0x07, 0x62, 0xa6, 0x61, 0xf5, 0xe7, 0x94, 0x3b, 0x6f, 0x4e, 0x35, 0x87, 0xfb, 0xa4, 0x27,
+	0x5e, 0xf4, 0xa1, 0xae, 0x62, 0xd3, 0x20, 0xc8, 0x6c, 0x92, 0x83, 0x81, 0x4e, 0xbe, 0xe3, 0xc9,
+	0xc6, 0x17, 0x98, 0x86, 0xe5, 0x0d, 0xef, 0x77, 0x1c, 0x80, 0xa6, 0xbe, 0xef, 0x11, 0x69, 0x4d,
+	0xd4, 0x32, 0x70, 0x8d, 0x5d, 0x11, 0x2b, 0x63, 0x23, 0x95, 0x65, 0x9f, 0x1a, 0xb4, 0x4d, 0x4e,
+	0x7a, 0xe2, 0xa5, 0x71, 0xf0, 0x50, 0xae, 0x6c, 0x39, 0x8f, 0x7b, 0x49, 0x8f, 0x9d, 0xa1, 0xe3,
+	0x4d, 0x7d, 0xdf, 0x93, 0x8b, 0x9a, 0xbf, 0xe5, 0x40, 0xbc, 0xe2, 0x4e, 0x22, 0xd3, 0xef, 0x6b,
+	0xc0, 0x26, 0xd3, 0xcb, 0x8d, 0x3b, 0x2f, 0xb7, 0x9b, 0x2c, 0xb7, 0xe5, 0x21, 0xdc, 0x50, 0x5a,
+	0x8b, 0x43, 0x8b, 0xc0, 0x9f, 0x51, 0x9c, 0xda, 0x58, 0x36, 0x7f, 0x79, 0xf3, 0xcf, 0x92, 0xb9,
+	0x07, 0x22, 0x5f, 0xb5, 0x71, 0xab, 0x6d, 0xba, 0x59, 0xc4, 0x65, 0x79, 0xb2, 0x8f, 0xa1, 0x93,
+	0x9e, 0xc8, 0x53, 0xfc, 0x20, 0x1b, 0x95, 0x31, 0xc2, 0x2a, 0x88, 0x92, 0xdd, 0x16, 0xb2, 0x77,
+	0x71, 0x83, 0xfe, 0x00, 0x71, 0x59, 0x99, 0x98, 0x7e, 0xa1, 0x4f, 0xe1, 0x8b, 0x30, 0xe0, 0x85,
+	0x1d, 0x0e, 0xcc, 0x39, 0x13, 0xaa, 0x0d, 0x42, 0x85, 0xdc, 0x50, 0xd5, 0x89, 0x43, 0x25, 0x86,
+	0x79, 0x86, 0xf4, 0xbd, 0xc8, 0xf4, 0x1d, 0xf2, 0x90, 0xd4, 0x59, 0xc7, 0x50, 0xf6, 0xde, 0xaf,
+	0xfc, 0xc3, 0x01, 0xe0, 0xfb, 0x42, 0xbd, 0x0a, 0x96, 0x2b, 0x85, 0xb2, 0xa2, 0x15, 0x8a, 0xe5,
+	0x5c, 0x21, 0xaf, 0xdd, 0xc9, 0x97, 0x8a, 0xca, 0x66, 0x6e, 0x2b, 0xa7, 0x64, 0xf9, 0x40, 0x72,
+	0xbe, 0xd3, 0x4d, 0xc5, 0xa8, 0xa3, 0xe2, 0x04, 0x81, 0x12, 0x98, 0xf7, 0x7b, 0xdf, 0x55, 0x4a,
+	0x3c, 0x97, 0x9c, 0xed, 0x74, 0x53, 0x51, 0xea, 0x75, 0x17, 0xd9, 0xf0, 0x0a, 0x58, 0xf0, 0xfb,
+	0x64, 0xe4, 0x52, 0x39, 0x93, 0xcb, 0xf3, 0xc1, 0xe4, 0x85, 0x4e, 0x37, 0x35, 0x4b, 0xfd, 0x32,
+	0x6c, 0x9d, 0xa6, 0xc0, 0x9c, 0xdf, 0x37, 0x5f, 0xe0, 0x43, 0xc9, 0x78, 0xa7, 0x9b, 0x9a, 0xa1,
+	0x6e, 0x79, 0x0c, 0xd7, 0x41, 0x62, 0xd8, 0x43, 0xdb, 0xce, 0x95, 0x6f, 0x69, 0x15, 0xa5, 0x5c,
+	0xe0, 0xc3, 0xc9, 0xc5, 0x4e, 0x37, 0xc5, 0x7b, 0xbe, 0xde, 0xee, 0x4b, 0x86, 0x1f, 0xfe, 0x20,
+	0x04, 0xae, 0xfc, 0x16, 0x04, 0x73, 0xc3, 0x9f, 0x47, 0x30, 0x0d, 0xde, 0x28, 0xaa, 0x85, 0x62,
+	0xa1, 0x94, 0xb9, 0xad, 0x95, 0xca, 0x99, 0xf2, 0x9d, 0xd2, 0x48, 0xc1, 0x6e, 0x29, 0xd4, 0x39,
+	0x6f, 0x34, 0xe0, 0x4d, 0x20, 0x8c, 0xfa, 0x67, 0x95, 0x62, 0xa1, 0x94, 0x2b, 0x6b, 0x45, 0x45,
+	0xcd, 0x15, 0xb2, 0x3c, 0x97, 0x5c, 0xee, 0x74, 0x53, 0x0b, 0x14, 0x32, 0x34, 0x54, 0xf0, 0x43,
+	0xf0, 0xe6, 0x28, 0xb8, 0x52, 0x28, 0xe7, 0xf2, 0x9f, 0x7a, 0xd8, 0x60, 0x72, 0xa9, 0xd3, 0x4d,
+	0x41, 0x8a, 0xad, 0xf8, 0x26, 0x00, 0x5e, 0x05, 0x4b, 0xa3, 0xd0, 0x62, 0xa6, 0x54, 0x52, 0xb2,
+	0x7c, 0x28, 0xc9, 0x77, 0xba, 0xa9, 0x38, 0xc5, 0x14, 0x75, 0xdb, 0x46, 0x35, 0x78, 0x1d, 0x24,
+	0x46, 0xbd, 0x55, 0xe5, 0x33, 0x65, 0xb3, 0xac, 0x64, 0xf9, 0x70, 0x12, 0x76, 0xba, 0xa9, 0x39,
+	0xea, 0xaf, 0xa2, 0x2f, 0x50, 0x95, 0xa0, 0x33, 0xf9, 0xb7, 0x32, 0xb9, 0xdb, 0x4a, 0x96, 0x9f,
+	0xf2, 0xf3, 0x6f, 0xe9, 0x46, 0x03, 0xd5, 0xa8, 0x9c, 0x72, 0xfe, 0xf0, 0x85, 0x10, 0x78, 0xf6,
+	0x42, 0x08, 0x7c, 0x73, 0x24, 0x04, 0x0e, 0x8f, 0x04, 0xee, 0xe9, 0x91, 0xc0, 0xfd, 0x7d, 0x24,
+	0x70, 0x8f, 0x8e, 0x85, 0xc0, 0xd3, 0x63, 0x21, 0xf0, 0xec, 0x58, 0x08, 0xdc, 0xfb, 0xff, 0x85,
+	0xb8, 0xef, 0xfe, 0xfb, 0xe7, 0xf6, 0xf3, 0x4e, 0xc4, 0xdd, 0x21, 0xef, 0xfe, 0x37, 0x00, 0x14,
+	0xb4, 0xf2, 0xfe, 0x19, 0x0e, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -667,6 +716,44 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -937,6 +1024,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1097,6 +1198,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1204,6 +1319,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1256,6 +1377,112 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2125,6 +2352,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -10,8 +11,8 @@ import (
 
 // NewVote creates a new Vote instance
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter.String(), option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 func (v Vote) String() string {
@@ -43,7 +44,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, WeightedVoteOptions(vot.Options))
 	}
 	return out
 }
@@ -53,6 +54,38 @@ func (v Vote) Empty() bool {
 	return v.String() == Vote{}.String()
 }
 
+// NewNonSplitVoteOption creates a single option vote with weight 1
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+func (w WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(w)
+	return string(out)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, len(v))
+	for i, option := range v {
+		out[i] = fmt.Sprintf("%s=%s", option.Option, option.Weight)
+	}
+
+	return strings.Join(out, ",")
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid and false
+// otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+
+	return ValidVoteOption(option.Option)
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -63,6 +96,34 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// WeightedVoteOptionsFromString returns weighted vote options from a string
+// of comma separated option=weight pairs, e.g.
+// "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4". It returns an error if the string
+// is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(pair, "=")
+		if len(fields) != 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option, expected option=weight", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return options, err
+		}
+
+		options = append(options, WeightedVoteOption{Option: option, Weight: weight})
+	}
+
+	return options, nil
+}
+
 // ValidVoteOption returns true if the vote option is valid and false otherwise.
 func ValidVoteOption(option VoteOption) bool {
 	if option == OptionYes ||
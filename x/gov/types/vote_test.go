@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		str        string
+		expected   WeightedVoteOptions
+		expectPass bool
+	}{
+		{"VOTE_OPTION_YES=1", NewNonSplitVoteOption(OptionYes), true},
+		{"VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4", WeightedVoteOptions{
+			{Option: OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+			{Option: OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+		}, true},
+		{"VOTE_OPTION_YES", nil, false},
+		{"VOTE_OPTION_YES=foo", nil, false},
+		{"foo=1", nil, false},
+	}
+
+	for i, tc := range tests {
+		options, err := WeightedVoteOptionsFromString(tc.str)
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+			require.Equal(t, tc.expected.String(), options.String(), "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
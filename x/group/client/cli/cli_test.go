@@ -0,0 +1,208 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/group/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg         network.Config
+	network     *network.Network
+	member      sdk.AccAddress
+	groupID     uint64
+	groupPolicy sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic("member", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	s.member = info.GetAddress()
+
+	// the member pays the fees of its votes
+	_, err = banktestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		s.member,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(200))),
+		commonFlags(s.cfg)...,
+	)
+	s.Require().NoError(err)
+
+	s.T().Log("create a group with the validator as admin, and the validator and the member as members")
+	members := fmt.Sprintf(`{"members": [{"address": "%s", "weight": "1"}, {"address": "%s", "weight": "2"}]}`, val.Address, s.member)
+	membersFile := testutil.WriteToNewTempFile(s.T(), members)
+	s.execTx(cli.NewCmdCreateGroup(), []string{"group", membersFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)})
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryGroupsByAdmin(), []string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var groupsRes types.QueryGroupsByAdminResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &groupsRes), out.String())
+	s.Require().Len(groupsRes.Groups, 1)
+	s.groupID = groupsRes.Groups[0].GroupId
+
+	s.T().Log("create a group policy with a threshold of 2 and fund its account")
+	groupID := strconv.FormatUint(s.groupID, 10)
+	policy := `{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"2", "timeout":"86400s"}`
+	s.execTx(cli.NewCmdCreateGroupPolicy(), []string{groupID, "policy", policy, fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)})
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryGroupPoliciesByGroup(), []string{groupID, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var policiesRes types.QueryGroupPoliciesByGroupResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &policiesRes), out.String())
+	s.Require().Len(policiesRes.GroupPolicies, 1)
+	s.groupPolicy, err = sdk.AccAddressFromBech32(policiesRes.GroupPolicies[0].Address)
+	s.Require().NoError(err)
+
+	_, err = banktestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		s.groupPolicy,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+		commonFlags(s.cfg)...,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func commonFlags(cfg network.Config) []string {
+	return []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+}
+
+// execTx executes a transaction command and makes sure it succeeds.
+func (s *IntegrationTestSuite) execTx(cmd *cobra.Command, args []string) {
+	val := s.network.Validators[0]
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(args, commonFlags(s.cfg)...))
+	s.Require().NoError(err, out.String())
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+}
+
+func (s *IntegrationTestSuite) TestQueryGroupInfo() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid group id", []string{"invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, true},
+		{"missing group", []string{"100", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, true},
+		{"valid query", []string{strconv.FormatUint(s.groupID, 10), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryGroupInfo(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err, out.String())
+			var group types.GroupInfo
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &group), out.String())
+			s.Require().Equal(val.Address.String(), group.Admin)
+			s.Require().Equal(sdk.NewDec(3), group.TotalWeight)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryGroupMembers() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryGroupMembers(), []string{strconv.FormatUint(s.groupID, 10), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+
+	var res types.QueryGroupMembersResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Len(res.Members, 2)
+}
+
+func (s *IntegrationTestSuite) TestProposal() {
+	val := s.network.Validators[0]
+	receiver := sdk.AccAddress("receiver____________")
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	s.T().Log("submit a proposal sending coins from the group policy account")
+	generateOnly := []string{fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)}
+	sendTx, err := banktestutil.MsgSendExec(val.ClientCtx, s.groupPolicy, receiver, amount, generateOnly...)
+	s.Require().NoError(err)
+	txFile := testutil.WriteToNewTempFile(s.T(), sendTx.String())
+	s.execTx(cli.NewCmdSubmitProposal(), []string{s.groupPolicy.String(), txFile.Name(), "proposal", fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)})
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryProposalsByGroupPolicy(), []string{s.groupPolicy.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var proposalsRes types.QueryProposalsByGroupPolicyResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &proposalsRes), out.String())
+	s.Require().Len(proposalsRes.Proposals, 1)
+	proposalID := strconv.FormatUint(proposalsRes.Proposals[0].ProposalId, 10)
+
+	s.T().Log("vote yes with a weight reaching the threshold and execute the proposal")
+	s.execTx(cli.NewCmdVote(), []string{proposalID, "yes", "", fmt.Sprintf("--%s=%s", flags.FlagFrom, s.member)})
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryVoteByProposalVoter(), []string{proposalID, s.member.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var vote types.Vote
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &vote), out.String())
+	s.Require().Equal(types.ChoiceYes, vote.Choice)
+
+	s.execTx(cli.NewCmdExec(), []string{proposalID, fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)})
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryProposal(), []string{proposalID, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var proposal types.Proposal
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &proposal), out.String())
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+
+	out, err = banktestutil.QueryBalancesExec(val.ClientCtx, receiver)
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), amount[0].Amount.String())
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,461 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	groupQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupQueryCmd.AddCommand(
+		GetCmdQueryGroupInfo(),
+		GetCmdQueryGroupPolicyInfo(),
+		GetCmdQueryGroupMembers(),
+		GetCmdQueryGroupsByAdmin(),
+		GetCmdQueryGroupPoliciesByGroup(),
+		GetCmdQueryGroupPoliciesByAdmin(),
+		GetCmdQueryProposal(),
+		GetCmdQueryProposalsByGroupPolicy(),
+		GetCmdQueryVoteByProposalVoter(),
+		GetCmdQueryVotesByProposal(),
+		GetCmdQueryVotesByVoter(),
+	)
+
+	return groupQueryCmd
+}
+
+// GetCmdQueryGroupInfo returns cmd to query for a group.
+func GetCmdQueryGroupInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-info [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for group info by group id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupInfo(context.Background(), &types.QueryGroupInfoRequest{GroupId: groupID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Info)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGroupPolicyInfo returns cmd to query for a group policy.
+func GetCmdQueryGroupPolicyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policy-info [group-policy-account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for group policy info by account address of group policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPolicyInfo(context.Background(), &types.QueryGroupPolicyInfoRequest{Address: address.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Info)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGroupMembers returns cmd to query for the members of a group.
+func GetCmdQueryGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-members [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for group members by group id with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupMembers(context.Background(), &types.QueryGroupMembersRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-members")
+	return cmd
+}
+
+// GetCmdQueryGroupsByAdmin returns cmd to query for the groups of an admin.
+func GetCmdQueryGroupsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups-by-admin [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for groups by admin account address with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupsByAdmin(context.Background(), &types.QueryGroupsByAdminRequest{
+				Admin:      admin.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups-by-admin")
+	return cmd
+}
+
+// GetCmdQueryGroupPoliciesByGroup returns cmd to query for the group policies of a group.
+func GetCmdQueryGroupPoliciesByGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-group [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for group policies by group id with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPoliciesByGroup(context.Background(), &types.QueryGroupPoliciesByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-policies-by-group")
+	return cmd
+}
+
+// GetCmdQueryGroupPoliciesByAdmin returns cmd to query for the group policies of an admin.
+func GetCmdQueryGroupPoliciesByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-admin [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for group policies by admin account address with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPoliciesByAdmin(context.Background(), &types.QueryGroupPoliciesByAdminRequest{
+				Admin:      admin.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-policies-by-admin")
+	return cmd
+}
+
+// GetCmdQueryProposal returns cmd to query for a proposal.
+func GetCmdQueryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for proposal by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := parseID(args[0], "proposal")
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Proposal(context.Background(), &types.QueryProposalRequest{ProposalId: proposalID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Proposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProposalsByGroupPolicy returns cmd to query for the proposals of a group policy.
+func GetCmdQueryProposalsByGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-group-policy [group-policy-account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for proposals by account address of group policy with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalsByGroupPolicy(context.Background(), &types.QueryProposalsByGroupPolicyRequest{
+				Address:    address.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-by-group-policy")
+	return cmd
+}
+
+// GetCmdQueryVoteByProposalVoter returns cmd to query for the vote of a voter on a proposal.
+func GetCmdQueryVoteByProposalVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for vote by proposal id and voter account address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for vote by proposal id and voter account address.
+
+Example:
+$ %s query %s vote 1 cosmos1skjw..
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := parseID(args[0], "proposal")
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteByProposalVoter(context.Background(), &types.QueryVoteByProposalVoterRequest{
+				ProposalId: proposalID,
+				Voter:      voter.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Vote)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVotesByProposal returns cmd to query for the votes on a proposal.
+func GetCmdQueryVotesByProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for votes by proposal id with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := parseID(args[0], "proposal")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotesByProposal(context.Background(), &types.QueryVotesByProposalRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-proposal")
+	return cmd
+}
+
+// GetCmdQueryVotesByVoter returns cmd to query for the votes of a voter.
+func GetCmdQueryVotesByVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for votes by voter account address with pagination flags",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotesByVoter(context.Background(), &types.QueryVotesByVoterRequest{
+				Voter:      voter.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-voter")
+	return cmd
+}
@@ -0,0 +1,590 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// serviceMsgInterfaceName is the interface name under which Msg service
+// requests are registered, see types.RegisterInterfaces.
+const serviceMsgInterfaceName = "cosmos.base.v1beta1.ServiceMsg"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupPolicy(),
+		NewCmdUpdateGroupPolicyAdmin(),
+		NewCmdUpdateGroupPolicyDecisionPolicy(),
+		NewCmdUpdateGroupPolicyMetadata(),
+		NewCmdSubmitProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return groupTxCmd
+}
+
+// NewCmdCreateGroup returns a CLI command handler for creating a MsgCreateGroup transaction.
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [metadata] [members-json-file] --from [admin]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated weights and an
+administrator account. The members are read from a JSON file.
+
+Example:
+$ %s tx %s create-group "treasury" members.json --from cosmos1skj..
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "cosmos1...",
+			"weight": "1",
+			"metadata": "some metadata"
+		},
+		{
+			"address": "cosmos1...",
+			"weight": "1",
+			"metadata": "some metadata"
+		}
+	]
+}
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.GetFromAddress(), members, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupMembers returns a CLI command handler for creating a MsgUpdateGroupMembers transaction.
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [group-id] [members-json-file] --from [admin]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members. The member updates are read from a JSON file, in the same
+format as for create-group. Set a member's weight to "0" to delete it.
+
+Updating the members aborts the proposals submitted for the previous members.
+
+Example:
+$ %s tx %s update-group-members 1 members.json --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(clientCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupAdmin returns a CLI command handler for creating a MsgUpdateGroupAdmin transaction.
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [group-id] [new-admin] --from [admin]",
+		Short: "Update a group's admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(clientCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupMetadata returns a CLI command handler for creating a MsgUpdateGroupMetadata transaction.
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [group-id] [metadata] --from [admin]",
+		Short: "Update a group's metadata",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMetadata(clientCtx.GetFromAddress(), groupID, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCreateGroupPolicy returns a CLI command handler for creating a MsgCreateGroupPolicy transaction.
+func NewCmdCreateGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group-id] [metadata] [decision-policy] --from [admin]",
+		Short: "Create a group policy account with a decision policy for a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy account with a decision policy for a group. The decision policy is
+given as JSON, the account address is returned in the transaction events.
+
+Examples:
+$ %s tx %s create-group-policy 1 "spending" '{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"2", "timeout":"86400s"}' --from cosmos1skj..
+$ %s tx %s create-group-policy 1 "spending" '{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"86400s"}' --from cosmos1skj..
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group")
+			if err != nil {
+				return err
+			}
+
+			decisionPolicy, err := parseDecisionPolicy(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupPolicy(clientCtx.GetFromAddress(), groupID, args[1], decisionPolicy)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupPolicyAdmin returns a CLI command handler for creating a MsgUpdateGroupPolicyAdmin transaction.
+func NewCmdUpdateGroupPolicyAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [group-policy-account] [new-admin] --from [admin]",
+		Short: "Update a group policy admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicyAdmin(clientCtx.GetFromAddress(), address, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupPolicyDecisionPolicy returns a CLI command handler for creating a
+// MsgUpdateGroupPolicyDecisionPolicy transaction.
+func NewCmdUpdateGroupPolicyDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [group-policy-account] [decision-policy] --from [admin]",
+		Short: "Update a group policy's decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group policy's decision policy, given as JSON.
+
+Updating the decision policy aborts the proposals submitted for the previous decision policy.
+
+Example:
+$ %s tx %s update-group-policy-decision-policy cosmos1skj.. '{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"3", "timeout":"86400s"}' --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			decisionPolicy, err := parseDecisionPolicy(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(clientCtx.GetFromAddress(), address, decisionPolicy)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateGroupPolicyMetadata returns a CLI command handler for creating a MsgUpdateGroupPolicyMetadata transaction.
+func NewCmdUpdateGroupPolicyMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-metadata [group-policy-account] [metadata] --from [admin]",
+		Short: "Update a group policy metadata",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicyMetadata(clientCtx.GetFromAddress(), address, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitProposal returns a CLI command handler for creating a MsgSubmitProposal transaction.
+func NewCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [group-policy-account] [msg_tx_json_file] [metadata] --from [proposer]",
+		Short: "Submit a new proposal to a group policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal to a group policy, the messages of the proposal are read from a
+generated transaction, and must be signed by the group policy account only.
+
+Example:
+$ %s tx bank send cosmos1policy.. cosmos1sk.. 10stake --generate-only > tx.json
+$ %s tx %s submit-proposal cosmos1policy.. tx.json "pay the auditors" --from cosmos1skj..
+`, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			serviceMsgs, err := toServiceMsgs(clientCtx.InterfaceRegistry, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(address, []string{clientCtx.GetFromAddress().String()}, serviceMsgs, args[2])
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdVote returns a CLI command handler for creating a MsgVote transaction.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [choice] [metadata] --from [voter]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal, the choice is one of yes, no, abstain or veto.
+
+Example:
+$ %s tx %s vote 1 yes "" --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseID(args[0], "proposal")
+			if err != nil {
+				return err
+			}
+
+			choice, err := types.ChoiceFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(clientCtx.GetFromAddress(), proposalID, choice, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id] --from [signer]",
+		Short: "Execute a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an accepted proposal. Once its voting period has ended, executing
+a proposal also closes it, so that its final result is stored.
+
+Example:
+$ %s tx %s exec 1 --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseID(args[0], "proposal")
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(clientCtx.GetFromAddress(), proposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseID(arg string, name string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s id %s is not a valid uint64: %w", name, arg, err)
+	}
+	return id, nil
+}
+
+// parseMembers reads the members from a JSON file.
+func parseMembers(membersFile string) ([]types.Member, error) {
+	bz, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var members struct {
+		Members []types.Member `json:"members"`
+	}
+	if err := json.Unmarshal(bz, &members); err != nil {
+		return nil, err
+	}
+
+	return members.Members, nil
+}
+
+// parseDecisionPolicy reads a decision policy from its JSON representation.
+func parseDecisionPolicy(clientCtx client.Context, policyJSON string) (types.DecisionPolicy, error) {
+	var decisionPolicy types.DecisionPolicy
+	if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON([]byte(policyJSON), &decisionPolicy); err != nil {
+		return nil, err
+	}
+	return decisionPolicy, nil
+}
+
+// toServiceMsgs converts the messages of a transaction into their Msg service
+// representation. Legacy messages are matched to the Msg service method whose
+// request type they are, using the methods registered in the interface registry.
+func toServiceMsgs(registry codectypes.InterfaceRegistry, msgs []sdk.Msg) ([]sdk.ServiceMsg, error) {
+	if registry == nil {
+		return nil, errors.New("interface registry is required to resolve Msg service methods")
+	}
+
+	methods := make(map[string]string)
+	for _, typeURL := range registry.ListImplementations(serviceMsgInterfaceName) {
+		if strings.Count(typeURL, "/") != 2 {
+			continue
+		}
+
+		req, err := registry.Resolve(typeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		methods[proto.MessageName(req)] = typeURL
+	}
+
+	serviceMsgs := make([]sdk.ServiceMsg, len(msgs))
+	for i, msg := range msgs {
+		if srvMsg, ok := msg.(sdk.ServiceMsg); ok {
+			serviceMsgs[i] = srvMsg
+			continue
+		}
+
+		methodName, ok := methods[proto.MessageName(msg)]
+		if !ok {
+			return nil, fmt.Errorf("no Msg service method found for %T", msg)
+		}
+
+		serviceMsgs[i] = sdk.ServiceMsg{
+			MethodName: methodName,
+			Request:    msg,
+		}
+	}
+
+	return serviceMsgs, nil
+}
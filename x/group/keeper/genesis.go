@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis new group genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.setSequence(ctx, types.GroupSeqKey, data.GroupSeq)
+	k.setSequence(ctx, types.GroupPolicySeqKey, data.GroupPolicySeq)
+	k.setSequence(ctx, types.ProposalSeqKey, data.ProposalSeq)
+
+	for _, group := range data.Groups {
+		k.setGroupInfo(ctx, group)
+	}
+	for _, member := range data.GroupMembers {
+		k.setGroupMember(ctx, member)
+	}
+	for _, groupPolicy := range data.GroupPolicies {
+		k.setGroupPolicyInfo(ctx, groupPolicy)
+	}
+	for _, proposal := range data.Proposals {
+		k.setProposal(ctx, proposal)
+	}
+	for _, vote := range data.Votes {
+		k.setVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.NewGenesisState()
+	gs.GroupSeq = k.getSequence(ctx, types.GroupSeqKey)
+	gs.GroupPolicySeq = k.getSequence(ctx, types.GroupPolicySeqKey)
+	gs.ProposalSeq = k.getSequence(ctx, types.ProposalSeqKey)
+
+	k.IterateGroups(ctx, func(group types.GroupInfo) bool {
+		gs.Groups = append(gs.Groups, group)
+		return false
+	})
+	k.IterateGroupMembers(ctx, 0, func(member types.GroupMember) bool {
+		gs.GroupMembers = append(gs.GroupMembers, member)
+		return false
+	})
+	k.IterateGroupPolicies(ctx, func(groupPolicy types.GroupPolicyInfo) bool {
+		gs.GroupPolicies = append(gs.GroupPolicies, groupPolicy)
+		return false
+	})
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		gs.Proposals = append(gs.Proposals, proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		gs.Votes = append(gs.Votes, vote)
+		return false
+	})
+
+	return gs
+}
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo returns the group with the given ID.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	group, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &group}, nil
+}
+
+// GroupPolicyInfo returns the group policy with the given account address.
+func (k Keeper) GroupPolicyInfo(c context.Context, req *types.QueryGroupPolicyInfoRequest) (*types.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	groupPolicy, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupPolicyInfoResponse{Info: &groupPolicy}, nil
+}
+
+// GroupMembers returns the members of the group with the given ID.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	membersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupMembersPrefix(req.GroupId))
+
+	var members []*types.GroupMember
+	pageRes, err := query.Paginate(membersStore, req.Pagination, func(_ []byte, value []byte) error {
+		var member types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &member); err != nil {
+			return err
+		}
+		members = append(members, &member)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupsByAdmin returns the groups administered by the given admin.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupsByAdminPrefix(admin))
+
+	var groups []*types.GroupInfo
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		group, err := k.GetGroupInfo(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &group)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupsByAdminResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupPoliciesByGroup returns the group policies of the group with the
+// given ID.
+func (k Keeper) GroupPoliciesByGroup(c context.Context, req *types.QueryGroupPoliciesByGroupRequest) (*types.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	groupPolicies, pageRes, err := k.paginateGroupPolicies(ctx, types.GetGroupPoliciesByGroupPrefix(req.GroupId), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupPoliciesByGroupResponse{
+		GroupPolicies: groupPolicies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// GroupPoliciesByAdmin returns the group policies administered by the given
+// admin.
+func (k Keeper) GroupPoliciesByAdmin(c context.Context, req *types.QueryGroupPoliciesByAdminRequest) (*types.QueryGroupPoliciesByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	groupPolicies, pageRes, err := k.paginateGroupPolicies(ctx, types.GetGroupPoliciesByAdminPrefix(admin), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupPoliciesByAdminResponse{
+		GroupPolicies: groupPolicies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// paginateGroupPolicies returns a page of the group policies of an index
+// whose keys end with the group policy address.
+func (k Keeper) paginateGroupPolicies(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]*types.GroupPolicyInfo, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	var groupPolicies []*types.GroupPolicyInfo
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		groupPolicy, err := k.GetGroupPolicyInfo(ctx, sdk.AccAddress(key))
+		if err != nil {
+			return err
+		}
+		groupPolicies = append(groupPolicies, &groupPolicy)
+		return nil
+	})
+
+	return groupPolicies, pageRes, err
+}
+
+// Proposal returns the proposal with the given ID.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupPolicy returns the proposals submitted to the group policy
+// with the given account address.
+func (k Keeper) ProposalsByGroupPolicy(c context.Context, req *types.QueryProposalsByGroupPolicyRequest) (*types.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProposalsByGroupPolicyPrefix(address))
+
+	var proposals []*types.Proposal
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		proposal, err := k.GetProposal(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsByGroupPolicyResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VoteByProposalVoter returns the vote of a voter on a proposal.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vote, found := k.GetVote(ctx, req.ProposalId, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vote by %s on proposal %d not found", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal returns the votes on the proposal with the given ID.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votesStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByProposalPrefix(req.ProposalId))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(_ []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByProposalResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VotesByVoter returns the votes of the given voter.
+func (k Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByVoterPrefix(voter))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		vote, found := k.GetVote(ctx, types.GetIDFromBytes(key), voter)
+		if !found {
+			return status.Errorf(codes.Internal, "vote by %s on proposal %d not found", req.Voter, types.GetIDFromBytes(key))
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByVoterResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func (s *TestSuite) TestGRPCQueryGroups() {
+	queryClient, addrs := s.queryClient, s.addrs
+	ctx := gocontext.Background()
+
+	_, err := queryClient.GroupInfo(ctx, &types.QueryGroupInfoRequest{GroupId: 1})
+	s.Require().Error(err)
+
+	groupID := s.createGroup()
+	s.createGroup()
+
+	infoRes, err := queryClient.GroupInfo(ctx, &types.QueryGroupInfoRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, infoRes.Info.GroupId)
+	s.Require().Equal("group", infoRes.Info.Metadata)
+
+	membersRes, err := queryClient.GroupMembers(ctx, &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 2)
+
+	membersRes, err = queryClient.GroupMembers(ctx, &types.QueryGroupMembersRequest{
+		GroupId:    groupID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 1)
+	s.Require().Equal(uint64(2), membersRes.Pagination.Total)
+
+	groupsRes, err := queryClient.GroupsByAdmin(ctx, &types.QueryGroupsByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Len(groupsRes.Groups, 2)
+
+	groupsRes, err = queryClient.GroupsByAdmin(ctx, &types.QueryGroupsByAdminRequest{Admin: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(groupsRes.Groups)
+
+	_, err = queryClient.GroupsByAdmin(ctx, &types.QueryGroupsByAdminRequest{Admin: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestGRPCQueryGroupPolicies() {
+	queryClient, addrs := s.queryClient, s.addrs
+	ctx := gocontext.Background()
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	s.createGroupPolicy(groupID, 1)
+
+	infoRes, err := queryClient.GroupPolicyInfo(ctx, &types.QueryGroupPolicyInfoRequest{Address: address.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(address.String(), infoRes.Info.Address)
+	s.Require().NotNil(infoRes.Info.GetDecisionPolicy())
+
+	_, err = queryClient.GroupPolicyInfo(ctx, &types.QueryGroupPolicyInfoRequest{Address: addrs[1].String()})
+	s.Require().Error(err)
+
+	byGroupRes, err := queryClient.GroupPoliciesByGroup(ctx, &types.QueryGroupPoliciesByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(byGroupRes.GroupPolicies, 2)
+
+	byAdminRes, err := queryClient.GroupPoliciesByAdmin(ctx, &types.QueryGroupPoliciesByAdminRequest{
+		Admin:      addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byAdminRes.GroupPolicies, 1)
+	s.Require().NotNil(byAdminRes.Pagination.NextKey)
+}
+
+func (s *TestSuite) TestGRPCQueryProposalsAndVotes() {
+	queryClient, addrs := s.queryClient, s.addrs
+	ctx := gocontext.Background()
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 3)
+	proposalID := s.submitSendProposal(address, tenStake)
+	s.submitSendProposal(address, tenStake)
+	s.Require().NoError(s.vote(addrs[1], proposalID, types.ChoiceYes))
+	s.Require().NoError(s.vote(addrs[2], proposalID, types.ChoiceAbstain))
+
+	proposalRes, err := queryClient.Proposal(ctx, &types.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(proposalID, proposalRes.Proposal.ProposalId)
+	msgs, err := proposalRes.Proposal.GetServiceMsgs()
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+
+	_, err = queryClient.Proposal(ctx, &types.QueryProposalRequest{ProposalId: 100})
+	s.Require().Error(err)
+
+	proposalsRes, err := queryClient.ProposalsByGroupPolicy(ctx, &types.QueryProposalsByGroupPolicyRequest{Address: address.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposalsRes.Proposals, 2)
+
+	voteRes, err := queryClient.VoteByProposalVoter(ctx, &types.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ChoiceAbstain, voteRes.Vote.Choice)
+
+	_, err = queryClient.VoteByProposalVoter(ctx, &types.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: addrs[3].String()})
+	s.Require().Error(err)
+
+	votesRes, err := queryClient.VotesByProposal(ctx, &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes.Votes, 2)
+
+	votesRes2, err := queryClient.VotesByVoter(ctx, &types.QueryVotesByVoterRequest{Voter: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes2.Votes, 1)
+	s.Require().Equal(proposalID, votesRes2.Votes[0].ProposalId)
+}
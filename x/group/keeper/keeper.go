@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper manages the groups, their policy accounts and the proposals voted
+// by their members, and dispatches the messages of accepted proposals.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	router    *baseapp.MsgServiceRouter
+	accKeeper types.AccountKeeper
+}
+
+// NewKeeper constructs a group Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter, accKeeper types.AccountKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		router:    router,
+		accKeeper: accKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getSequence returns the last value of the sequence stored at the given key.
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return types.GetIDFromBytes(bz)
+}
+
+// setSequence sets the last value of the sequence stored at the given key.
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, types.GetIDBytes(seq))
+}
+
+// nextSequence increments the sequence stored at the given key and returns
+// its new value, sequences start at 1.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group with the given ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var group types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupKey(groupID))
+	if bz == nil {
+		return group, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, nil
+}
+
+// setGroupInfo stores a group and indexes it by admin.
+func (k Keeper) setGroupInfo(ctx sdk.Context, group types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupKey(group.GroupId), k.cdc.MustMarshalBinaryBare(&group))
+	store.Set(types.GetGroupByAdminKey(mustAccAddress(group.Admin), group.GroupId), []byte{})
+}
+
+// IterateGroups iterates over all the groups.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(group types.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &group)
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (types.GroupMember, bool) {
+	var member types.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupMemberKey(groupID, address))
+	if bz == nil {
+		return member, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &member)
+	return member, true
+}
+
+// setGroupMember stores a group member.
+func (k Keeper) setGroupMember(ctx sdk.Context, member types.GroupMember) {
+	key := types.GetGroupMemberKey(member.GroupId, mustAccAddress(member.Member.Address))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&member))
+}
+
+// IterateGroupMembers iterates over the members of the given group, or over
+// the members of all the groups if the group ID is 0.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, groupID uint64, cb func(member types.GroupMember) (stop bool)) {
+	prefix := types.GroupMemberKeyPrefix
+	if groupID != 0 {
+		prefix = types.GetGroupMembersPrefix(groupID)
+	}
+
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var member types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &member)
+		if cb(member) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the group policy with the given account address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupPolicyInfo, error) {
+	var groupPolicy types.GroupPolicyInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupPolicyKey(address))
+	if bz == nil {
+		return groupPolicy, sdkerrors.Wrapf(types.ErrNotFound, "group policy %s", address)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &groupPolicy)
+	return groupPolicy, nil
+}
+
+// setGroupPolicyInfo stores a group policy and indexes it by group and by
+// admin.
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, groupPolicy types.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	address := mustAccAddress(groupPolicy.Address)
+	store.Set(types.GetGroupPolicyKey(address), k.cdc.MustMarshalBinaryBare(&groupPolicy))
+	store.Set(types.GetGroupPolicyByGroupKey(groupPolicy.GroupId, address), []byte{})
+	store.Set(types.GetGroupPolicyByAdminKey(mustAccAddress(groupPolicy.Admin), address), []byte{})
+}
+
+// IterateGroupPolicies iterates over all the group policies.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, cb func(groupPolicy types.GroupPolicyInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPolicyKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var groupPolicy types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &groupPolicy)
+		if cb(groupPolicy) {
+			break
+		}
+	}
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var proposal types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return proposal, sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, nil
+}
+
+// setProposal stores a proposal and indexes it by group policy.
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposalKey(proposal.ProposalId), k.cdc.MustMarshalBinaryBare(&proposal))
+	store.Set(types.GetProposalByGroupPolicyKey(mustAccAddress(proposal.Address), proposal.ProposalId), []byte{})
+}
+
+// IterateProposals iterates over all the proposals.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, bool) {
+	var vote types.Vote
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// setVote stores a vote and indexes it by voter.
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	voter := mustAccAddress(vote.Voter)
+	store.Set(types.GetVoteKey(vote.ProposalId, voter), k.cdc.MustMarshalBinaryBare(&vote))
+	store.Set(types.GetVoteByVoterKey(voter, vote.ProposalId), []byte{})
+}
+
+// IterateVotes iterates over all the votes.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// mustAccAddress returns the account address of a bech32 address stored by
+// the module, which was validated before being stored.
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
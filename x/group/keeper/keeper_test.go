@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	now          = time.Now().UTC()
+	votingPeriod = time.Hour
+	tenStake     = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	initialFunds = sdk.NewInt(30000000)
+)
+
+const sendMethodName = "/cosmos.bank.v1beta1.Msg/Send"
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, initialFunds)
+}
+
+// createGroup creates a group administered by addrs[0] with addrs[1] and
+// addrs[2] as members of weight 1 and 2.
+func (s *TestSuite) createGroup() uint64 {
+	members := []types.Member{
+		types.NewMember(s.addrs[1], sdk.NewDec(1), "first"),
+		types.NewMember(s.addrs[2], sdk.NewDec(2), "second"),
+	}
+	res, err := s.msgServer.CreateGroup(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateGroup(s.addrs[0], members, "group"))
+	s.Require().NoError(err)
+	return res.GroupId
+}
+
+// createGroupPolicy creates a group policy for the given group with the given
+// threshold, and funds its account with ten stake.
+func (s *TestSuite) createGroupPolicy(groupID uint64, threshold int64) sdk.AccAddress {
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(threshold), votingPeriod)
+	msg, err := types.NewMsgCreateGroupPolicy(s.addrs[0], groupID, "policy", policy)
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	address, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(simapp.FundAccount(s.app, s.ctx, address, tenStake))
+
+	return address
+}
+
+// submitSendProposal submits a proposal, proposed by addrs[1], which sends
+// the given coins from the group policy account to addrs[3].
+func (s *TestSuite) submitSendProposal(address sdk.AccAddress, coins sdk.Coins) uint64 {
+	msgs := []sdk.ServiceMsg{{
+		MethodName: sendMethodName,
+		Request:    banktypes.NewMsgSend(address, s.addrs[3], coins),
+	}}
+	msg, err := types.NewMsgSubmitProposal(address, []string{s.addrs[1].String()}, msgs, "proposal")
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	return res.ProposalId
+}
+
+func (s *TestSuite) vote(voter sdk.AccAddress, proposalID uint64, choice types.Choice) error {
+	_, err := s.msgServer.Vote(sdk.WrapSDKContext(s.ctx), types.NewMsgVote(voter, proposalID, choice, ""))
+	return err
+}
+
+func (s *TestSuite) exec(proposalID uint64) error {
+	_, err := s.msgServer.Exec(sdk.WrapSDKContext(s.ctx), types.NewMsgExec(s.addrs[3], proposalID))
+	return err
+}
+
+func (s *TestSuite) TestGroups() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup()
+	group, err := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[0].String(), group.Admin)
+	s.Require().Equal(uint64(1), group.Version)
+	s.Require().Equal(sdk.NewDec(3), group.TotalWeight)
+
+	s.T().Log("verify only the admin can update the group")
+	_, err = s.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(addrs[1], groupID, "updated"))
+	s.Require().Error(err)
+	_, err = s.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(addrs[0], groupID, "updated"))
+	s.Require().NoError(err)
+
+	s.T().Log("verify members are added, updated and removed")
+	updates := []types.Member{
+		types.NewMember(addrs[1], sdk.ZeroDec(), ""),
+		types.NewMember(addrs[2], sdk.NewDec(5), ""),
+		types.NewMember(addrs[3], sdk.NewDec(1), ""),
+	}
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(addrs[0], groupID, updates))
+	s.Require().NoError(err)
+
+	_, found := app.GroupKeeper.GetGroupMember(ctx, groupID, addrs[1])
+	s.Require().False(found)
+	member, found := app.GroupKeeper.GetGroupMember(ctx, groupID, addrs[2])
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDec(5), member.Member.Weight)
+
+	group, err = app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal("updated", group.Metadata)
+	s.Require().Equal(uint64(2), group.Version)
+	s.Require().Equal(sdk.NewDec(6), group.TotalWeight)
+
+	s.T().Log("verify removing a missing member fails")
+	updates = []types.Member{types.NewMember(addrs[1], sdk.ZeroDec(), "")}
+	_, err = s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(addrs[0], groupID, updates))
+	s.Require().Error(err)
+
+	s.T().Log("verify the admin can be changed")
+	_, err = s.msgServer.UpdateGroupAdmin(goCtx, types.NewMsgUpdateGroupAdmin(addrs[0], groupID, addrs[3]))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(addrs[0], groupID, "again"))
+	s.Require().Error(err)
+	_, err = s.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(addrs[3], groupID, "again"))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGroupPolicies() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID := s.createGroup()
+
+	s.T().Log("verify only the group admin can create a group policy")
+	msg, err := types.NewMsgCreateGroupPolicy(addrs[1], groupID, "", types.NewThresholdDecisionPolicy(sdk.NewDec(1), votingPeriod))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupPolicy(goCtx, msg)
+	s.Require().Error(err)
+
+	address := s.createGroupPolicy(groupID, 2)
+	other := s.createGroupPolicy(groupID, 1)
+	s.Require().NotEqual(address, other)
+
+	groupPolicy, err := app.GroupKeeper.GetGroupPolicyInfo(ctx, address)
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, groupPolicy.GroupId)
+	s.Require().Equal(uint64(1), groupPolicy.Version)
+	s.Require().Equal(sdk.NewDec(2), groupPolicy.GetDecisionPolicy().(*types.ThresholdDecisionPolicy).Threshold)
+
+	s.T().Log("verify the group policy account is a module account")
+	acc := app.AccountKeeper.GetAccount(ctx, address)
+	s.Require().NotNil(acc)
+	s.Require().Nil(acc.GetPubKey())
+
+	s.T().Log("verify the decision policy can be updated")
+	update, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(addrs[0], address, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), votingPeriod))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupPolicyDecisionPolicy(goCtx, update)
+	s.Require().NoError(err)
+
+	groupPolicy, err = app.GroupKeeper.GetGroupPolicyInfo(ctx, address)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), groupPolicy.Version)
+	s.Require().IsType(&types.PercentageDecisionPolicy{}, groupPolicy.GetDecisionPolicy())
+
+	s.T().Log("verify the admin can be changed")
+	_, err = s.msgServer.UpdateGroupPolicyAdmin(goCtx, types.NewMsgUpdateGroupPolicyAdmin(addrs[0], address, addrs[1]))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateGroupPolicyMetadata(goCtx, types.NewMsgUpdateGroupPolicyMetadata(addrs[0], address, "updated"))
+	s.Require().Error(err)
+	_, err = s.msgServer.UpdateGroupPolicyMetadata(goCtx, types.NewMsgUpdateGroupPolicyMetadata(addrs[1], address, "updated"))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestProposalAccepted() {
+	app, addrs := s.app, s.addrs
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake)
+
+	s.T().Log("verify only members can vote, once")
+	s.Require().Error(s.vote(addrs[3], proposalID, types.ChoiceYes))
+	s.Require().NoError(s.vote(addrs[1], proposalID, types.ChoiceYes))
+	s.Require().Error(s.vote(addrs[1], proposalID, types.ChoiceYes))
+
+	s.T().Log("verify an open proposal can't be executed")
+	s.Require().Error(s.exec(proposalID))
+
+	s.Require().NoError(s.vote(addrs[2], proposalID, types.ChoiceYes))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	s.Require().Equal(sdk.NewDec(3), proposal.VoteState.YesCount)
+
+	s.T().Log("verify the proposal messages are executed once")
+	s.Require().NoError(s.exec(proposalID))
+	s.Require().True(app.BankKeeper.GetAllBalances(s.ctx, address).Empty())
+
+	proposal, err = app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Require().Error(s.exec(proposalID))
+}
+
+func (s *TestSuite) TestProposalExecutionFailure() {
+	app, addrs := s.app, s.addrs
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake.Add(tenStake...))
+	s.Require().NoError(s.vote(addrs[2], proposalID, types.ChoiceYes))
+
+	s.T().Log("verify a failed execution doesn't change the state and can be retried")
+	s.Require().NoError(s.exec(proposalID))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultFailure, proposal.ExecutorResult)
+	s.Require().Equal(tenStake, app.BankKeeper.GetAllBalances(s.ctx, address))
+
+	s.Require().NoError(simapp.FundAccount(app, s.ctx, address, tenStake))
+	s.Require().NoError(s.exec(proposalID))
+	proposal, err = app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+}
+
+func (s *TestSuite) TestProposalRejected() {
+	app, addrs := s.app, s.addrs
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake)
+
+	s.Require().NoError(s.vote(addrs[2], proposalID, types.ChoiceNo))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+
+	s.T().Log("verify a closed proposal can't be voted on")
+	s.Require().Error(s.vote(addrs[1], proposalID, types.ChoiceYes))
+
+	s.T().Log("verify a rejected proposal isn't executed")
+	s.Require().NoError(s.exec(proposalID))
+	proposal, err = app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	s.Require().Equal(tenStake, app.BankKeeper.GetAllBalances(s.ctx, address))
+}
+
+func (s *TestSuite) TestProposalTimeout() {
+	app, addrs := s.app, s.addrs
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake)
+	s.Require().NoError(s.vote(addrs[1], proposalID, types.ChoiceYes))
+
+	s.ctx = s.ctx.WithBlockTime(now.Add(votingPeriod))
+	s.Require().Error(s.vote(addrs[2], proposalID, types.ChoiceYes))
+
+	s.T().Log("verify a proposal without a final result is rejected once the voting period ended")
+	s.Require().NoError(s.exec(proposalID))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	s.Require().Equal(types.ProposalResultRejected, proposal.Result)
+}
+
+func (s *TestSuite) TestProposalAborted() {
+	app, addrs := s.app, s.addrs
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake)
+
+	s.T().Log("verify a proposal of a group whose members changed can't be voted on and is aborted")
+	updates := []types.Member{types.NewMember(addrs[3], sdk.NewDec(1), "")}
+	_, err := s.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(addrs[0], groupID, updates))
+	s.Require().NoError(err)
+	s.Require().Error(s.vote(addrs[2], proposalID, types.ChoiceYes))
+
+	s.Require().NoError(s.exec(proposalID))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusAborted, proposal.Status)
+	s.Require().Error(s.exec(proposalID))
+}
+
+func (s *TestSuite) TestGenesis() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	groupID := s.createGroup()
+	address := s.createGroupPolicy(groupID, 2)
+	proposalID := s.submitSendProposal(address, tenStake)
+	s.Require().NoError(s.vote(addrs[1], proposalID, types.ChoiceYes))
+
+	genesis := app.GroupKeeper.ExportGenesis(ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+	s.Require().Len(genesis.Groups, 1)
+	s.Require().Len(genesis.GroupMembers, 2)
+	s.Require().Len(genesis.GroupPolicies, 1)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Require().Len(genesis.Votes, 1)
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	newApp.GroupKeeper.InitGenesis(newCtx, genesis)
+	s.Require().Equal(genesis, newApp.GroupKeeper.ExportGenesis(newCtx))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
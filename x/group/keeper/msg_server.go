@@ -0,0 +1,490 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateGroup implements the MsgServer.CreateGroup method.
+func (k msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return nil, err
+	}
+
+	groupID := k.nextSequence(ctx, types.GroupSeqKey)
+	totalWeight := sdk.ZeroDec()
+	for _, member := range msg.Members {
+		totalWeight = totalWeight.Add(member.Weight)
+		k.setGroupMember(ctx, types.GroupMember{GroupId: groupID, Member: member})
+	}
+
+	k.setGroupInfo(ctx, types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       msg.Admin,
+		Metadata:    msg.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	})
+
+	emitGroupEvent(ctx, types.EventCreateGroup, groupID)
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+// UpdateGroupMembers implements the MsgServer.UpdateGroupMembers method.
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.getGroupByAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, update := range msg.MemberUpdates {
+		address, err := sdk.AccAddressFromBech32(update.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		// the weight of an existing member is replaced by its new weight
+		previous, found := k.GetGroupMember(ctx, group.GroupId, address)
+		if found {
+			group.TotalWeight = group.TotalWeight.Sub(previous.Member.Weight)
+		}
+
+		if update.Weight.IsZero() {
+			if !found {
+				return nil, sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", update.Address, group.GroupId)
+			}
+			store.Delete(types.GetGroupMemberKey(group.GroupId, address))
+			continue
+		}
+
+		group.TotalWeight = group.TotalWeight.Add(update.Weight)
+		k.setGroupMember(ctx, types.GroupMember{GroupId: group.GroupId, Member: update})
+	}
+
+	// changing the members invalidates the proposals submitted for the
+	// previous version of the group
+	group.Version++
+	k.setGroupInfo(ctx, group)
+
+	emitGroupEvent(ctx, types.EventUpdateGroup, group.GroupId)
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin implements the MsgServer.UpdateGroupAdmin method.
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.getGroupByAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupByAdminKey(mustAccAddress(group.Admin), group.GroupId))
+	group.Admin = msg.NewAdmin
+	k.setGroupInfo(ctx, group)
+
+	emitGroupEvent(ctx, types.EventUpdateGroup, group.GroupId)
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata implements the MsgServer.UpdateGroupMetadata method.
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.getGroupByAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Metadata = msg.Metadata
+	k.setGroupInfo(ctx, group)
+
+	emitGroupEvent(ctx, types.EventUpdateGroup, group.GroupId)
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupPolicy implements the MsgServer.CreateGroupPolicy method.
+func (k msgServer) CreateGroupPolicy(goCtx context.Context, msg *types.MsgCreateGroupPolicy) (*types.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, err := k.getGroupByAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	decisionPolicy := msg.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	// The group policy account is a module account, so that it can't have a
+	// public key and can only be controlled through proposals. The next
+	// sequence is used if an account already exists at the derived address.
+	var address sdk.AccAddress
+	for {
+		seq := k.nextSequence(ctx, types.GroupPolicySeqKey)
+		address = types.DeriveGroupPolicyAddress(seq)
+		if k.accKeeper.GetAccount(ctx, address) != nil {
+			continue
+		}
+
+		account := k.accKeeper.NewAccount(ctx, authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(address),
+			types.GroupPolicyAccountName(seq),
+		))
+		k.accKeeper.SetAccount(ctx, account)
+		break
+	}
+
+	groupPolicy, err := types.NewGroupPolicyInfo(address, group.GroupId, mustAccAddress(msg.Admin), msg.Metadata, 1, decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	k.setGroupPolicyInfo(ctx, groupPolicy)
+
+	emitGroupPolicyEvent(ctx, types.EventCreateGroupPolicy, groupPolicy.Address)
+
+	return &types.MsgCreateGroupPolicyResponse{Address: groupPolicy.Address}, nil
+}
+
+// UpdateGroupPolicyAdmin implements the MsgServer.UpdateGroupPolicyAdmin method.
+func (k msgServer) UpdateGroupPolicyAdmin(goCtx context.Context, msg *types.MsgUpdateGroupPolicyAdmin) (*types.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupPolicy, err := k.getGroupPolicyByAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupPolicyByAdminKey(mustAccAddress(groupPolicy.Admin), mustAccAddress(groupPolicy.Address)))
+	groupPolicy.Admin = msg.NewAdmin
+	k.setGroupPolicyInfo(ctx, groupPolicy)
+
+	emitGroupPolicyEvent(ctx, types.EventUpdateGroupPolicy, groupPolicy.Address)
+
+	return &types.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy implements the MsgServer.UpdateGroupPolicyDecisionPolicy method.
+func (k msgServer) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupPolicyDecisionPolicy) (*types.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupPolicy, err := k.getGroupPolicyByAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	decisionPolicy := msg.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	if err := groupPolicy.SetDecisionPolicy(decisionPolicy); err != nil {
+		return nil, err
+	}
+
+	// changing the decision policy invalidates the proposals submitted for
+	// the previous version of the group policy
+	groupPolicy.Version++
+	k.setGroupPolicyInfo(ctx, groupPolicy)
+
+	emitGroupPolicyEvent(ctx, types.EventUpdateGroupPolicy, groupPolicy.Address)
+
+	return &types.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+// UpdateGroupPolicyMetadata implements the MsgServer.UpdateGroupPolicyMetadata method.
+func (k msgServer) UpdateGroupPolicyMetadata(goCtx context.Context, msg *types.MsgUpdateGroupPolicyMetadata) (*types.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupPolicy, err := k.getGroupPolicyByAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicy.Metadata = msg.Metadata
+	k.setGroupPolicyInfo(ctx, groupPolicy)
+
+	emitGroupPolicyEvent(ctx, types.EventUpdateGroupPolicy, groupPolicy.Address)
+
+	return &types.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+// SubmitProposal implements the MsgServer.SubmitProposal method.
+func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicy, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := k.GetGroupInfo(ctx, groupPolicy.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range msg.Proposers {
+		proposerAddr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := k.GetGroupMember(ctx, group.GroupId, proposerAddr); !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, group.GroupId)
+		}
+	}
+
+	decisionPolicy := groupPolicy.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	proposalID := k.nextSequence(ctx, types.ProposalSeqKey)
+	k.setProposal(ctx, types.Proposal{
+		ProposalId:         proposalID,
+		Address:            msg.Address,
+		Metadata:           msg.Metadata,
+		Proposers:          msg.Proposers,
+		SubmittedAt:        ctx.BlockTime(),
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: groupPolicy.Version,
+		Status:             types.ProposalStatusSubmitted,
+		Result:             types.ProposalResultUnfinalized,
+		VoteState:          types.DefaultTally(),
+		Timeout:            ctx.BlockTime().Add(decisionPolicy.GetTimeout()),
+		ExecutorResult:     types.ProposalExecutorResultNotRun,
+		Msgs:               msg.Msgs,
+	})
+
+	emitProposalEvent(ctx, types.EventSubmitProposal, proposalID)
+
+	return &types.MsgSubmitProposalResponse{ProposalId: proposalID}, nil
+}
+
+// Vote implements the MsgServer.Vote method.
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is %s", proposal.ProposalId, proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.Timeout) {
+		return nil, sdkerrors.Wrapf(types.ErrExpired, "voting period of proposal %d has ended", proposal.ProposalId)
+	}
+
+	group, groupPolicy, err := k.getProposalGroup(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !proposalVersionsMatch(proposal, group, groupPolicy) {
+		return nil, sdkerrors.Wrapf(types.ErrModified, "group or group policy of proposal %d", proposal.ProposalId)
+	}
+
+	member, found := k.GetGroupMember(ctx, group.GroupId, voter)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a member of group %d", msg.Voter, group.GroupId)
+	}
+	if _, found := k.GetVote(ctx, proposal.ProposalId, voter); found {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "vote by %s on proposal %d", msg.Voter, proposal.ProposalId)
+	}
+
+	if err := proposal.VoteState.Add(msg.Choice, member.Member.Weight); err != nil {
+		return nil, err
+	}
+
+	k.setVote(ctx, types.Vote{
+		ProposalId:  proposal.ProposalId,
+		Voter:       msg.Voter,
+		Choice:      msg.Choice,
+		Metadata:    msg.Metadata,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	if err := k.tally(&proposal, group, groupPolicy, false); err != nil {
+		return nil, err
+	}
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ProposalId, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Voter),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method. A proposal whose group or group
+// policy was modified since its submission is aborted, and a proposal whose
+// voting period has ended is closed. The messages of an accepted proposal are
+// executed, if their execution fails the proposal can be executed again.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case proposal.Status == types.ProposalStatusAborted:
+		return nil, sdkerrors.Wrapf(types.ErrModified, "proposal %d was aborted", proposal.ProposalId)
+	case proposal.ExecutorResult == types.ProposalExecutorResultSuccess:
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d was already executed", proposal.ProposalId)
+	}
+
+	if proposal.Status == types.ProposalStatusSubmitted {
+		group, groupPolicy, err := k.getProposalGroup(ctx, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		if !proposalVersionsMatch(proposal, group, groupPolicy) {
+			proposal.Status = types.ProposalStatusAborted
+		} else {
+			timedOut := !ctx.BlockTime().Before(proposal.Timeout)
+			if err := k.tally(&proposal, group, groupPolicy, timedOut); err != nil {
+				return nil, err
+			}
+			if proposal.Status == types.ProposalStatusSubmitted {
+				return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is still open for voting", proposal.ProposalId)
+			}
+		}
+	}
+
+	if proposal.Status == types.ProposalStatusClosed && proposal.Result == types.ProposalResultAccepted {
+		if err := k.execProposalMsgs(ctx, proposal); err != nil {
+			proposal.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposal.ProposalId, "err", err)
+		} else {
+			proposal.ExecutorResult = types.ProposalExecutorResultSuccess
+		}
+	}
+
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventExec,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ProposalId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// getGroupByAdmin returns the group with the given ID, making sure the given
+// admin is its admin.
+func (k Keeper) getGroupByAdmin(ctx sdk.Context, groupID uint64, admin string) (types.GroupInfo, error) {
+	group, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return group, err
+	}
+	if group.Admin != admin {
+		return group, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+	return group, nil
+}
+
+// getGroupPolicyByAdmin returns the group policy with the given address,
+// making sure the given admin is its admin.
+func (k Keeper) getGroupPolicyByAdmin(ctx sdk.Context, address string, admin string) (types.GroupPolicyInfo, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.GroupPolicyInfo{}, err
+	}
+	groupPolicy, err := k.GetGroupPolicyInfo(ctx, addr)
+	if err != nil {
+		return groupPolicy, err
+	}
+	if groupPolicy.Admin != admin {
+		return groupPolicy, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group policy %s", admin, address)
+	}
+	return groupPolicy, nil
+}
+
+func emitGroupEvent(ctx sdk.Context, eventType string, groupID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+		),
+	)
+}
+
+func emitGroupPolicyEvent(ctx sdk.Context, eventType string, address string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+		),
+	)
+}
+
+func emitProposalEvent(ctx sdk.Context, eventType string, proposalID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+		),
+	)
+}
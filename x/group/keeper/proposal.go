@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// getProposalGroup returns the group and the group policy of a proposal.
+func (k Keeper) getProposalGroup(ctx sdk.Context, proposal types.Proposal) (types.GroupInfo, types.GroupPolicyInfo, error) {
+	groupPolicy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(proposal.Address))
+	if err != nil {
+		return types.GroupInfo{}, groupPolicy, err
+	}
+
+	group, err := k.GetGroupInfo(ctx, groupPolicy.GroupId)
+	if err != nil {
+		return group, groupPolicy, err
+	}
+
+	return group, groupPolicy, nil
+}
+
+// proposalVersionsMatch returns true if neither the group nor the group policy
+// of a proposal were modified since its submission.
+func proposalVersionsMatch(proposal types.Proposal, group types.GroupInfo, groupPolicy types.GroupPolicyInfo) bool {
+	return proposal.GroupVersion == group.Version && proposal.GroupPolicyVersion == groupPolicy.Version
+}
+
+// tally updates the status and the result of a proposal given its current
+// vote state. When the voting period has ended, the proposal is closed even if
+// the decision policy didn't reach a final result, and is rejected unless the
+// decision policy allows it.
+func (k Keeper) tally(proposal *types.Proposal, group types.GroupInfo, groupPolicy types.GroupPolicyInfo, votingPeriodEnded bool) error {
+	decisionPolicy := groupPolicy.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	result, err := decisionPolicy.Allow(proposal.VoteState, group.TotalWeight)
+	if err != nil {
+		return err
+	}
+
+	if !result.Final && !votingPeriodEnded {
+		return nil
+	}
+
+	proposal.Status = types.ProposalStatusClosed
+	if result.Allow {
+		proposal.Result = types.ProposalResultAccepted
+	} else {
+		proposal.Result = types.ProposalResultRejected
+	}
+
+	return nil
+}
+
+// execProposalMsgs executes the messages of a proposal on behalf of its group
+// policy account. The state changes and the events of the messages are only
+// committed if they all succeed.
+func (k Keeper) execProposalMsgs(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+
+	address := mustAccAddress(proposal.Address)
+	cacheCtx, writeCache := ctx.CacheContext()
+	events := sdk.EmptyEvents()
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "message index %d must only be signed by the group policy account", i)
+		}
+
+		handler := k.router.Handler(msg.MethodName)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s; message index: %d", msg.MethodName, i)
+		}
+
+		msgResult, err := handler(cacheCtx, msg.Request)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		for _, event := range msgResult.GetEvents() {
+			events = events.AppendEvent(sdk.Event(event))
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
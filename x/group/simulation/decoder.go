@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding group type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.GroupSeqKey),
+			bytes.Equal(kvA.Key[:1], types.GroupPolicySeqKey),
+			bytes.Equal(kvA.Key[:1], types.ProposalSeqKey):
+			return fmt.Sprintf("%d\n%d", types.GetIDFromBytes(kvA.Value), types.GetIDFromBytes(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.GroupKeyPrefix):
+			var groupA, groupB types.GroupInfo
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &groupA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &groupB)
+			return fmt.Sprintf("%v\n%v", groupA, groupB)
+
+		case bytes.Equal(kvA.Key[:1], types.GroupMemberKeyPrefix):
+			var memberA, memberB types.GroupMember
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &memberA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &memberB)
+			return fmt.Sprintf("%v\n%v", memberA, memberB)
+
+		case bytes.Equal(kvA.Key[:1], types.GroupPolicyKeyPrefix):
+			var groupPolicyA, groupPolicyB types.GroupPolicyInfo
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &groupPolicyA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &groupPolicyB)
+			return fmt.Sprintf("%v\n%v", groupPolicyA, groupPolicyB)
+
+		case bytes.Equal(kvA.Key[:1], types.ProposalKeyPrefix):
+			var proposalA, proposalB types.Proposal
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &proposalA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+		case bytes.Equal(kvA.Key[:1], types.VoteKeyPrefix):
+			var voteA, voteB types.Vote
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &voteA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], types.GroupByAdminKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.GroupPolicyByGroupKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.GroupPolicyByAdminKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ProposalByGroupPolicyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.VoteByVoterKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid group key %X", kvA.Key))
+		}
+	}
+}
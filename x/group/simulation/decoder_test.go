@@ -0,0 +1,73 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	adminAddr  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	memberAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	group := types.GroupInfo{GroupId: 1, Admin: adminAddr.String(), Version: 1, TotalWeight: sdk.NewDec(1)}
+	groupBz, err := cdc.MarshalBinaryBare(&group)
+	require.NoError(t, err)
+
+	policyAddr := types.DeriveGroupPolicyAddress(1)
+	groupPolicy, err := types.NewGroupPolicyInfo(policyAddr, 1, adminAddr, "", 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour))
+	require.NoError(t, err)
+	groupPolicyBz, err := cdc.MarshalBinaryBare(&groupPolicy)
+	require.NoError(t, err)
+
+	vote := types.Vote{ProposalId: 1, Voter: memberAddr.String(), Choice: types.ChoiceYes, SubmittedAt: time.Now().UTC()}
+	voteBz, err := cdc.MarshalBinaryBare(&vote)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GroupSeqKey, Value: types.GetIDBytes(3)},
+			{Key: types.GetGroupKey(1), Value: groupBz},
+			{Key: types.GetGroupPolicyKey(policyAddr), Value: groupPolicyBz},
+			{Key: types.GetVoteKey(1, memberAddr), Value: voteBz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"GroupSeq", false, "3\n3"},
+		{"GroupInfo", false, fmt.Sprintf("%v\n%v", group, group)},
+		{"GroupPolicyInfo", false, fmt.Sprintf("%v\n%v", groupPolicy, groupPolicy)},
+		{"Vote", false, fmt.Sprintf("%v\n%v", vote, vote)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// genGroups returns randomly generated groups, with their members, the first
+// account being the admin of all the groups.
+func genGroups(r *rand.Rand, accounts []simtypes.Account) ([]types.GroupInfo, []types.GroupMember) {
+	numGroups := r.Intn(3)
+	groups := make([]types.GroupInfo, numGroups)
+	var members []types.GroupMember
+
+	for i := 0; i < numGroups; i++ {
+		groupID := uint64(i + 1)
+		totalWeight := sdk.ZeroDec()
+		for _, acc := range randomMembers(r, accounts) {
+			member := types.NewMember(acc.Address, sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10))), "")
+			totalWeight = totalWeight.Add(member.Weight)
+			members = append(members, types.GroupMember{GroupId: groupID, Member: member})
+		}
+
+		groups[i] = types.GroupInfo{
+			GroupId:     groupID,
+			Admin:       accounts[0].Address.String(),
+			Metadata:    simtypes.RandStringOfLength(r, 10),
+			Version:     1,
+			TotalWeight: totalWeight,
+		}
+	}
+
+	return groups, members
+}
+
+// randomMembers returns a random non empty subset of the accounts.
+func randomMembers(r *rand.Rand, accounts []simtypes.Account) []simtypes.Account {
+	numMembers := simtypes.RandIntBetween(r, 1, 4)
+	if numMembers > len(accounts) {
+		numMembers = len(accounts)
+	}
+
+	members := make([]simtypes.Account, numMembers)
+	for i, j := range r.Perm(len(accounts))[:numMembers] {
+		members[i] = accounts[j]
+	}
+
+	return members
+}
+
+// RandomizedGenState generates a random GenesisState for group
+func RandomizedGenState(simState *module.SimulationState) {
+	groups, members := genGroups(simState.Rand, simState.Accounts)
+
+	groupGenesis := types.NewGenesisState()
+	groupGenesis.GroupSeq = uint64(len(groups))
+	groupGenesis.Groups = groups
+	groupGenesis.GroupMembers = members
+
+	bz, err := simState.Cdc.MarshalJSON(groupGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
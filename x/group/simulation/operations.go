@@ -0,0 +1,375 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateGroup        = "op_weight_msg_create_group"
+	OpWeightMsgUpdateGroupMembers = "op_weight_msg_update_group_members"
+	OpWeightMsgCreateGroupPolicy  = "op_weight_msg_create_group_policy"
+	OpWeightMsgSubmitProposal     = "op_weight_msg_submit_group_proposal"
+	OpWeightMsgVote               = "op_weight_msg_group_vote"
+	OpWeightMsgExec               = "op_weight_msg_group_exec"
+)
+
+// sendMethodName is the Msg service method name of bank sends, which are
+// used as the messages of the simulated proposals.
+const sendMethodName = "/cosmos.bank.v1beta1.Msg/Send"
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateGroup        int
+		weightMsgUpdateGroupMembers int
+		weightMsgCreateGroupPolicy  int
+		weightMsgSubmitProposal     int
+		weightMsgVote               int
+		weightMsgExec               int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroup, &weightMsgCreateGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroup = simappparams.DefaultWeightMsgCreateGroup
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateGroupMembers, &weightMsgUpdateGroupMembers, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateGroupMembers = simappparams.DefaultWeightMsgUpdateGroupMembers
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroupPolicy, &weightMsgCreateGroupPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroupPolicy = simappparams.DefaultWeightMsgCreateGroupPolicy
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitProposal = simappparams.DefaultWeightMsgSubmitGroupProposal
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = simappparams.DefaultWeightMsgGroupVote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgGroupExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroup,
+			SimulateMsgCreateGroup(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateGroupMembers,
+			SimulateMsgUpdateGroupMembers(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroupPolicy,
+			SimulateMsgCreateGroupPolicy(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitProposal,
+			SimulateMsgSubmitProposal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateGroup generates a MsgCreateGroup with random values.
+// nolint: interfacer
+func SimulateMsgCreateGroup(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		var members []types.Member
+		for _, acc := range randomMembers(r, accs) {
+			members = append(members, types.NewMember(acc.Address, sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10))), ""))
+		}
+
+		msg := types.NewMsgCreateGroup(admin.Address, members, simtypes.RandStringOfLength(r, 10))
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgUpdateGroupMembers generates a MsgUpdateGroupMembers with random values.
+// nolint: interfacer
+func SimulateMsgUpdateGroupMembers(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		group, admin, ok := randomGroup(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateGroupMembers, "no group found"), nil, nil
+		}
+
+		// add or update a random account, members are never removed so that
+		// groups can't be left without members
+		acc, _ := simtypes.RandomAcc(r, accs)
+		update := types.NewMember(acc.Address, sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10))), "")
+
+		msg := types.NewMsgUpdateGroupMembers(admin.Address, group.GroupId, []types.Member{update})
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgCreateGroupPolicy generates a MsgCreateGroupPolicy with random values.
+// nolint: interfacer
+func SimulateMsgCreateGroupPolicy(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		group, admin, ok := randomGroup(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateGroupPolicy, "no group found"), nil, nil
+		}
+
+		timeout := time.Duration(simtypes.RandIntBetween(r, 60, 3600)) * time.Second
+		var decisionPolicy types.DecisionPolicy
+		if r.Intn(2) == 0 {
+			decisionPolicy = types.NewThresholdDecisionPolicy(sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10))), timeout)
+		} else {
+			decisionPolicy = types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2), timeout)
+		}
+
+		msg, err := types.NewMsgCreateGroupPolicy(admin.Address, group.GroupId, simtypes.RandStringOfLength(r, 10), decisionPolicy)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateGroupPolicy, err.Error()), nil, err
+		}
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal with random values,
+// the proposal sends a random subset of the group policy account balance.
+// nolint: interfacer
+func SimulateMsgSubmitProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var groupPolicies []types.GroupPolicyInfo
+		k.IterateGroupPolicies(ctx, func(groupPolicy types.GroupPolicyInfo) bool {
+			groupPolicies = append(groupPolicies, groupPolicy)
+			return false
+		})
+		if len(groupPolicies) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "no group policy found"), nil, nil
+		}
+		groupPolicy := groupPolicies[r.Intn(len(groupPolicies))]
+
+		proposer, ok := randomMember(r, ctx, k, groupPolicy.GroupId, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "no member found"), nil, nil
+		}
+
+		address, err := sdk.AccAddressFromBech32(groupPolicy.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+
+		var msgs []sdk.ServiceMsg
+		coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, address))
+		if !coins.Empty() {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			msgs = append(msgs, sdk.ServiceMsg{
+				MethodName: sendMethodName,
+				Request:    banktypes.NewMsgSend(address, recipient.Address, coins),
+			})
+		}
+
+		msg, err := types.NewMsgSubmitProposal(address, []string{proposer.Address.String()}, msgs, simtypes.RandStringOfLength(r, 10))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, proposer, chainID)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with random values.
+// nolint: interfacer
+func SimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			proposal types.Proposal
+			voter    simtypes.Account
+			found    bool
+		)
+		k.IterateProposals(ctx, func(p types.Proposal) bool {
+			if p.Status != types.ProposalStatusSubmitted || !ctx.BlockTime().Before(p.Timeout) {
+				return false
+			}
+
+			groupPolicy, err := k.GetGroupPolicyInfo(ctx, mustAccAddress(p.Address))
+			if err != nil || groupPolicy.Version != p.GroupPolicyVersion {
+				return false
+			}
+			group, err := k.GetGroupInfo(ctx, groupPolicy.GroupId)
+			if err != nil || group.Version != p.GroupVersion {
+				return false
+			}
+
+			// the first member of the group which didn't vote yet votes
+			k.IterateGroupMembers(ctx, group.GroupId, func(member types.GroupMember) bool {
+				address := mustAccAddress(member.Member.Address)
+				if _, voted := k.GetVote(ctx, p.ProposalId, address); voted {
+					return false
+				}
+				voter, found = simtypes.FindAccount(accs, address)
+				return found
+			})
+			proposal = p
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVote, "no proposal to vote on"), nil, nil
+		}
+
+		choices := []types.Choice{types.ChoiceYes, types.ChoiceNo, types.ChoiceAbstain, types.ChoiceVeto}
+		msg := types.NewMsgVote(voter.Address, proposal.ProposalId, choices[r.Intn(len(choices))], simtypes.RandStringOfLength(r, 10))
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, voter, chainID)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec with random values.
+// nolint: interfacer
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var proposal types.Proposal
+		found := false
+		k.IterateProposals(ctx, func(p types.Proposal) bool {
+			switch {
+			case p.Status == types.ProposalStatusClosed:
+				found = p.Result == types.ProposalResultAccepted && p.ExecutorResult != types.ProposalExecutorResultSuccess
+			case p.Status == types.ProposalStatusSubmitted:
+				found = !ctx.BlockTime().Before(p.Timeout)
+			}
+			proposal = p
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgExec, "no proposal to execute"), nil, nil
+		}
+
+		signer, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgExec(signer.Address, proposal.ProposalId)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, signer, chainID)
+	}
+}
+
+// randomGroup returns a random group whose admin is one of the simulation
+// accounts.
+func randomGroup(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.GroupInfo, simtypes.Account, bool) {
+	var groups []types.GroupInfo
+	k.IterateGroups(ctx, func(group types.GroupInfo) bool {
+		groups = append(groups, group)
+		return false
+	})
+	if len(groups) == 0 {
+		return types.GroupInfo{}, simtypes.Account{}, false
+	}
+
+	group := groups[r.Intn(len(groups))]
+	admin, ok := simtypes.FindAccount(accs, mustAccAddress(group.Admin))
+	return group, admin, ok
+}
+
+// randomMember returns a random member of a group which is one of the
+// simulation accounts.
+func randomMember(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, groupID uint64, accs []simtypes.Account) (simtypes.Account, bool) {
+	var members []simtypes.Account
+	k.IterateGroupMembers(ctx, groupID, func(member types.GroupMember) bool {
+		if acc, ok := simtypes.FindAccount(accs, mustAccAddress(member.Member.Address)); ok {
+			members = append(members, acc)
+		}
+		return false
+	})
+	if len(members) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return members[r.Intn(len(members))], true
+}
+
+// genAndDeliverTx generates a transaction with random fees, signed by the
+// given account, and delivers it.
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	msg sdk.Msg, signer simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, signer.Address))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "fee error"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -0,0 +1,223 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Time: time.Now()})
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		err := suite.app.BankKeeper.SetBalances(suite.ctx, account.Address, initCoins)
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+// createGroupPolicy creates a group administered by the first account, whose
+// members are all the accounts, and a group policy with a threshold of 1.
+func (suite *SimTestSuite) createGroupPolicy(ctx sdk.Context, accounts []simtypes.Account) (uint64, sdk.AccAddress) {
+	require := suite.Require()
+	msgServer := keeper.NewMsgServerImpl(suite.app.GroupKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var members []types.Member
+	for _, acc := range accounts {
+		members = append(members, types.NewMember(acc.Address, sdk.NewDec(1), ""))
+	}
+	groupRes, err := msgServer.CreateGroup(goCtx, types.NewMsgCreateGroup(accounts[0].Address, members, ""))
+	require.NoError(err)
+
+	msg, err := types.NewMsgCreateGroupPolicy(accounts[0].Address, groupRes.GroupId, "", types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour))
+	require.NoError(err)
+	policyRes, err := msgServer.CreateGroupPolicy(goCtx, msg)
+	require.NoError(err)
+
+	address, err := sdk.AccAddressFromBech32(policyRes.Address)
+	require.NoError(err)
+	return groupRes.GroupId, address
+}
+
+// TestWeightedOperations tests the weights of the operations.
+func (suite *SimTestSuite) TestWeightedOperations() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, cdc, app.AccountKeeper,
+		app.BankKeeper, app.GroupKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simappparams.DefaultWeightMsgCreateGroup, types.ModuleName, types.TypeMsgCreateGroup},
+		{simappparams.DefaultWeightMsgUpdateGroupMembers, types.ModuleName, types.TypeMsgUpdateGroupMembers},
+		{simappparams.DefaultWeightMsgCreateGroupPolicy, types.ModuleName, types.TypeMsgCreateGroupPolicy},
+		{simappparams.DefaultWeightMsgSubmitGroupProposal, types.ModuleName, types.TypeMsgSubmitProposal},
+		{simappparams.DefaultWeightMsgGroupVote, types.ModuleName, types.TypeMsgVote},
+		{simappparams.DefaultWeightMsgGroupExec, types.ModuleName, types.TypeMsgExec},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateMsgCreateGroup tests the normal scenario of a valid message of type TypeMsgCreateGroup.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgCreateGroup() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateGroup(app.AccountKeeper, app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg types.MsgCreateGroup
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.NotEmpty(msg.Members)
+	require.Len(futureOperations, 0)
+}
+
+// TestSimulateMsgCreateGroupPolicy tests the normal scenario of a valid message of type TypeMsgCreateGroupPolicy.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgCreateGroupPolicy() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	groupID, _ := suite.createGroupPolicy(ctx, accounts)
+
+	// execute operation
+	op := simulation.SimulateMsgCreateGroupPolicy(app.AccountKeeper, app.BankKeeper, app.GroupKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+
+	var msg types.MsgCreateGroupPolicy
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.Equal(accounts[0].Address.String(), msg.Admin)
+	require.Equal(groupID, msg.GroupId)
+	require.Len(futureOperations, 0)
+}
+
+// TestSimulateProposal tests the normal scenario of submitting a proposal,
+// voting on it and executing it.
+func (suite *SimTestSuite) TestSimulateProposal() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block, the operations being delivered in its state
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: ctx.BlockTime()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+
+	_, address := suite.createGroupPolicy(ctx, accounts)
+	require.NoError(simapp.FundAccount(app, ctx, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+
+	op := simulation.SimulateMsgSubmitProposal(app.AccountKeeper, app.BankKeeper, app.GroupKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+	require.True(operationMsg.OK)
+
+	var submitMsg types.MsgSubmitProposal
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &submitMsg)
+	require.Equal(address.String(), submitMsg.Address)
+
+	op = simulation.SimulateMsgVote(app.AccountKeeper, app.BankKeeper, app.GroupKeeper)
+	operationMsg, _, err = op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+	require.True(operationMsg.OK)
+
+	// the other members vote yes so that the proposal is accepted whatever
+	// the simulated vote was
+	msgServer := keeper.NewMsgServerImpl(app.GroupKeeper)
+	for _, acc := range accounts[1:] {
+		_, _ = msgServer.Vote(sdk.WrapSDKContext(ctx), types.NewMsgVote(acc.Address, 1, types.ChoiceYes, ""))
+	}
+
+	op = simulation.SimulateMsgExec(app.AccountKeeper, app.BankKeeper, app.GroupKeeper)
+	operationMsg, _, err = op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(err)
+	require.True(operationMsg.OK)
+
+	var execMsg types.MsgExec
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &execMsg)
+	require.Equal(uint64(1), execMsg.ProposalId)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
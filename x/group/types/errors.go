@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	// ErrEmpty error if a required value is empty
+	ErrEmpty = sdkerrors.Register(ModuleName, 2, "value is empty")
+	// ErrDuplicate error if a value is set more than once
+	ErrDuplicate = sdkerrors.Register(ModuleName, 3, "duplicate value")
+	// ErrMaxLimit error if a value exceeds its limit
+	ErrMaxLimit = sdkerrors.Register(ModuleName, 4, "limit exceeded")
+	// ErrInvalid error if a value is invalid
+	ErrInvalid = sdkerrors.Register(ModuleName, 5, "invalid value")
+	// ErrUnauthorized error if the signer isn't allowed to perform an action
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 6, "unauthorized")
+	// ErrModified error if a proposal's group or group policy was modified
+	// after its submission
+	ErrModified = sdkerrors.Register(ModuleName, 7, "modified")
+	// ErrExpired error if a proposal's voting period has ended
+	ErrExpired = sdkerrors.Register(ModuleName, 8, "expired")
+	// ErrNotFound error if a group, group policy, proposal or vote doesn't exist
+	ErrNotFound = sdkerrors.Register(ModuleName, 9, "not found")
+)
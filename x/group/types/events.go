@@ -0,0 +1,20 @@
+package types
+
+// group module events
+const (
+	EventCreateGroup       = "create_group"
+	EventUpdateGroup       = "update_group"
+	EventCreateGroupPolicy = "create_group_policy"
+	EventUpdateGroupPolicy = "update_group_policy"
+	EventSubmitProposal    = "submit_proposal"
+	EventVote              = "vote"
+	EventExec              = "exec"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	groups := make(map[uint64]GroupInfo, len(data.Groups))
+	for _, g := range data.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group")
+		}
+		if g.GroupId > data.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d is greater than the group sequence", g.GroupId)
+		}
+		if _, ok := groups[g.GroupId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group id %d", g.GroupId)
+		}
+		groups[g.GroupId] = g
+	}
+
+	totalWeights := make(map[uint64]sdk.Dec, len(data.Groups))
+	members := make(map[string]struct{}, len(data.GroupMembers))
+	for _, m := range data.GroupMembers {
+		if _, ok := groups[m.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of member %s", m.GroupId, m.Member.Address)
+		}
+		if err := m.Member.ValidateBasic(false); err != nil {
+			return sdkerrors.Wrap(err, "group member")
+		}
+		key := string(GetIDBytes(m.GroupId)) + m.Member.Address
+		if _, ok := members[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s of group %d", m.Member.Address, m.GroupId)
+		}
+		members[key] = struct{}{}
+
+		if weight, ok := totalWeights[m.GroupId]; ok {
+			totalWeights[m.GroupId] = weight.Add(m.Member.Weight)
+		} else {
+			totalWeights[m.GroupId] = m.Member.Weight
+		}
+	}
+
+	for _, g := range data.Groups {
+		totalWeight, ok := totalWeights[g.GroupId]
+		if !ok {
+			totalWeight = sdk.ZeroDec()
+		}
+		if !totalWeight.Equal(g.TotalWeight) {
+			return sdkerrors.Wrapf(ErrInvalid, "total weight of group %d doesn't match the weights of its members", g.GroupId)
+		}
+	}
+
+	groupPolicies := make(map[string]struct{}, len(data.GroupPolicies))
+	for _, p := range data.GroupPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group policy")
+		}
+		if _, ok := groups[p.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group %d of group policy %s", p.GroupId, p.Address)
+		}
+		if _, ok := groupPolicies[p.Address]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group policy %s", p.Address)
+		}
+		groupPolicies[p.Address] = struct{}{}
+	}
+
+	proposals := make(map[uint64]struct{}, len(data.Proposals))
+	for _, p := range data.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal")
+		}
+		if p.ProposalId > data.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d is greater than the proposal sequence", p.ProposalId)
+		}
+		if _, ok := groupPolicies[p.Address]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "group policy %s of proposal %d", p.Address, p.ProposalId)
+		}
+		if _, ok := proposals[p.ProposalId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal id %d", p.ProposalId)
+		}
+		proposals[p.ProposalId] = struct{}{}
+	}
+
+	votes := make(map[string]struct{}, len(data.Votes))
+	for _, v := range data.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "vote")
+		}
+		if _, ok := proposals[v.ProposalId]; !ok {
+			return sdkerrors.Wrapf(ErrNotFound, "proposal %d of vote by %s", v.ProposalId, v.Voter)
+		}
+		key := string(GetIDBytes(v.ProposalId)) + v.Voter
+		if _, ok := votes[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "vote by %s on proposal %d", v.Voter, v.ProposalId)
+		}
+		votes[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, p := range data.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range data.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
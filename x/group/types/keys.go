@@ -0,0 +1,166 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey is the store key string for group
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for group
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x01: group sequence
+// - 0x02: group policy sequence
+// - 0x03: proposal sequence
+//
+// - 0x10<groupID_Bytes>: GroupInfo
+// - 0x11<adminAddress_Bytes><groupID_Bytes>: []byte{}
+// - 0x12<groupID_Bytes><memberAddress_Bytes>: GroupMember
+//
+// - 0x20<policyAddress_Bytes>: GroupPolicyInfo
+// - 0x21<groupID_Bytes><policyAddress_Bytes>: []byte{}
+// - 0x22<adminAddress_Bytes><policyAddress_Bytes>: []byte{}
+//
+// - 0x30<proposalID_Bytes>: Proposal
+// - 0x31<policyAddress_Bytes><proposalID_Bytes>: []byte{}
+//
+// - 0x40<proposalID_Bytes><voterAddress_Bytes>: Vote
+// - 0x41<voterAddress_Bytes><proposalID_Bytes>: []byte{}
+var (
+	GroupSeqKey       = []byte{0x01}
+	GroupPolicySeqKey = []byte{0x02}
+	ProposalSeqKey    = []byte{0x03}
+
+	GroupKeyPrefix        = []byte{0x10}
+	GroupByAdminKeyPrefix = []byte{0x11}
+	GroupMemberKeyPrefix  = []byte{0x12}
+
+	GroupPolicyKeyPrefix        = []byte{0x20}
+	GroupPolicyByGroupKeyPrefix = []byte{0x21}
+	GroupPolicyByAdminKeyPrefix = []byte{0x22}
+
+	ProposalKeyPrefix              = []byte{0x30}
+	ProposalByGroupPolicyKeyPrefix = []byte{0x31}
+
+	VoteKeyPrefix        = []byte{0x40}
+	VoteByVoterKeyPrefix = []byte{0x41}
+)
+
+// GetIDBytes returns the big endian byte representation of an ID, so that
+// IDs are iterated in increasing order.
+func GetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIDFromBytes returns an ID from its big endian byte representation.
+func GetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetGroupKey returns the store key of a group.
+func GetGroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GetGroupsByAdminPrefix returns the index prefix of the groups of an admin.
+func GetGroupsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminKeyPrefix, admin.Bytes()...)
+}
+
+// GetGroupByAdminKey returns the index key of a group of an admin.
+func GetGroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GetGroupsByAdminPrefix(admin), GetIDBytes(groupID)...)
+}
+
+// GetGroupMembersPrefix returns the store key prefix of the members of a
+// group.
+func GetGroupMembersPrefix(groupID uint64) []byte {
+	return append(GroupMemberKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GetGroupMemberKey returns the store key of a group member.
+func GetGroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GetGroupMembersPrefix(groupID), member.Bytes()...)
+}
+
+// GetGroupPolicyKey returns the store key of a group policy.
+func GetGroupPolicyKey(address sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, address.Bytes()...)
+}
+
+// GetGroupPoliciesByGroupPrefix returns the index prefix of the group
+// policies of a group.
+func GetGroupPoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(GroupPolicyByGroupKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GetGroupPolicyByGroupKey returns the index key of a group policy of a group.
+func GetGroupPolicyByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GetGroupPoliciesByGroupPrefix(groupID), address.Bytes()...)
+}
+
+// GetGroupPoliciesByAdminPrefix returns the index prefix of the group
+// policies of an admin.
+func GetGroupPoliciesByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupPolicyByAdminKeyPrefix, admin.Bytes()...)
+}
+
+// GetGroupPolicyByAdminKey returns the index key of a group policy of an
+// admin.
+func GetGroupPolicyByAdminKey(admin sdk.AccAddress, address sdk.AccAddress) []byte {
+	return append(GetGroupPoliciesByAdminPrefix(admin), address.Bytes()...)
+}
+
+// GetProposalKey returns the store key of a proposal.
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// GetProposalsByGroupPolicyPrefix returns the index prefix of the proposals
+// of a group policy.
+func GetProposalsByGroupPolicyPrefix(address sdk.AccAddress) []byte {
+	return append(ProposalByGroupPolicyKeyPrefix, address.Bytes()...)
+}
+
+// GetProposalByGroupPolicyKey returns the index key of a proposal of a group
+// policy.
+func GetProposalByGroupPolicyKey(address sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetProposalsByGroupPolicyPrefix(address), GetIDBytes(proposalID)...)
+}
+
+// GetVotesByProposalPrefix returns the store key prefix of the votes on a
+// proposal.
+func GetVotesByProposalPrefix(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// GetVoteKey returns the store key of a vote.
+func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetVotesByProposalPrefix(proposalID), voter.Bytes()...)
+}
+
+// GetVotesByVoterPrefix returns the index prefix of the votes of a voter.
+func GetVotesByVoterPrefix(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterKeyPrefix, voter.Bytes()...)
+}
+
+// GetVoteByVoterKey returns the index key of a vote of a voter.
+func GetVoteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetVotesByVoterPrefix(voter), GetIDBytes(proposalID)...)
+}
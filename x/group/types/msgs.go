@@ -0,0 +1,574 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup                     = "create_group"
+	TypeMsgUpdateGroupMembers              = "update_group_members"
+	TypeMsgUpdateGroupAdmin                = "update_group_admin"
+	TypeMsgUpdateGroupMetadata             = "update_group_metadata"
+	TypeMsgCreateGroupPolicy               = "create_group_policy"
+	TypeMsgUpdateGroupPolicyAdmin          = "update_group_policy_admin"
+	TypeMsgUpdateGroupPolicyDecisionPolicy = "update_group_policy_decision_policy"
+	TypeMsgUpdateGroupPolicyMetadata       = "update_group_policy_metadata"
+	TypeMsgSubmitProposal                  = "submit_proposal"
+	TypeMsgVote                            = "vote"
+	TypeMsgExec                            = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgSubmitProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = &MsgCreateGroupPolicy{}
+	_ types.UnpackInterfacesMessage = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+)
+
+// mustAccAddress returns the account address of a msg signer, which was
+// checked by ValidateBasic.
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func validateAdmin(admin string) error {
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	return nil
+}
+
+func validateGroupPolicyAddress(address string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy address (%s)", err)
+	}
+	return nil
+}
+
+func validateDecisionPolicy(any *types.Any) error {
+	policy := unpackDecisionPolicy(any)
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	return policy.ValidateBasic()
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup
+// nolint: interfacer
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata string) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Admin:    admin.String(),
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if err := validateMembers(msg.Members, false); err != nil {
+		return err
+	}
+	return validateMetadata(msg.Metadata)
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers
+// nolint: interfacer
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates []Member) *MsgUpdateGroupMembers {
+	return &MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	return validateMembers(msg.MemberUpdates, true)
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin
+// nolint: interfacer
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) *MsgUpdateGroupAdmin {
+	return &MsgUpdateGroupAdmin{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateAdmin(msg.NewAdmin); err != nil {
+		return err
+	}
+	if msg.Admin == msg.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// NewMsgUpdateGroupMetadata creates a new MsgUpdateGroupMetadata
+// nolint: interfacer
+func NewMsgUpdateGroupMetadata(admin sdk.AccAddress, groupID uint64, metadata string) *MsgUpdateGroupMetadata {
+	return &MsgUpdateGroupMetadata{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) Type() string { return TypeMsgUpdateGroupMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMetadata) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	return validateMetadata(msg.Metadata)
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy
+// nolint: interfacer
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy DecisionPolicy) (*MsgCreateGroupPolicy, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateGroupPolicy{
+		Admin:          admin.String(),
+		GroupId:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the msg, or nil if
+// it is not set.
+func (msg MsgCreateGroupPolicy) GetDecisionPolicy() DecisionPolicy {
+	return unpackDecisionPolicy(msg.DecisionPolicy)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) Type() string { return TypeMsgCreateGroupPolicy }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateDecisionPolicy(msg.DecisionPolicy); err != nil {
+		return err
+	}
+	return validateMetadata(msg.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupPolicyAdmin creates a new MsgUpdateGroupPolicyAdmin
+// nolint: interfacer
+func NewMsgUpdateGroupPolicyAdmin(admin sdk.AccAddress, address sdk.AccAddress, newAdmin sdk.AccAddress) *MsgUpdateGroupPolicyAdmin {
+	return &MsgUpdateGroupPolicyAdmin{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyAdmin) Type() string { return TypeMsgUpdateGroupPolicyAdmin }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if err := validateGroupPolicyAddress(msg.Address); err != nil {
+		return err
+	}
+	if err := validateAdmin(msg.NewAdmin); err != nil {
+		return err
+	}
+	if msg.Admin == msg.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy creates a new MsgUpdateGroupPolicyDecisionPolicy
+// nolint: interfacer
+func NewMsgUpdateGroupPolicyDecisionPolicy(admin sdk.AccAddress, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicy, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:          admin.String(),
+		Address:        address.String(),
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the msg, or nil if
+// it is not set.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	return unpackDecisionPolicy(msg.DecisionPolicy)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupPolicyDecisionPolicy
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if err := validateGroupPolicyAddress(msg.Address); err != nil {
+		return err
+	}
+	return validateDecisionPolicy(msg.DecisionPolicy)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupPolicyMetadata creates a new MsgUpdateGroupPolicyMetadata
+// nolint: interfacer
+func NewMsgUpdateGroupPolicyMetadata(admin sdk.AccAddress, address sdk.AccAddress, metadata string) *MsgUpdateGroupPolicyMetadata {
+	return &MsgUpdateGroupPolicyMetadata{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyMetadata) Type() string { return TypeMsgUpdateGroupPolicyMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyMetadata) ValidateBasic() error {
+	if err := validateAdmin(msg.Admin); err != nil {
+		return err
+	}
+	if err := validateGroupPolicyAddress(msg.Address); err != nil {
+		return err
+	}
+	return validateMetadata(msg.Metadata)
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal
+// nolint: interfacer
+func NewMsgSubmitProposal(address sdk.AccAddress, proposers []string, msgs []sdk.ServiceMsg, metadata string) (*MsgSubmitProposal, error) {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      anys,
+	}, nil
+}
+
+// GetServiceMsgs returns the cached service messages of the msg.
+func (msg MsgSubmitProposal) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(msg.Msgs)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// GetSigners implements the sdk.Msg interface, all the proposers must sign.
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(msg.Proposers))
+	for i, proposer := range msg.Proposers {
+		signers[i] = mustAccAddress(proposer)
+	}
+	return signers
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface. The messages of the
+// proposal must all be signed by the group policy account only.
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy address (%s)", err)
+	}
+	if err := validateProposers(msg.Proposers); err != nil {
+		return err
+	}
+
+	msgs, err := msg.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(ErrUnauthorized, "message index %d must only be signed by the group policy account", i)
+		}
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackServiceMsgAnys(msg.Msgs, unpacker)
+}
+
+// NewMsgVote creates a new MsgVote
+// nolint: interfacer
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, choice Choice, metadata string) *MsgVote {
+	return &MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Choice:     choice,
+		Metadata:   metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Voter)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	if !ValidChoice(msg.Choice) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid choice %s", msg.Choice)
+	}
+	return validateMetadata(msg.Metadata)
+}
+
+// NewMsgExec creates a new MsgExec
+// nolint: interfacer
+func NewMsgExec(signer sdk.AccAddress, proposalID uint64) *MsgExec {
+	return &MsgExec{
+		ProposalId: proposalID,
+		Signer:     signer.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Signer)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 1000))
+	admin    = sdk.AccAddress("_______admin________")
+	member1  = sdk.AccAddress("_______member1______")
+	member2  = sdk.AccAddress("_______member2______")
+	policy   = sdk.AccAddress("_______policy_______")
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	one := sdk.NewDec(1)
+	tests := []struct {
+		title      string
+		admin      sdk.AccAddress
+		members    []types.Member
+		metadata   string
+		expectPass bool
+	}{
+		{"nil admin address", nil, []types.Member{types.NewMember(member1, one, "")}, "", false},
+		{"no members", admin, nil, "", true},
+		{"zero weight member", admin, []types.Member{types.NewMember(member1, sdk.ZeroDec(), "")}, "", false},
+		{"negative weight member", admin, []types.Member{types.NewMember(member1, sdk.NewDec(-1), "")}, "", false},
+		{"duplicate members", admin, []types.Member{types.NewMember(member1, one, ""), types.NewMember(member1, one, "")}, "", false},
+		{"too long metadata", admin, []types.Member{types.NewMember(member1, one, "")}, strings.Repeat("a", types.MaxMetadataLen+1), false},
+		{"valid test case", admin, []types.Member{types.NewMember(member1, one, ""), types.NewMember(member2, one, "")}, "metadata", true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgCreateGroup(tc.admin, tc.members, tc.metadata)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	tests := []struct {
+		title      string
+		groupID    uint64
+		updates    []types.Member
+		expectPass bool
+	}{
+		{"missing group id", 0, []types.Member{types.NewMember(member1, sdk.NewDec(1), "")}, false},
+		{"no updates", 1, nil, false},
+		{"negative weight", 1, []types.Member{types.NewMember(member1, sdk.NewDec(-1), "")}, false},
+		{"removal", 1, []types.Member{types.NewMember(member1, sdk.ZeroDec(), "")}, true},
+		{"valid test case", 1, []types.Member{types.NewMember(member1, sdk.NewDec(2), "")}, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgUpdateGroupMembers(admin, tc.groupID, tc.updates)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	tests := []struct {
+		title          string
+		admin          sdk.AccAddress
+		groupID        uint64
+		decisionPolicy types.DecisionPolicy
+		expectPass     bool
+	}{
+		{"nil admin address", nil, 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour), false},
+		{"missing group id", admin, 0, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour), false},
+		{"zero threshold", admin, 1, types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour), false},
+		{"zero timeout", admin, 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), 0), false},
+		{"percentage above one", admin, 1, types.NewPercentageDecisionPolicy(sdk.NewDec(2), time.Hour), false},
+		{"valid threshold policy", admin, 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour), true},
+		{"valid percentage policy", admin, 1, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour), true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgCreateGroupPolicy(tc.admin, tc.groupID, "", tc.decisionPolicy)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	tests := []struct {
+		title      string
+		proposers  []string
+		msgs       []sdk.ServiceMsg
+		expectPass bool
+	}{
+		{"no proposers", nil, nil, false},
+		{"invalid proposer", []string{"invalid"}, nil, false},
+		{"duplicate proposers", []string{member1.String(), member1.String()}, nil, false},
+		{"invalid inner message", []string{member1.String()}, []sdk.ServiceMsg{{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(policy, member1, sdk.Coins{}),
+		}}, false},
+		{"inner message not signed by the group policy", []string{member1.String()}, []sdk.ServiceMsg{{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(member1, member2, coinsPos),
+		}}, false},
+		{"no messages", []string{member1.String()}, nil, true},
+		{"valid test case", []string{member1.String(), member2.String()}, []sdk.ServiceMsg{{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(policy, member1, coinsPos),
+		}}, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgSubmitProposal(policy, tc.proposers, tc.msgs, "")
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Len(t, msg.GetSigners(), len(tc.proposers))
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgVote(t *testing.T) {
+	tests := []struct {
+		title      string
+		voter      sdk.AccAddress
+		proposalID uint64
+		choice     types.Choice
+		expectPass bool
+	}{
+		{"nil voter address", nil, 1, types.ChoiceYes, false},
+		{"missing proposal id", member1, 0, types.ChoiceYes, false},
+		{"unspecified choice", member1, 1, types.ChoiceUnspecified, false},
+		{"unknown choice", member1, 1, types.Choice(10), false},
+		{"valid test case", member1, 1, types.ChoiceVeto, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgVote(tc.voter, tc.proposalID, tc.choice, "")
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
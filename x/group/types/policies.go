@@ -0,0 +1,119 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not. If final, the
+	// proposal is closed and no more votes can be cast on it.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules to determine the result of
+// election on a proposal.
+type DecisionPolicy interface {
+	proto.Message
+
+	// GetTimeout returns the duration after submission of a proposal after
+	// which no more votes can be cast on it.
+	GetTimeout() time.Duration
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result and the group's total weight.
+	Allow(tally Tally, totalWeight sdk.Dec) (DecisionPolicyResult, error)
+	// ValidateBasic performs stateless validation of the decision policy.
+	ValidateBasic() error
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// GetTimeout implements DecisionPolicy.GetTimeout.
+func (p ThresholdDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow allows a proposal to pass when the weighted sum of the yes votes
+// reaches the threshold, capped to the group's total weight. It is rejected
+// as soon as the threshold can't be reached anymore.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec) (DecisionPolicyResult, error) {
+	threshold := sdk.MinDec(p.Threshold, totalWeight)
+	if tally.YesCount.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "threshold must be positive")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+	return nil
+}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// GetTimeout implements DecisionPolicy.GetTimeout.
+func (p PercentageDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow allows a proposal to pass when the share of the group's total weight
+// which voted yes reaches the percentage. It is rejected as soon as the
+// percentage can't be reached anymore.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec) (DecisionPolicyResult, error) {
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	if tally.YesCount.Quo(totalWeight).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).Quo(totalWeight).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func tally(yes, no, abstain, veto int64) types.Tally {
+	return types.Tally{
+		YesCount:     sdk.NewDec(yes),
+		NoCount:      sdk.NewDec(no),
+		AbstainCount: sdk.NewDec(abstain),
+		VetoCount:    sdk.NewDec(veto),
+	}
+}
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	tests := []struct {
+		title       string
+		threshold   int64
+		tally       types.Tally
+		totalWeight int64
+		expected    types.DecisionPolicyResult
+	}{
+		{"no votes", 2, tally(0, 0, 0, 0), 3, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold reached", 2, tally(2, 0, 0, 0), 3, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold can still be reached", 2, tally(1, 1, 0, 0), 3, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold can't be reached", 2, tally(0, 1, 1, 0), 3, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"threshold above total weight", 10, tally(3, 0, 0, 0), 3, types.DecisionPolicyResult{Allow: true, Final: true}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			policy := types.NewThresholdDecisionPolicy(sdk.NewDec(tc.threshold), time.Hour)
+			require.NoError(t, policy.ValidateBasic())
+
+			result, err := policy.Allow(tc.tally, sdk.NewDec(tc.totalWeight))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	tests := []struct {
+		title       string
+		tally       types.Tally
+		totalWeight int64
+		expected    types.DecisionPolicyResult
+	}{
+		{"no votes", tally(0, 0, 0, 0), 4, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage reached", tally(2, 0, 0, 0), 4, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"percentage can still be reached", tally(1, 2, 0, 0), 4, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage can't be reached", tally(1, 1, 0, 2), 4, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"empty group", tally(0, 0, 0, 0), 0, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+			require.NoError(t, policy.ValidateBasic())
+
+			result, err := policy.Allow(tc.tally, sdk.NewDec(tc.totalWeight))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestTallyAdd(t *testing.T) {
+	tally := types.DefaultTally()
+	require.NoError(t, tally.Add(types.ChoiceYes, sdk.NewDec(2)))
+	require.NoError(t, tally.Add(types.ChoiceVeto, sdk.NewDec(1)))
+	require.Error(t, tally.Add(types.ChoiceUnspecified, sdk.NewDec(1)))
+	require.Equal(t, sdk.NewDec(3), tally.TotalCounts())
+	require.NoError(t, tally.ValidateBasic())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &QueryGroupPolicyInfoResponse{}
+	_ types.UnpackInterfacesMessage = &QueryGroupPoliciesByGroupResponse{}
+	_ types.UnpackInterfacesMessage = &QueryGroupPoliciesByAdminResponse{}
+	_ types.UnpackInterfacesMessage = &QueryProposalResponse{}
+	_ types.UnpackInterfacesMessage = &QueryProposalsByGroupPolicyResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPolicyInfoResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Info != nil {
+		return m.Info.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackGroupPolicies(m.GroupPolicies, unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPoliciesByAdminResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackGroupPolicies(m.GroupPolicies, unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Proposal != nil {
+		return m.Proposal.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalsByGroupPolicyResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, proposal := range m.Proposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unpackGroupPolicies(groupPolicies []*GroupPolicyInfo, unpacker types.AnyUnpacker) error {
+	for _, groupPolicy := range groupPolicies {
+		if err := groupPolicy.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
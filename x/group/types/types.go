@@ -0,0 +1,387 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MaxMetadataLen is the maximum length of the metadata attached to groups,
+// members, group policies, proposals and votes.
+const MaxMetadataLen = 255
+
+var (
+	_ codectypes.UnpackInterfacesMessage = GroupPolicyInfo{}
+	_ codectypes.UnpackInterfacesMessage = Proposal{}
+)
+
+// NewMember creates a new group member.
+// nolint: interfacer
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata string) Member {
+	return Member{
+		Address:  address.String(),
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs stateless validation of a member, a zero weight is
+// only allowed for member updates, where it removes the member from the
+// group.
+func (m Member) ValidateBasic(allowZeroWeight bool) error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "member weight must not be negative")
+	}
+	if m.Weight.IsZero() && !allowZeroWeight {
+		return sdkerrors.Wrap(ErrInvalid, "member weight must be positive")
+	}
+	return validateMetadata(m.Metadata)
+}
+
+// validateMembers performs stateless validation of a list of members, making
+// sure no member is given twice.
+func validateMembers(members []Member, allowZeroWeight bool) error {
+	index := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		if err := m.ValidateBasic(allowZeroWeight); err != nil {
+			return err
+		}
+		if _, ok := index[m.Address]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", m.Address)
+		}
+		index[m.Address] = struct{}{}
+	}
+	return nil
+}
+
+func validateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLen {
+		return sdkerrors.Wrapf(ErrMaxLimit, "metadata length must be at most %d", MaxMetadataLen)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a group info.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "total weight must not be negative")
+	}
+	return validateMetadata(g.Metadata)
+}
+
+// GroupPolicyAccountName returns the name of the module account backing the
+// group policy with the given sequence. The account address is derived from
+// this name, so group policy accounts can't have a public key and can only be
+// controlled through the group module.
+func GroupPolicyAccountName(seq uint64) string {
+	return fmt.Sprintf("%s/policy/%d", ModuleName, seq)
+}
+
+// DeriveGroupPolicyAddress returns the account address of the group policy
+// with the given sequence.
+func DeriveGroupPolicyAddress(seq uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(GroupPolicyAccountName(seq))
+}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo.
+// nolint: interfacer
+func NewGroupPolicyInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata string,
+	version uint64, decisionPolicy DecisionPolicy) (GroupPolicyInfo, error) {
+	p := GroupPolicyInfo{
+		Address:  address.String(),
+		GroupId:  groupID,
+		Admin:    admin.String(),
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	if err := p.SetDecisionPolicy(decisionPolicy); err != nil {
+		return GroupPolicyInfo{}, err
+	}
+
+	return p, nil
+}
+
+// SetDecisionPolicy converts the decision policy to any and sets it as the
+// group policy's decision policy.
+func (g *GroupPolicyInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the group policy,
+// or nil if it is not set.
+func (g GroupPolicyInfo) GetDecisionPolicy() DecisionPolicy {
+	return unpackDecisionPolicy(g.DecisionPolicy)
+}
+
+// ValidateBasic performs stateless validation of a group policy info.
+func (g GroupPolicyInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	policy := g.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateMetadata(g.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	m, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(m)
+}
+
+func unpackDecisionPolicy(any *codectypes.Any) DecisionPolicy {
+	if any == nil {
+		return nil
+	}
+	decisionPolicy, ok := any.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// DefaultTally returns a tally with no votes.
+func DefaultTally() Tally {
+	return Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+}
+
+// TotalCounts returns the weighted sum of all the votes.
+func (t Tally) TotalCounts() sdk.Dec {
+	return t.YesCount.Add(t.NoCount).Add(t.AbstainCount).Add(t.VetoCount)
+}
+
+// Add adds the weight of a vote with the given choice to the tally.
+func (t *Tally) Add(choice Choice, weight sdk.Dec) error {
+	switch choice {
+	case ChoiceYes:
+		t.YesCount = t.YesCount.Add(weight)
+	case ChoiceNo:
+		t.NoCount = t.NoCount.Add(weight)
+	case ChoiceAbstain:
+		t.AbstainCount = t.AbstainCount.Add(weight)
+	case ChoiceVeto:
+		t.VetoCount = t.VetoCount.Add(weight)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", choice)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a tally.
+func (t Tally) ValidateBasic() error {
+	for _, count := range []sdk.Dec{t.YesCount, t.NoCount, t.AbstainCount, t.VetoCount} {
+		if count.IsNil() || count.IsNegative() {
+			return sdkerrors.Wrap(ErrInvalid, "vote counts must not be negative")
+		}
+	}
+	return nil
+}
+
+// ValidChoice returns true if the choice is a valid voting choice.
+func ValidChoice(choice Choice) bool {
+	return choice == ChoiceYes || choice == ChoiceNo || choice == ChoiceAbstain || choice == ChoiceVeto
+}
+
+// ChoiceFromString returns a Choice from a string, either its full name, such
+// as CHOICE_YES, or its short name, such as yes.
+func ChoiceFromString(str string) (Choice, error) {
+	str = strings.ToUpper(str)
+	if !strings.HasPrefix(str, "CHOICE_") {
+		str = "CHOICE_" + str
+	}
+	choice, ok := Choice_value[str]
+	if !ok || !ValidChoice(Choice(choice)) {
+		return ChoiceUnspecified, sdkerrors.Wrapf(ErrInvalid, "'%s' is not a valid vote choice", str)
+	}
+	return Choice(choice), nil
+}
+
+// SetMsgs converts the service messages to anys and sets them as the
+// proposal's messages.
+func (p *Proposal) SetMsgs(msgs []sdk.ServiceMsg) error {
+	anys, err := packServiceMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Msgs = anys
+	return nil
+}
+
+// GetServiceMsgs returns the cached service messages of the proposal.
+func (p Proposal) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return unpackServiceMsgs(p.Msgs)
+}
+
+// ValidateBasic performs stateless validation of a proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy address (%s)", err)
+	}
+	if err := validateProposers(p.Proposers); err != nil {
+		return err
+	}
+	if p.GroupVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group version")
+	}
+	if p.GroupPolicyVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group policy version")
+	}
+	if p.Status == ProposalStatusInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "status")
+	}
+	if p.Result == ProposalResultInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "result")
+	}
+	if p.ExecutorResult == ProposalExecutorResultInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "executor result")
+	}
+	if err := p.VoteState.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := p.GetServiceMsgs(); err != nil {
+		return err
+	}
+	return validateMetadata(p.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackServiceMsgAnys(p.Msgs, unpacker)
+}
+
+// ValidateBasic performs stateless validation of a vote.
+func (v Vote) ValidateBasic() error {
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	if !ValidChoice(v.Choice) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid choice %s", v.Choice)
+	}
+	return validateMetadata(v.Metadata)
+}
+
+func validateProposers(proposers []string) error {
+	if len(proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	index := make(map[string]struct{}, len(proposers))
+	for _, proposer := range proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+		}
+		if _, ok := index[proposer]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		index[proposer] = struct{}{}
+	}
+	return nil
+}
+
+// isServiceMethodName returns true if the name is a fully-qualified Msg
+// service method name, such as /cosmos.bank.v1beta1.Msg/Send.
+func isServiceMethodName(methodName string) bool {
+	return strings.HasPrefix(methodName, "/") && strings.Count(methodName, "/") == 2 &&
+		!strings.HasSuffix(methodName, "/")
+}
+
+func packServiceMsgs(msgs []sdk.ServiceMsg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+func unpackServiceMsgs(anys []*codectypes.Any) ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(anys))
+	for i, any := range anys {
+		if !isServiceMethodName(any.TypeUrl) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a Msg service method", any.TypeUrl)
+		}
+		msgReq, ok := any.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", any)
+		}
+		msgs[i] = sdk.ServiceMsg{
+			MethodName: any.TypeUrl,
+			Request:    msgReq,
+		}
+	}
+	return msgs, nil
+}
+
+func unpackServiceMsgAnys(anys []*codectypes.Any, unpacker codectypes.AnyUnpacker) error {
+	for _, any := range anys {
+		// only Msg service requests can be executed by a group policy, so
+		// each Any's type URL must be a fully-qualified method name
+		if !isServiceMethodName(any.TypeUrl) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a Msg service method", any.TypeUrl)
+		}
+
+		var msg sdk.MsgRequest
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	testClassID = "kitties"
+	sendClassID = "puppies"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	owner   sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	// the NFTs are created at genesis, and owned by an account whose key is
+	// imported in the keyring of the validator once the network is started
+	ownerKey := secp256k1.GenPrivKey()
+	s.owner = sdk.AccAddress(ownerKey.PubKey().Address())
+
+	nftGenesis := types.NewGenesisState(
+		[]*types.Class{{Id: testClassID, Name: "Kitties"}, {Id: sendClassID, Name: "Puppies"}},
+		[]*types.Entry{{
+			Owner: s.owner.String(),
+			Nfts: []*types.NFT{
+				{ClassId: testClassID, Id: "kitty1", Uri: "kitty1.json"},
+				{ClassId: testClassID, Id: "kitty2", Uri: "kitty2.json"},
+				{ClassId: sendClassID, Id: "puppy1", Uri: "puppy1.json"},
+			},
+		}},
+		nil,
+	)
+	nftGenesisBz, err := cfg.Codec.MarshalJSON(nftGenesis)
+	s.Require().NoError(err)
+	cfg.GenesisState[types.ModuleName] = nftGenesisBz
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	armor := crypto.EncryptArmorPrivKey(ownerKey, "passphrase", string(hd.Secp256k1Type))
+	s.Require().NoError(val.ClientCtx.Keyring.ImportPrivKey("owner", armor, "passphrase"))
+
+	// the owner pays the fees of its sends
+	_, err = banktestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		s.owner,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(200))),
+		commonFlags(s.cfg)...,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func commonFlags(cfg network.Config) []string {
+	return []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+}
+
+// execQuery executes a query command with a json output.
+func (s *IntegrationTestSuite) execQuery(cmd *cobra.Command, args []string) ([]byte, error) {
+	val := s.network.Validators[0]
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag)))
+	return out.Bytes(), err
+}
+
+func (s *IntegrationTestSuite) TestQueryClass() {
+	val := s.network.Validators[0]
+
+	_, err := s.execQuery(cli.GetCmdQueryClass(), []string{"unknown"})
+	s.Require().Error(err)
+
+	out, err := s.execQuery(cli.GetCmdQueryClass(), []string{testClassID})
+	s.Require().NoError(err, string(out))
+	var class types.Class
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &class), string(out))
+	s.Require().Equal("Kitties", class.Name)
+
+	out, err = s.execQuery(cli.GetCmdQueryClasses(), nil)
+	s.Require().NoError(err, string(out))
+	var classesRes types.QueryClassesResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &classesRes), string(out))
+	s.Require().Len(classesRes.Classes, 2)
+}
+
+func (s *IntegrationTestSuite) TestQueryNFTs() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expCount  int
+	}{
+		{"no class nor owner", nil, true, 0},
+		{"by class", []string{fmt.Sprintf("--%s=%s", cli.FlagClassID, testClassID)}, false, 2},
+		{
+			"by class and owner",
+			[]string{fmt.Sprintf("--%s=%s", cli.FlagClassID, testClassID), fmt.Sprintf("--%s=%s", cli.FlagOwner, s.owner)},
+			false, 2,
+		},
+		{"by other owner", []string{fmt.Sprintf("--%s=%s", cli.FlagOwner, val.Address)}, false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := s.execQuery(cli.GetCmdQueryNFTs(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err, string(out))
+			var res types.QueryNFTsResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &res), string(out))
+			s.Require().Len(res.Nfts, tc.expCount)
+		})
+	}
+
+	out, err := s.execQuery(cli.GetCmdQueryNFT(), []string{testClassID, "kitty1"})
+	s.Require().NoError(err, string(out))
+	var nft types.NFT
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &nft), string(out))
+	s.Require().Equal("kitty1.json", nft.Uri)
+}
+
+func (s *IntegrationTestSuite) TestQueryOwnership() {
+	val := s.network.Validators[0]
+
+	out, err := s.execQuery(cli.GetCmdQueryOwner(), []string{testClassID, "kitty1"})
+	s.Require().NoError(err, string(out))
+	var ownerRes types.QueryOwnerResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &ownerRes), string(out))
+	s.Require().Equal(s.owner.String(), ownerRes.Owner)
+
+	out, err = s.execQuery(cli.GetCmdQueryBalance(), []string{s.owner.String(), testClassID})
+	s.Require().NoError(err, string(out))
+	var balanceRes types.QueryBalanceResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &balanceRes), string(out))
+	s.Require().Equal(uint64(2), balanceRes.Amount)
+
+	out, err = s.execQuery(cli.GetCmdQuerySupply(), []string{testClassID})
+	s.Require().NoError(err, string(out))
+	var supplyRes types.QuerySupplyResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &supplyRes), string(out))
+	s.Require().Equal(uint64(2), supplyRes.Amount)
+}
+
+func (s *IntegrationTestSuite) TestSend() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name    string
+		from    sdk.AccAddress
+		expCode uint32
+	}{
+		{"not the owner", val.Address, types.ErrUnauthorized.ABCICode()},
+		{"valid send", s.owner, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := append([]string{sendClassID, "puppy1", val.Address.String(), fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.from)}, commonFlags(s.cfg)...)
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdSend(), args)
+			s.Require().NoError(err, out.String())
+			var txResp sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expCode, txResp.Code, out.String())
+		})
+	}
+
+	out, err := s.execQuery(cli.GetCmdQueryOwner(), []string{sendClassID, "puppy1"})
+	s.Require().NoError(err, string(out))
+	var ownerRes types.QueryOwnerResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out, &ownerRes), string(out))
+	s.Require().Equal(val.Address.String(), ownerRes.Owner)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
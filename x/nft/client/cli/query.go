@@ -0,0 +1,274 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Flag names and values
+const (
+	FlagOwner   = "owner"
+	FlagClassID = "class-id"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	nftQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nft module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftQueryCmd.AddCommand(
+		GetCmdQueryClass(),
+		GetCmdQueryClasses(),
+		GetCmdQueryNFT(),
+		GetCmdQueryNFTs(),
+		GetCmdQueryOwner(),
+		GetCmdQueryBalance(),
+		GetCmdQuerySupply(),
+	)
+
+	return nftQueryCmd
+}
+
+// GetCmdQueryClass returns cmd to query for an nft class.
+func GetCmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an NFT class based on its id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Class(context.Background(), &types.QueryClassRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Class)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClasses returns cmd to query for all the nft classes.
+func GetCmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Args:  cobra.NoArgs,
+		Short: "Query all NFT classes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Classes(context.Background(), &types.QueryClassesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+	return cmd
+}
+
+// GetCmdQueryNFT returns cmd to query for an nft.
+func GetCmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft [class-id] [nft-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query an NFT based on its class and id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFT(context.Background(), &types.QueryNFTRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Nft)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryNFTs returns cmd to query for the nfts of a class or an owner.
+func GetCmdQueryNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts",
+		Args:  cobra.NoArgs,
+		Short: "Query all NFTs of a given class or owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all NFTs of a given class or owner, at least one of the two must be given.
+
+Example:
+$ %s query %s nfts --%s=kitties --%s=cosmos1skj..
+`, version.AppName, types.ModuleName, FlagClassID, FlagOwner),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			if classID == "" && owner == "" {
+				return fmt.Errorf("must provide at least one of --%s and --%s", FlagClassID, FlagOwner)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.NFTs(context.Background(), &types.QueryNFTsRequest{
+				ClassId:    classID,
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagClassID, "", "The class id of the NFTs")
+	cmd.Flags().String(FlagOwner, "", "The owner of the NFTs")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	return cmd
+}
+
+// GetCmdQueryOwner returns cmd to query for the owner of an nft.
+func GetCmdQueryOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner [class-id] [nft-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the owner of an NFT based on its class and id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Owner(context.Background(), &types.QueryOwnerRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBalance returns cmd to query for the number of nfts of a class
+// owned by an account.
+func GetCmdQueryBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [owner] [class-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the number of NFTs of a given class owned by the owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Balance(context.Background(), &types.QueryBalanceRequest{
+				Owner:   args[0],
+				ClassId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySupply returns cmd to query for the number of nfts of a class.
+func GetCmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the number of NFTs of a given class",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Supply(context.Background(), &types.QuerySupplyRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
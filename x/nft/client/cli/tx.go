@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	nftTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "NFT transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftTxCmd.AddCommand(
+		NewCmdSend(),
+	)
+
+	return nftTxCmd
+}
+
+// NewCmdSend returns a CLI command handler for creating a MsgSend transaction.
+func NewCmdSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [class-id] [nft-id] [receiver] --from [sender]",
+		Short: "Transfer ownership of an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer ownership of an NFT to the receiver, the sender must be the owner of the NFT.
+
+Example:
+$ %s tx %s send kitties kitty1 cosmos1skj.. --from cosmos1skl..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSend(args[0], args[1], clientCtx.GetFromAddress(), receiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
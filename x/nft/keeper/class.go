@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// saveClass defines a method for creating a new nft class, managed by the
+// given module. Classes created without a module can't be updated, and their
+// NFTs can't be minted, burned or updated.
+func (k Keeper) saveClass(ctx sdk.Context, class types.Class, module string) error {
+	if err := class.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+	if module != "" {
+		ctx.KVStore(k.storeKey).Set(types.GetClassModuleKey(class.Id), []byte(module))
+	}
+	return nil
+}
+
+// updateClass defines a method for updating an existing nft class.
+func (k Keeper) updateClass(ctx sdk.Context, class types.Class) error {
+	if !k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) {
+	bz := k.cdc.MustMarshalBinaryBare(&class)
+	ctx.KVStore(k.storeKey).Set(types.GetClassStoreKey(class.Id), bz)
+}
+
+// GetClass defines a method for returning the class information of the specified id
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, bool) {
+	var class types.Class
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassStoreKey(classID))
+	if bz == nil {
+		return class, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// GetClasses defines a method for returning all classes information
+func (k Keeper) GetClasses(ctx sdk.Context) (classes []*types.Class) {
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, &class)
+		return false
+	})
+	return
+}
+
+// IterateClasses iterates over all the classes, in the order of their IDs,
+// until the callback returns true.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+		if cb(class) {
+			break
+		}
+	}
+}
+
+// HasClass determines whether the specified classID exist
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetClassStoreKey(classID))
+}
+
+// GetClassModule returns the name of the module managing the class, it
+// returns false if the class isn't managed by any module.
+func (k Keeper) GetClassModule(ctx sdk.Context, classID string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassModuleKey(classID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// InitGenesis new nft genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	modules := make(map[string]string, len(data.ClassModules))
+	for _, classModule := range data.ClassModules {
+		modules[classModule.ClassId] = classModule.Module
+	}
+
+	for _, class := range data.Classes {
+		if err := k.saveClass(ctx, *class, modules[class.Id]); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.Entries {
+		owner, err := sdk.AccAddressFromBech32(entry.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, nft := range entry.Nfts {
+			if err := k.mint(ctx, *nft, owner); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	classes := k.GetClasses(ctx)
+
+	var classModules []*types.ClassModule
+	for _, class := range classes {
+		if module, found := k.GetClassModule(ctx, class.Id); found {
+			classModules = append(classModules, &types.ClassModule{ClassId: class.Id, Module: module})
+		}
+	}
+
+	// the NFTs are grouped by owner, the entries being ordered by the first NFT
+	// of each owner
+	var entries []*types.Entry
+	entriesByOwner := make(map[string]*types.Entry)
+	k.IterateNFTs(ctx, func(nft types.NFT) bool {
+		owner := k.GetOwner(ctx, nft.ClassId, nft.Id).String()
+		entry, found := entriesByOwner[owner]
+		if !found {
+			entry = &types.Entry{Owner: owner}
+			entriesByOwner[owner] = entry
+			entries = append(entries, entry)
+		}
+		entry.Nfts = append(entry.Nfts, &nft)
+		return false
+	})
+
+	return types.NewGenesisState(classes, entries, classModules)
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balance return the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
+func (k Keeper) Balance(goCtx context.Context, r *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	balance := k.GetBalance(ctx, r.ClassId, owner)
+	return &types.QueryBalanceResponse{Amount: balance}, nil
+}
+
+// Owner return the owner of the NFT based on its class and id, same as ownerOf in ERC721
+func (k Keeper) Owner(goCtx context.Context, r *types.QueryOwnerRequest) (*types.QueryOwnerResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateNFTID(r.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := k.GetOwner(ctx, r.ClassId, r.Id)
+	if owner.Empty() {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", r.Id, r.ClassId)
+	}
+	return &types.QueryOwnerResponse{Owner: owner.String()}, nil
+}
+
+// Supply return the number of NFTs from the given class, same as totalSupply of ERC721.
+func (k Keeper) Supply(goCtx context.Context, r *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	supply := k.GetTotalSupply(ctx, r.ClassId)
+	return &types.QuerySupplyResponse{Amount: supply}, nil
+}
+
+// NFTs return all NFTs of a given class or owner, at least one of the two
+// must be given.
+func (k Keeper) NFTs(goCtx context.Context, r *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if r.ClassId == "" && r.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "must provide at least one of the class id and the owner")
+	}
+
+	if r.ClassId != "" {
+		if err := types.ValidateClassID(r.ClassId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var (
+		owner sdk.AccAddress
+		err   error
+	)
+	if r.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(r.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+
+	var nfts []*types.NFT
+	var pageRes *query.PageResponse
+	switch {
+	case len(owner) > 0 && r.ClassId != "":
+		// the keys of the store are the IDs of the NFTs of the class
+		nftStore := prefix.NewStore(store, append(types.NFTOfClassByOwnerKey, types.GetNFTOfClassByOwnerStoreKey(owner, r.ClassId)...))
+		pageRes, err = query.Paginate(nftStore, r.Pagination, func(key []byte, _ []byte) error {
+			nft, has := k.GetNFT(ctx, r.ClassId, string(key))
+			if has {
+				nfts = append(nfts, &nft)
+			}
+			return nil
+		})
+
+	case len(owner) > 0:
+		// the keys of the store are made of the class and NFT IDs
+		nftStore := prefix.NewStore(store, append(types.NFTOfClassByOwnerKey, types.GetNFTByOwnerStoreKey(owner)...))
+		pageRes, err = query.Paginate(nftStore, r.Pagination, func(key []byte, _ []byte) error {
+			classID, nftID := types.ParseNFTStoreKey(key)
+			nft, has := k.GetNFT(ctx, classID, nftID)
+			if has {
+				nfts = append(nfts, &nft)
+			}
+			return nil
+		})
+
+	default:
+		nftStore := k.getNFTStore(ctx, r.ClassId)
+		pageRes, err = query.Paginate(nftStore, r.Pagination, func(_ []byte, value []byte) error {
+			var nft types.NFT
+			if err := k.cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+				return err
+			}
+			nfts = append(nfts, &nft)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNFTsResponse{
+		Nfts:       nfts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// NFT return an NFT based on its class and id.
+func (k Keeper) NFT(goCtx context.Context, r *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateNFTID(r.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	n, has := k.GetNFT(ctx, r.ClassId, r.Id)
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", r.Id, r.ClassId)
+	}
+	return &types.QueryNFTResponse{Nft: &n}, nil
+}
+
+// Class return an NFT class based on its id
+func (k Keeper) Class(goCtx context.Context, r *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "class %s not found", r.ClassId)
+	}
+	return &types.QueryClassResponse{Class: &class}, nil
+}
+
+// Classes return all NFT classes
+func (k Keeper) Classes(goCtx context.Context, r *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	classStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey)
+
+	var classes []*types.Class
+	pageRes, err := query.Paginate(classStore, r.Pagination, func(_ []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.UnmarshalBinaryBare(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, &class)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassesResponse{
+		Classes:    classes,
+		Pagination: pageRes,
+	}, nil
+}
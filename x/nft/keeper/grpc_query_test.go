@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+func (s *TestSuite) TestGRPCQueryClasses() {
+	queryClient := s.queryClient
+	ctx := gocontext.Background()
+
+	_, err := queryClient.Class(ctx, &types.QueryClassRequest{ClassId: testClassID})
+	s.Require().Error(err)
+
+	class := s.saveClass()
+	s.Require().NoError(s.scoped.SaveClass(s.ctx, types.Class{Id: "puppies"}))
+
+	classRes, err := queryClient.Class(ctx, &types.QueryClassRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(class, *classRes.Class)
+
+	_, err = queryClient.Class(ctx, &types.QueryClassRequest{ClassId: ""})
+	s.Require().Error(err)
+
+	classesRes, err := queryClient.Classes(ctx, &types.QueryClassesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(classesRes.Classes, 2)
+
+	classesRes, err = queryClient.Classes(ctx, &types.QueryClassesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(classesRes.Classes, 1)
+	s.Require().Equal(uint64(2), classesRes.Pagination.Total)
+}
+
+func (s *TestSuite) TestGRPCQueryNFTs() {
+	queryClient, addrs := s.queryClient, s.addrs
+	ctx := gocontext.Background()
+
+	s.saveClass()
+	s.Require().NoError(s.scoped.SaveClass(s.ctx, types.Class{Id: "puppies"}))
+	nft := s.mint(testNFTID, addrs[0])
+	s.mint("kitty2", addrs[0])
+	s.mint("kitty3", addrs[1])
+	s.Require().NoError(s.scoped.Mint(s.ctx, types.NFT{ClassId: "puppies", Id: "puppy1"}, addrs[0]))
+
+	nftRes, err := queryClient.NFT(ctx, &types.QueryNFTRequest{ClassId: testClassID, Id: testNFTID})
+	s.Require().NoError(err)
+	s.Require().Equal(nft, *nftRes.Nft)
+
+	_, err = queryClient.NFT(ctx, &types.QueryNFTRequest{ClassId: testClassID, Id: "kitty4"})
+	s.Require().Error(err)
+
+	ownerRes, err := queryClient.Owner(ctx, &types.QueryOwnerRequest{ClassId: testClassID, Id: "kitty3"})
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[1].String(), ownerRes.Owner)
+
+	balanceRes, err := queryClient.Balance(ctx, &types.QueryBalanceRequest{ClassId: testClassID, Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), balanceRes.Amount)
+
+	supplyRes, err := queryClient.Supply(ctx, &types.QuerySupplyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), supplyRes.Amount)
+
+	testCases := []struct {
+		msg      string
+		req      *types.QueryNFTsRequest
+		expErr   bool
+		expCount int
+	}{
+		{"no class nor owner", &types.QueryNFTsRequest{}, true, 0},
+		{"invalid owner", &types.QueryNFTsRequest{Owner: "invalid"}, true, 0},
+		{"by class", &types.QueryNFTsRequest{ClassId: testClassID}, false, 3},
+		{"by owner", &types.QueryNFTsRequest{Owner: addrs[0].String()}, false, 3},
+		{"by class and owner", &types.QueryNFTsRequest{ClassId: testClassID, Owner: addrs[0].String()}, false, 2},
+		{
+			"paginated",
+			&types.QueryNFTsRequest{ClassId: testClassID, Pagination: &query.PageRequest{Limit: 2}},
+			false, 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := queryClient.NFTs(ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(res.Nfts, tc.expCount)
+		})
+	}
+}
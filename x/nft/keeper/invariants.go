@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// RegisterInvariants registers the nft module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "owners", OwnersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the nft module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TotalSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return OwnersInvariant(k)(ctx)
+	}
+}
+
+// TotalSupplyInvariant checks that the total supply of every class is the
+// number of its NFTs.
+func TotalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		counts := make(map[string]uint64)
+		k.IterateNFTs(ctx, func(nft types.NFT) bool {
+			counts[nft.ClassId]++
+			return false
+		})
+
+		k.IterateClasses(ctx, func(class types.Class) bool {
+			supply := k.GetTotalSupply(ctx, class.Id)
+			if supply != counts[class.Id] {
+				count++
+				msg += fmt.Sprintf("\tclass %s has a total supply of %d but %d nfts\n", class.Id, supply, counts[class.Id])
+			}
+			delete(counts, class.Id)
+			return false
+		})
+
+		for classID := range counts {
+			count++
+			msg += fmt.Sprintf("\tclass %s has nfts but doesn't exist\n", classID)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-supply",
+			fmt.Sprintf("amount of classes with an invalid total supply found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// OwnersInvariant checks that every NFT has an owner, and that the index of
+// the NFTs by owner matches their owners.
+func OwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg       string
+			count     int
+			nftsCount int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateNFTs(ctx, func(nft types.NFT) bool {
+			nftsCount++
+			owner := k.GetOwner(ctx, nft.ClassId, nft.Id)
+			if owner.Empty() {
+				count++
+				msg += fmt.Sprintf("\tnft %s of class %s has no owner\n", nft.Id, nft.ClassId)
+			} else if !store.Has(types.GetNFTOfClassByOwnerKey(owner, nft.ClassId, nft.Id)) {
+				count++
+				msg += fmt.Sprintf("\tnft %s of class %s is not indexed for its owner %s\n", nft.Id, nft.ClassId, owner)
+			}
+			return false
+		})
+
+		indexCount := 0
+		iterator := sdk.KVStorePrefixIterator(store, types.NFTOfClassByOwnerKey)
+		for ; iterator.Valid(); iterator.Next() {
+			indexCount++
+		}
+		iterator.Close()
+
+		if indexCount != nftsCount {
+			count++
+			msg += fmt.Sprintf("\t%d nfts are indexed by owner but %d exist\n", indexCount, nftsCount)
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "owners",
+			fmt.Sprintf("amount of nft ownership errors found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Keeper of the nft store. It gives read access to the classes and the NFTs,
+// and lets their owners transfer NFTs. Classes are created and NFTs are
+// minted, burned and updated through the ScopedKeeper of a module.
+type Keeper struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+
+	// scopedModules is shared by all the copies of the keeper, it records the
+	// modules which were given a ScopedKeeper
+	scopedModules map[string]struct{}
+}
+
+// NewKeeper creates a new nft Keeper instance
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		scopedModules: make(map[string]struct{}),
+	}
+}
+
+// ScopeToModule returns a ScopedKeeper for the given module, which can
+// create classes and manage the NFTs of the classes it created. It panics if
+// the module name is empty or if a ScopedKeeper was already created for it.
+func (k Keeper) ScopeToModule(moduleName string) ScopedKeeper {
+	if strings.TrimSpace(moduleName) == "" {
+		panic("cannot scope to an empty module name")
+	}
+
+	if _, ok := k.scopedModules[moduleName]; ok {
+		panic(fmt.Sprintf("cannot create multiple scoped keepers for the same module name: %s", moduleName))
+	}
+
+	k.scopedModules[moduleName] = struct{}{}
+
+	return ScopedKeeper{
+		keeper: k,
+		module: moduleName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
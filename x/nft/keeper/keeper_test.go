@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	testModule  = "test"
+	testClassID = "kitties"
+	testNFTID   = "kitty1"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	scoped      keeper.ScopedKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.NFTKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.scoped = app.NFTKeeper.ScopeToModule(testModule)
+	s.msgServer = keeper.NewMsgServerImpl(app.NFTKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+// saveClass creates the test class, managed by the test module.
+func (s *TestSuite) saveClass() types.Class {
+	class := types.Class{
+		Id:     testClassID,
+		Name:   "Kitties",
+		Symbol: "KIT",
+		Uri:    "https://kitties.example",
+	}
+	s.Require().NoError(s.scoped.SaveClass(s.ctx, class))
+	return class
+}
+
+// mint mints an nft of the test class to the owner.
+func (s *TestSuite) mint(id string, owner sdk.AccAddress) types.NFT {
+	nft := types.NFT{
+		ClassId: testClassID,
+		Id:      id,
+		Uri:     "https://kitties.example/" + id,
+	}
+	s.Require().NoError(s.scoped.Mint(s.ctx, nft, owner))
+	return nft
+}
+
+func (s *TestSuite) TestScopeToModule() {
+	k := s.app.NFTKeeper
+	s.Require().Panics(func() { k.ScopeToModule("") })
+	s.Require().Panics(func() { k.ScopeToModule(testModule) })
+	s.Require().Equal("other", k.ScopeToModule("other").Module())
+}
+
+func (s *TestSuite) TestSaveClass() {
+	k := s.app.NFTKeeper
+	class := s.saveClass()
+
+	actual, found := k.GetClass(s.ctx, testClassID)
+	s.Require().True(found)
+	s.Require().Equal(class, actual)
+	s.Require().True(k.HasClass(s.ctx, testClassID))
+	s.Require().Len(k.GetClasses(s.ctx), 1)
+
+	module, found := k.GetClassModule(s.ctx, testClassID)
+	s.Require().True(found)
+	s.Require().Equal(testModule, module)
+
+	// classes can't be saved twice, even by another module
+	s.Require().ErrorIs(s.scoped.SaveClass(s.ctx, class), types.ErrClassExists)
+	s.Require().ErrorIs(k.ScopeToModule("other").SaveClass(s.ctx, class), types.ErrClassExists)
+
+	s.Require().Error(s.scoped.SaveClass(s.ctx, types.Class{Id: "1invalid"}))
+}
+
+func (s *TestSuite) TestUpdateClass() {
+	k := s.app.NFTKeeper
+	class := s.saveClass()
+
+	class.Description = "cute kitties"
+	s.Require().NoError(s.scoped.UpdateClass(s.ctx, class))
+	actual, _ := k.GetClass(s.ctx, testClassID)
+	s.Require().Equal("cute kitties", actual.Description)
+
+	s.Require().ErrorIs(k.ScopeToModule("other").UpdateClass(s.ctx, class), types.ErrUnauthorized)
+	s.Require().ErrorIs(s.scoped.UpdateClass(s.ctx, types.Class{Id: "puppies"}), types.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestMint() {
+	k := s.app.NFTKeeper
+	owner := s.addrs[0]
+
+	// the class must exist
+	s.Require().ErrorIs(s.scoped.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: testNFTID}, owner), types.ErrClassNotExists)
+
+	s.saveClass()
+	data, err := codectypes.NewAnyWithValue(&types.Class{Id: "metadata"})
+	s.Require().NoError(err)
+	nft := types.NFT{ClassId: testClassID, Id: testNFTID, Uri: "kitty1.json", Data: data}
+	s.Require().NoError(s.scoped.Mint(s.ctx, nft, owner))
+
+	actual, found := k.GetNFT(s.ctx, testClassID, testNFTID)
+	s.Require().True(found)
+	s.Require().Equal(nft.Uri, actual.Uri)
+	s.Require().Equal(data.Value, actual.Data.Value)
+	s.Require().Equal(owner, k.GetOwner(s.ctx, testClassID, testNFTID))
+	s.Require().Equal(uint64(1), k.GetBalance(s.ctx, testClassID, owner))
+	s.Require().Equal(uint64(1), k.GetTotalSupply(s.ctx, testClassID))
+	s.Require().Len(k.GetNFTsOfClassByOwner(s.ctx, testClassID, owner), 1)
+
+	// nfts can't be minted twice
+	s.Require().ErrorIs(s.scoped.Mint(s.ctx, nft, s.addrs[1]), types.ErrNFTExists)
+
+	// only the module managing the class can mint
+	other := k.ScopeToModule("other")
+	s.Require().ErrorIs(other.Mint(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty2"}, owner), types.ErrUnauthorized)
+	s.Require().False(k.HasNFT(s.ctx, testClassID, "kitty2"))
+}
+
+func (s *TestSuite) TestBurn() {
+	k := s.app.NFTKeeper
+	owner := s.addrs[0]
+	s.saveClass()
+	s.mint(testNFTID, owner)
+	s.mint("kitty2", owner)
+
+	s.Require().ErrorIs(k.ScopeToModule("other").Burn(s.ctx, testClassID, testNFTID), types.ErrUnauthorized)
+	s.Require().NoError(s.scoped.Burn(s.ctx, testClassID, testNFTID))
+
+	s.Require().False(k.HasNFT(s.ctx, testClassID, testNFTID))
+	s.Require().True(k.GetOwner(s.ctx, testClassID, testNFTID).Empty())
+	s.Require().Equal(uint64(1), k.GetBalance(s.ctx, testClassID, owner))
+	s.Require().Equal(uint64(1), k.GetTotalSupply(s.ctx, testClassID))
+
+	s.Require().ErrorIs(s.scoped.Burn(s.ctx, testClassID, testNFTID), types.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestUpdate() {
+	k := s.app.NFTKeeper
+	s.saveClass()
+	nft := s.mint(testNFTID, s.addrs[0])
+
+	nft.Uri = "kitty1-v2.json"
+	s.Require().NoError(s.scoped.Update(s.ctx, nft))
+	actual, _ := k.GetNFT(s.ctx, testClassID, testNFTID)
+	s.Require().Equal("kitty1-v2.json", actual.Uri)
+
+	s.Require().ErrorIs(k.ScopeToModule("other").Update(s.ctx, nft), types.ErrUnauthorized)
+	s.Require().ErrorIs(s.scoped.Update(s.ctx, types.NFT{ClassId: testClassID, Id: "kitty2"}), types.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestSend() {
+	k := s.app.NFTKeeper
+	sender, receiver := s.addrs[0], s.addrs[1]
+	s.saveClass()
+	s.mint(testNFTID, sender)
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// only the owner can send the nft
+	_, err := s.msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, receiver, s.addrs[2]))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Send(goCtx, types.NewMsgSend(testClassID, "kitty2", sender, receiver))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, sender, receiver))
+	s.Require().NoError(err)
+
+	s.Require().Equal(receiver, k.GetOwner(s.ctx, testClassID, testNFTID))
+	s.Require().Equal(uint64(0), k.GetBalance(s.ctx, testClassID, sender))
+	s.Require().Equal(uint64(1), k.GetBalance(s.ctx, testClassID, receiver))
+	s.Require().Equal(uint64(1), k.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestInvariants() {
+	k := s.app.NFTKeeper
+	s.saveClass()
+	s.mint(testNFTID, s.addrs[0])
+	s.mint("kitty2", s.addrs[1])
+
+	_, broken := keeper.AllInvariants(k)(s.ctx)
+	s.Require().False(broken)
+
+	// break the total supply
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set(types.GetClassTotalSupplyKey(testClassID), sdk.Uint64ToBigEndian(1))
+	_, broken = keeper.TotalSupplyInvariant(k)(s.ctx)
+	s.Require().True(broken)
+
+	// break the index of the nfts by owner
+	store.Delete(types.GetNFTOfClassByOwnerKey(s.addrs[0], testClassID, testNFTID))
+	_, broken = keeper.OwnersInvariant(k)(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *TestSuite) TestGenesis() {
+	k := s.app.NFTKeeper
+	s.saveClass()
+	s.mint(testNFTID, s.addrs[0])
+	s.mint("kitty2", s.addrs[0])
+	s.mint("kitty3", s.addrs[1])
+
+	genesis := k.ExportGenesis(s.ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+	s.Require().Len(genesis.Classes, 1)
+	s.Require().Len(genesis.Entries, 2)
+	s.Require().Equal([]*types.ClassModule{{ClassId: testClassID, Module: testModule}}, genesis.ClassModules)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.NFTKeeper.InitGenesis(ctx, genesis)
+	s.Require().Equal(genesis, app.NFTKeeper.ExportGenesis(ctx))
+	s.Require().Equal(uint64(3), app.NFTKeeper.GetTotalSupply(ctx, testClassID))
+	s.Require().Equal(uint64(2), app.NFTKeeper.GetBalance(ctx, testClassID, s.addrs[0]))
+
+	// the class is still managed by the module after the import
+	scoped := app.NFTKeeper.ScopeToModule(testModule)
+	s.Require().NoError(scoped.Burn(ctx, testClassID, "kitty3"))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
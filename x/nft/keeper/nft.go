@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// mint defines a method for minting a new nft
+func (k Keeper) mint(ctx sdk.Context, nft types.NFT, receiver sdk.AccAddress) error {
+	if err := nft.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, nft.ClassId) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, nft.ClassId)
+	}
+	if k.HasNFT(ctx, nft.ClassId, nft.Id) {
+		return sdkerrors.Wrap(types.ErrNFTExists, nft.Id)
+	}
+
+	k.setNFT(ctx, nft)
+	k.setOwner(ctx, nft.ClassId, nft.Id, receiver)
+	k.incrTotalSupply(ctx, nft.ClassId)
+	return nil
+}
+
+// burn defines a method for burning a nft from a specific account.
+func (k Keeper) burn(ctx sdk.Context, classID string, nftID string) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, classID)
+	}
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(types.ErrNFTNotExists, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNFTKey(classID, nftID))
+	store.Delete(types.GetOwnerStoreKey(classID, nftID))
+	store.Delete(types.GetNFTOfClassByOwnerKey(owner, classID, nftID))
+	k.decrTotalSupply(ctx, classID)
+	return nil
+}
+
+// update defines a method for updating an exist nft
+func (k Keeper) update(ctx sdk.Context, nft types.NFT) error {
+	if !k.HasClass(ctx, nft.ClassId) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, nft.ClassId)
+	}
+	if !k.HasNFT(ctx, nft.ClassId, nft.Id) {
+		return sdkerrors.Wrap(types.ErrNFTNotExists, nft.Id)
+	}
+
+	k.setNFT(ctx, nft)
+	return nil
+}
+
+// Transfer defines a method for sending a nft from one account to another account.
+func (k Keeper) Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, classID)
+	}
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(types.ErrNFTNotExists, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	ctx.KVStore(k.storeKey).Delete(types.GetNFTOfClassByOwnerKey(owner, classID, nftID))
+	k.setOwner(ctx, classID, nftID, receiver)
+	return nil
+}
+
+// GetNFT returns the nft information of the specified classID and nftID
+func (k Keeper) GetNFT(ctx sdk.Context, classID, nftID string) (types.NFT, bool) {
+	var nft types.NFT
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNFTKey(classID, nftID))
+	if bz == nil {
+		return nft, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &nft)
+	return nft, true
+}
+
+// GetNFTsOfClassByOwner returns all nft information of the specified classID under the specified owner
+func (k Keeper) GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []types.NFT) {
+	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
+	iterator := ownerStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if nft, has := k.GetNFT(ctx, classID, string(iterator.Key())); has {
+			nfts = append(nfts, nft)
+		}
+	}
+	return nfts
+}
+
+// GetNFTsOfClass returns all nft information under the specified classID
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []types.NFT) {
+	nftStore := k.getNFTStore(ctx, classID)
+	iterator := nftStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
+
+// IterateNFTs iterates over all the NFTs, ordered by class, until the
+// callback returns true.
+func (k Keeper) IterateNFTs(ctx sdk.Context, cb func(nft types.NFT) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		if cb(nft) {
+			break
+		}
+	}
+}
+
+// GetOwner returns the owner information of the specified nft
+func (k Keeper) GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress {
+	return sdk.AccAddress(ctx.KVStore(k.storeKey).Get(types.GetOwnerStoreKey(classID, nftID)))
+}
+
+// GetBalance returns the specified account, the number of all nfts under the specified classID
+func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	iterator := k.getClassStoreByOwner(ctx, owner, classID).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var balance uint64
+	for ; iterator.Valid(); iterator.Next() {
+		balance++
+	}
+	return balance
+}
+
+// GetTotalSupply returns the number of all nfts under the specified classID
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassTotalSupplyKey(classID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// HasNFT determines whether the specified classID and nftID exist
+func (k Keeper) HasNFT(ctx sdk.Context, classID, id string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetNFTKey(classID, id))
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	bz := k.cdc.MustMarshalBinaryBare(&nft)
+	ctx.KVStore(k.storeKey).Set(types.GetNFTKey(nft.ClassId, nft.Id), bz)
+}
+
+func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOwnerStoreKey(classID, nftID), owner.Bytes())
+	store.Set(types.GetNFTOfClassByOwnerKey(owner, classID, nftID), []byte{})
+}
+
+func (k Keeper) incrTotalSupply(ctx sdk.Context, classID string) {
+	supply := k.GetTotalSupply(ctx, classID) + 1
+	k.updateTotalSupply(ctx, classID, supply)
+}
+
+func (k Keeper) decrTotalSupply(ctx sdk.Context, classID string) {
+	supply := k.GetTotalSupply(ctx, classID) - 1
+	k.updateTotalSupply(ctx, classID, supply)
+}
+
+func (k Keeper) updateTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetClassTotalSupplyKey(classID), sdk.Uint64ToBigEndian(supply))
+}
+
+func (k Keeper) getNFTStore(ctx sdk.Context, classID string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(types.NFTKey, types.GetNFTStoreKey(classID)...))
+}
+
+func (k Keeper) getClassStoreByOwner(ctx sdk.Context, owner sdk.AccAddress, classID string) prefix.Store {
+	key := append(types.NFTOfClassByOwnerKey, types.GetNFTOfClassByOwnerStoreKey(owner, classID)...)
+	return prefix.NewStore(ctx.KVStore(k.storeKey), key)
+}
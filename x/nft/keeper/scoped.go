@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// ScopedKeeper gives a module the right to create nft classes, and to mint,
+// burn and update the NFTs of the classes it created. A ScopedKeeper is
+// obtained with Keeper#ScopeToModule.
+type ScopedKeeper struct {
+	keeper Keeper
+	module string
+}
+
+// Module returns the name of the module the keeper is scoped to.
+func (sk ScopedKeeper) Module() string {
+	return sk.module
+}
+
+// SaveClass creates a new nft class managed by the module.
+func (sk ScopedKeeper) SaveClass(ctx sdk.Context, class types.Class) error {
+	return sk.keeper.saveClass(ctx, class, sk.module)
+}
+
+// UpdateClass updates a class managed by the module.
+func (sk ScopedKeeper) UpdateClass(ctx sdk.Context, class types.Class) error {
+	if err := sk.authorize(ctx, class.Id); err != nil {
+		return err
+	}
+	return sk.keeper.updateClass(ctx, class)
+}
+
+// Mint mints a new nft of a class managed by the module to the receiver.
+func (sk ScopedKeeper) Mint(ctx sdk.Context, nft types.NFT, receiver sdk.AccAddress) error {
+	if err := sk.authorize(ctx, nft.ClassId); err != nil {
+		return err
+	}
+	if err := sk.keeper.mint(ctx, nft, receiver); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassId),
+			sdk.NewAttribute(types.AttributeKeyID, nft.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, receiver.String()),
+		),
+	)
+	return nil
+}
+
+// Burn burns an nft of a class managed by the module.
+func (sk ScopedKeeper) Burn(ctx sdk.Context, classID string, nftID string) error {
+	if err := sk.authorize(ctx, classID); err != nil {
+		return err
+	}
+
+	owner := sk.keeper.GetOwner(ctx, classID, nftID)
+	if err := sk.keeper.burn(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyID, nftID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+	return nil
+}
+
+// Update updates the uri and the data of an nft of a class managed by the
+// module.
+func (sk ScopedKeeper) Update(ctx sdk.Context, nft types.NFT) error {
+	if err := sk.authorize(ctx, nft.ClassId); err != nil {
+		return err
+	}
+	return sk.keeper.update(ctx, nft)
+}
+
+// authorize makes sure the class is managed by the module.
+func (sk ScopedKeeper) authorize(ctx sdk.Context, classID string) error {
+	if !sk.keeper.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, classID)
+	}
+
+	module, found := sk.keeper.GetClassModule(ctx, classID)
+	if !found || module != sk.module {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "class %s is not managed by module %s", classID, sk.module)
+	}
+	return nil
+}
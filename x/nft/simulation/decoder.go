@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding nft type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ClassKey):
+			var classA, classB types.Class
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &classA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+
+		case bytes.Equal(kvA.Key[:1], types.NFTKey):
+			var nftA, nftB types.NFT
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &nftA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &nftB)
+			return fmt.Sprintf("%v\n%v", nftA, nftB)
+
+		case bytes.Equal(kvA.Key[:1], types.NFTOfClassByOwnerKey):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.Equal(kvA.Key[:1], types.OwnerKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ClassTotalSupply):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ClassModuleKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var ownerAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := types.Class{Id: "kitties", Name: "Kitties"}
+	classBz, err := cdc.MarshalBinaryBare(&class)
+	require.NoError(t, err)
+
+	nft := types.NFT{ClassId: "kitties", Id: "kitty1", Uri: "kitty1.json"}
+	nftBz, err := cdc.MarshalBinaryBare(&nft)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetClassStoreKey("kitties"), Value: classBz},
+			{Key: types.GetNFTKey("kitties", "kitty1"), Value: nftBz},
+			{Key: types.GetOwnerStoreKey("kitties", "kitty1"), Value: ownerAddr},
+			{Key: types.GetClassTotalSupplyKey("kitties"), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: types.GetClassModuleKey("kitties"), Value: []byte("test")},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Class", false, fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", false, fmt.Sprintf("%v\n%v", nft, nft)},
+		{"Owner", false, fmt.Sprintf("%v\n%v", ownerAddr, ownerAddr)},
+		{"TotalSupply", false, "3\n3"},
+		{"ClassModule", false, "test\ntest"},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// genClasses returns randomly generated nft classes, none of them being
+// managed by a module.
+func genClasses(r *rand.Rand) []*types.Class {
+	numClasses := simtypes.RandIntBetween(r, 1, 4)
+	classes := make([]*types.Class, numClasses)
+	for i := 0; i < numClasses; i++ {
+		classes[i] = &types.Class{
+			Id:          fmt.Sprintf("class%d", i),
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 3),
+			Description: simtypes.RandStringOfLength(r, 20),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+		}
+	}
+
+	return classes
+}
+
+// genEntries returns randomly generated NFTs of the given classes, owned by
+// random accounts.
+func genEntries(r *rand.Rand, classes []*types.Class, accounts []simtypes.Account) []*types.Entry {
+	var entries []*types.Entry
+	entriesByOwner := make(map[string]*types.Entry)
+
+	for _, class := range classes {
+		numNFTs := r.Intn(10)
+		for i := 0; i < numNFTs; i++ {
+			acc, _ := simtypes.RandomAcc(r, accounts)
+			owner := acc.Address.String()
+
+			entry, found := entriesByOwner[owner]
+			if !found {
+				entry = &types.Entry{Owner: owner}
+				entriesByOwner[owner] = entry
+				entries = append(entries, entry)
+			}
+
+			entry.Nfts = append(entry.Nfts, &types.NFT{
+				ClassId: class.Id,
+				Id:      fmt.Sprintf("nft%d", i),
+				Uri:     simtypes.RandStringOfLength(r, 10),
+			})
+		}
+	}
+
+	return entries
+}
+
+// RandomizedGenState generates a random GenesisState for nft
+func RandomizedGenState(simState *module.SimulationState) {
+	classes := genClasses(simState.Rand)
+	entries := genEntries(simState.Rand, classes, simState.Accounts)
+
+	nftGenesis := types.NewGenesisState(classes, entries, nil)
+
+	bz, err := simState.Cdc.MarshalJSON(nftGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = bz
+}
@@ -0,0 +1,120 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend = "op_weight_msg_send_nft"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgSend int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = simappparams.DefaultWeightMsgSendNFT
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSend generates a MsgSend sending a random NFT owned by one of
+// the simulation accounts to another random account.
+// nolint: interfacer
+func SimulateMsgSend(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		nft, sender, found := randomNFT(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSend, "no nft owned by a simulation account"), nil, nil
+		}
+
+		if len(accs) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSend, "not enough accounts"), nil, nil
+		}
+
+		// pick the receiver among the other accounts
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		for receiver.Address.Equals(sender.Address) {
+			receiver, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		msg := types.NewMsgSend(nft.ClassId, nft.Id, sender.Address, receiver.Address)
+		return genAndDeliverTx(r, app, ctx, ak, bk, msg, sender, chainID)
+	}
+}
+
+// randomNFT returns a random NFT of a random class, with its owner if it is
+// one of the simulation accounts.
+func randomNFT(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.NFT, simtypes.Account, bool) {
+	classes := k.GetClasses(ctx)
+	if len(classes) == 0 {
+		return types.NFT{}, simtypes.Account{}, false
+	}
+
+	class := classes[r.Intn(len(classes))]
+	nfts := k.GetNFTsOfClass(ctx, class.Id)
+	if len(nfts) == 0 {
+		return types.NFT{}, simtypes.Account{}, false
+	}
+
+	nft := nfts[r.Intn(len(nfts))]
+	owner, found := simtypes.FindAccount(accs, k.GetOwner(ctx, nft.ClassId, nft.Id))
+	return nft, owner, found
+}
+
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	msg sdk.Msg, signer simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, signer.Address))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "fee error"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(classes []*Class, entries []*Entry, classModules []*ClassModule) *GenesisState {
+	return &GenesisState{
+		Classes:      classes,
+		Entries:      entries,
+		ClassModules: classModules,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis checks that the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	classes := make(map[string]bool, len(data.Classes))
+	for _, class := range data.Classes {
+		if class == nil {
+			return sdkerrors.Wrap(ErrInvalidGenesis, "nil class")
+		}
+		if err := class.ValidateBasic(); err != nil {
+			return err
+		}
+		if classes[class.Id] {
+			return sdkerrors.Wrapf(ErrClassExists, "duplicate class %s", class.Id)
+		}
+		classes[class.Id] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, entry := range data.Entries {
+		if entry == nil {
+			return sdkerrors.Wrap(ErrInvalidGenesis, "nil entry")
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return err
+		}
+		for _, nft := range entry.Nfts {
+			if nft == nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "nil nft of %s", entry.Owner)
+			}
+			if err := nft.ValidateBasic(); err != nil {
+				return err
+			}
+			if !classes[nft.ClassId] {
+				return sdkerrors.Wrap(ErrClassNotExists, nft.ClassId)
+			}
+			key := string(GetNFTKey(nft.ClassId, nft.Id))
+			if nfts[key] {
+				return sdkerrors.Wrapf(ErrNFTExists, "duplicate nft %s of class %s", nft.Id, nft.ClassId)
+			}
+			nfts[key] = true
+		}
+	}
+
+	classModules := make(map[string]bool, len(data.ClassModules))
+	for _, classModule := range data.ClassModules {
+		if classModule == nil {
+			return sdkerrors.Wrap(ErrInvalidGenesis, "nil class module")
+		}
+		if !classes[classModule.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, classModule.ClassId)
+		}
+		if classModule.Module == "" {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "empty module of class %s", classModule.ClassId)
+		}
+		if classModules[classModule.ClassId] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate module of class %s", classModule.ClassId)
+		}
+		classModules[classModule.ClassId] = true
+	}
+
+	return nil
+}
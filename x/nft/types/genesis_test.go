@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	class := &types.Class{Id: "kitties"}
+	nft := &types.NFT{ClassId: "kitties", Id: "kitty1"}
+	owner := sender.String()
+
+	tests := []struct {
+		title      string
+		genesis    *types.GenesisState
+		expectPass bool
+	}{
+		{"default genesis", types.DefaultGenesisState(), true},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]*types.Class{class},
+				[]*types.Entry{{Owner: owner, Nfts: []*types.NFT{nft}}},
+				[]*types.ClassModule{{ClassId: "kitties", Module: "test"}},
+			),
+			true,
+		},
+		{"invalid class id", types.NewGenesisState([]*types.Class{{Id: "1kitties"}}, nil, nil), false},
+		{"duplicate classes", types.NewGenesisState([]*types.Class{class, class}, nil, nil), false},
+		{
+			"invalid owner",
+			types.NewGenesisState([]*types.Class{class}, []*types.Entry{{Owner: "invalid", Nfts: []*types.NFT{nft}}}, nil),
+			false,
+		},
+		{
+			"nft of unknown class",
+			types.NewGenesisState(nil, []*types.Entry{{Owner: owner, Nfts: []*types.NFT{nft}}}, nil),
+			false,
+		},
+		{
+			"duplicate nfts",
+			types.NewGenesisState(
+				[]*types.Class{class},
+				[]*types.Entry{{Owner: owner, Nfts: []*types.NFT{nft}}, {Owner: receiver.String(), Nfts: []*types.NFT{nft}}},
+				nil,
+			),
+			false,
+		},
+		{
+			"module of unknown class",
+			types.NewGenesisState(nil, nil, []*types.ClassModule{{ClassId: "kitties", Module: "test"}}),
+			false,
+		},
+		{
+			"empty module",
+			types.NewGenesisState([]*types.Class{class}, nil, []*types.ClassModule{{ClassId: "kitties"}}),
+			false,
+		},
+		{
+			"duplicate class modules",
+			types.NewGenesisState(
+				[]*types.Class{class}, nil,
+				[]*types.ClassModule{{ClassId: "kitties", Module: "test"}, {ClassId: "kitties", Module: "other"}},
+			),
+			false,
+		},
+	}
+	for _, tc := range tests {
+		err := types.ValidateGenesis(*tc.genesis)
+		if tc.expectPass {
+			require.NoError(t, err, tc.title)
+		} else {
+			require.Error(t, err, tc.title)
+		}
+	}
+}
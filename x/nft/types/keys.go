@@ -0,0 +1,119 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "nft"
+
+	// StoreKey is the store key string for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for nft
+	QuerierRoute = ModuleName
+)
+
+// Keys for nft store
+// Items are stored with the following key: values
+//
+// - 0x01<classID_Bytes>: Class
+// - 0x02<classID_Bytes_Len><classID_Bytes><nftID_Bytes>: NFT
+// - 0x03<ownerAddress_Bytes_Len><ownerAddress_Bytes><classID_Bytes_Len><classID_Bytes><nftID_Bytes>: []byte{}
+// - 0x04<classID_Bytes_Len><classID_Bytes><nftID_Bytes>: ownerAddress_Bytes
+// - 0x05<classID_Bytes>: BigEndian(totalSupply)
+// - 0x06<classID_Bytes>: moduleName_Bytes
+var (
+	// ClassKey is the prefix for the classes
+	ClassKey = []byte{0x01}
+	// NFTKey is the prefix for the NFTs, stored by class
+	NFTKey = []byte{0x02}
+	// NFTOfClassByOwnerKey is the prefix for the index of the NFTs by owner
+	// and class
+	NFTOfClassByOwnerKey = []byte{0x03}
+	// OwnerKey is the prefix for the owners of the NFTs
+	OwnerKey = []byte{0x04}
+	// ClassTotalSupply is the prefix for the total supply of the classes
+	ClassTotalSupply = []byte{0x05}
+	// ClassModuleKey is the prefix for the modules which manage the classes
+	ClassModuleKey = []byte{0x06}
+)
+
+// lengthPrefix prefixes the given bytes with their length, so that keys
+// made of several variable length parts can't collide.
+func lengthPrefix(bz []byte) []byte {
+	if len(bz) > 255 {
+		panic("length prefixed bytes can't be longer than 255 bytes")
+	}
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
+// GetClassStoreKey returns the store key of a class.
+func GetClassStoreKey(classID string) []byte {
+	return append(ClassKey, []byte(classID)...)
+}
+
+// GetNFTStoreKey returns the store key prefix of the NFTs of a class, relative
+// to NFTKey.
+func GetNFTStoreKey(classID string) []byte {
+	return lengthPrefix([]byte(classID))
+}
+
+// GetNFTKey returns the store key of an NFT.
+func GetNFTKey(classID, nftID string) []byte {
+	return append(append(NFTKey, GetNFTStoreKey(classID)...), []byte(nftID)...)
+}
+
+// GetNFTOfClassByOwnerStoreKey returns the store key prefix of the NFTs of a
+// class owned by the owner, relative to NFTOfClassByOwnerKey.
+func GetNFTOfClassByOwnerStoreKey(owner sdk.AccAddress, classID string) []byte {
+	return append(GetNFTByOwnerStoreKey(owner), lengthPrefix([]byte(classID))...)
+}
+
+// GetNFTByOwnerStoreKey returns the store key prefix of the NFTs owned by the
+// owner, relative to NFTOfClassByOwnerKey.
+func GetNFTByOwnerStoreKey(owner sdk.AccAddress) []byte {
+	return lengthPrefix(owner)
+}
+
+// GetNFTOfClassByOwnerKey returns the store key of the index entry of an NFT
+// by its owner.
+func GetNFTOfClassByOwnerKey(owner sdk.AccAddress, classID, nftID string) []byte {
+	return append(append(NFTOfClassByOwnerKey, GetNFTOfClassByOwnerStoreKey(owner, classID)...), []byte(nftID)...)
+}
+
+// GetOwnerStoreKey returns the store key of the owner of an NFT.
+func GetOwnerStoreKey(classID, nftID string) []byte {
+	return append(append(OwnerKey, lengthPrefix([]byte(classID))...), []byte(nftID)...)
+}
+
+// GetClassTotalSupplyKey returns the store key of the total supply of a class.
+func GetClassTotalSupplyKey(classID string) []byte {
+	return append(ClassTotalSupply, []byte(classID)...)
+}
+
+// GetClassModuleKey returns the store key of the module managing a class.
+func GetClassModuleKey(classID string) []byte {
+	return append(ClassModuleKey, []byte(classID)...)
+}
+
+// ParseNFTOfClassByOwnerStoreKey parses the owner, the class ID and the NFT
+// ID of an index entry key of an NFT by its owner, without its prefix.
+func ParseNFTOfClassByOwnerStoreKey(key []byte) (owner sdk.AccAddress, classID, nftID string) {
+	ownerLen := int(key[0])
+	owner = sdk.AccAddress(key[1 : 1+ownerLen])
+	key = key[1+ownerLen:]
+	classIDLen := int(key[0])
+	return owner, string(key[1 : 1+classIDLen]), string(key[1+classIDLen:])
+}
+
+// ParseNFTStoreKey parses the class ID and the NFT ID of an NFT key, without
+// its prefix.
+func ParseNFTStoreKey(key []byte) (classID, nftID string) {
+	classIDLen := int(key[0])
+	return string(key[1 : 1+classIDLen]), string(key[1+classIDLen:])
+}
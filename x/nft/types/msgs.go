@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypeMsgSend nft message types
+const TypeMsgSend = "send"
+
+var _ sdk.Msg = &MsgSend{}
+
+// NewMsgSend creates a new MsgSend
+// nolint: interfacer
+func NewMsgSend(classID, nftID string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSend) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSend) Type() string { return TypeMsgSend }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners implements the Msg.GetSigners method.
+func (m MsgSend) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
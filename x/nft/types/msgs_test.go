@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var (
+	sender   = sdk.AccAddress("_______sender_______")
+	receiver = sdk.AccAddress("______receiver______")
+)
+
+func TestMsgSend(t *testing.T) {
+	tests := []struct {
+		title      string
+		classID    string
+		nftID      string
+		sender     sdk.AccAddress
+		receiver   sdk.AccAddress
+		expectPass bool
+	}{
+		{"invalid class id", "1kitties", "kitty1", sender, receiver, false},
+		{"invalid nft id", "kitties", "k", sender, receiver, false},
+		{"nil sender", "kitties", "kitty1", nil, receiver, false},
+		{"nil receiver", "kitties", "kitty1", sender, nil, false},
+		{"valid test case", "kitties", "kitty1", sender, receiver, true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgSend(tc.classID, tc.nftID, tc.sender, tc.receiver)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.sender}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSendGetSignBytes(t *testing.T) {
+	msg := types.NewMsgSend("kitties", "kitty1", sender, receiver)
+	expected := `{"type":"cosmos-sdk/nft/MsgSend","value":{"class_id":"kitties","id":"kitty1","receiver":"cosmos1ta047h6ltaex2cm9d9mx2ujlta047h6lv8qxc4","sender":"cosmos1ta047h6lta0hxetwv3jhyh6lta047h6ldgqhtm"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// reIDString is the regular expression the class and NFT IDs must match,
+	// they start with a letter, followed by 2 to 100 letters, digits or any
+	// of '/', ':' and '-'.
+	reIDString = `[a-zA-Z][a-zA-Z0-9/:-]{2,100}`
+	reID       = regexp.MustCompile(fmt.Sprintf(`^%s$`, reIDString))
+)
+
+// ValidateClassID returns whether the class id is valid
+func ValidateClassID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrap(ErrInvalidClassID, id)
+	}
+	return nil
+}
+
+// ValidateNFTID returns whether the nft id is valid
+func ValidateNFTID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrap(ErrInvalidID, id)
+	}
+	return nil
+}
+
+// ValidateBasic does a simple validation check of the class.
+func (c Class) ValidateBasic() error {
+	return ValidateClassID(c.Id)
+}
+
+// ValidateBasic does a simple validation check of the NFT.
+func (n NFT) ValidateBasic() error {
+	if err := ValidateClassID(n.ClassId); err != nil {
+		return err
+	}
+	return ValidateNFTID(n.Id)
+}
@@ -70,16 +70,20 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.prefix())
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.prefix())
+}
+
+// prefix returns the prefix of the subspace stores. The name is copied, as
+// appending to it would write to its extra capacity, shared by the copies of
+// the subspace, which can be used concurrently, e.g. by the invariant monitor
+// or by parallel DeliverTxs.
+func (s Subspace) prefix() []byte {
+	return append(append(make([]byte, 0, len(s.name)+1), s.name...), '/')
 }
 
 // Validate attempts to validate a parameter value by its key. If the key is not
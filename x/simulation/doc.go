@@ -112,6 +112,26 @@ To export the simulation app state (i.e genesis) to a file:
 	-ExportStatePath=/path/to/genesis.json \
 	 v -timeout 24h
 
+To simulate a software upgrade in the middle of the simulation:
+
+ $ go test -mod=readonly github.com/cosmos/cosmos-sdk/simapp \
+ 	-run=TestAppSimulationWithUpgrade \
+ 	-Enabled=true \
+ 	-NumBlocks=100 \
+ 	-BlockSize=200 \
+ 	-Seed=99 \
+ 	-Period=5 \
+ 	-v -timeout 24h
+
+Upgrades
+
+SimulateFromSeedWithUpgrade runs the simulation with an application playing the
+old binary, schedules the given Upgrade in the first block, and switches to the
+upgraded application once the old one halted at the upgrade height. The
+upgraded application loads the committed state of the old one, so store
+upgrades and the upgrade handler run as they would on a live chain, and the
+randomized operations go on with the operations of the upgraded application.
+
 Params
 
 Params that are provided to simulation from a JSON file are used to used to set
@@ -43,7 +43,6 @@ func initChain(
 
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided config.Seed.
-// TODO: split this monster function up
 func SimulateFromSeed(
 	tb testing.TB,
 	w io.Writer,
@@ -54,6 +53,48 @@ func SimulateFromSeed(
 	blockedAddrs map[string]bool,
 	config simulation.Config,
 	cdc codec.JSONMarshaler,
+) (stopEarly bool, exportedParams Params, err error) {
+	return simulateFromSeed(tb, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, nil)
+}
+
+// SimulateFromSeedWithUpgrade is like SimulateFromSeed, but performs the given
+// software upgrade in the middle of the simulation. The upgrade is scheduled in
+// the first block. The provided application must halt at the upgrade height,
+// after which the simulation goes on with the upgraded application and its
+// operations. Operations queued by the old application are dropped, as they are
+// bound to its keepers. The simulation must commit, so that the upgraded
+// application can load the state of the old one.
+func SimulateFromSeedWithUpgrade(
+	tb testing.TB,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONMarshaler,
+	upgrade Upgrade,
+) (stopEarly bool, exportedParams Params, err error) {
+	if err := upgrade.validate(config); err != nil {
+		return true, Params{}, err
+	}
+
+	return simulateFromSeed(tb, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, &upgrade)
+}
+
+// TODO: split this monster function up
+func simulateFromSeed(
+	tb testing.TB,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONMarshaler,
+	upgrade *Upgrade,
 ) (stopEarly bool, exportedParams Params, err error) {
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
@@ -156,12 +197,32 @@ func SimulateFromSeed(
 		pastTimes = append(pastTimes, header.Time)
 		pastVoteInfos = append(pastVoteInfos, request.LastCommitInfo.Votes)
 
+		// Switch to the upgraded application once the old one halted
+		if upgrade != nil && header.Height == upgrade.Height {
+			if !upgrade.halts(app, request) {
+				return true, exportedParams, fmt.Errorf("application did not halt at upgrade height %d", upgrade.Height)
+			}
+
+			fmt.Fprintf(w, "\nApplication halted at upgrade height %d, switching to the upgraded application\n", upgrade.Height)
+			app, ops = upgrade.Apply(tb)
+
+			operationQueue = NewOperationQueue()
+			timeOperationQueue = nil
+			blockSimulator = createBlockSimulator(
+				testingMode, tb, w, params, eventStats.Tally,
+				ops, operationQueue, timeOperationQueue, logWriter, config)
+		}
+
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
 		app.BeginBlock(request)
 
 		ctx := app.NewContext(false, header)
 
+		if upgrade != nil && header.Height == 1 {
+			upgrade.Schedule(ctx)
+		}
+
 		// Run queued operations. Ignores blocksize if blocksize is too small
 		numQueuedOpsRan := runQueuedOperations(
 			operationQueue, int(header.Height), tb, r, app, ctx, accs, logWriter,
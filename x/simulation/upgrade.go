@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Upgrade defines a software upgrade performed by SimulateFromSeedWithUpgrade in
+// the middle of a simulation. The application the simulation starts with plays
+// the old binary: it must halt in BeginBlock at Height, panicking as x/upgrade
+// does when it has no handler for a plan due at that height. The application
+// returned by Apply plays the new binary and runs the simulation from Height on.
+type Upgrade struct {
+	// Height is the height of the first block run by the upgraded application.
+	Height int64
+
+	// Schedule schedules the upgrade at Height, e.g. with the x/upgrade keeper.
+	// It is called with the context of the first simulated block.
+	Schedule func(ctx sdk.Context)
+
+	// Apply is called once the old application halted at Height. It returns the
+	// upgraded application, loaded from the database of the old one, along with
+	// its weighted operations.
+	Apply func(tb testing.TB) (*baseapp.BaseApp, WeightedOperations)
+}
+
+// validate checks that the upgrade happens within the simulated blocks.
+func (u Upgrade) validate(config simulation.Config) error {
+	if !config.Commit {
+		return fmt.Errorf("simulating an upgrade requires the simulation to commit")
+	}
+	if u.Height <= 1 || u.Height > int64(config.NumBlocks) {
+		return fmt.Errorf("upgrade height %d must be within the simulated blocks [2, %d]", u.Height, config.NumBlocks)
+	}
+	if u.Schedule == nil || u.Apply == nil {
+		return fmt.Errorf("upgrade must define Schedule and Apply")
+	}
+
+	return nil
+}
+
+// halts runs BeginBlock on the given application and reports whether it
+// halted for the upgrade, i.e. whether it panicked with the message of x/upgrade
+// announcing that the upgrade is needed at Height. Any other panic is propagated.
+func (u Upgrade) halts(app *baseapp.BaseApp, req abci.RequestBeginBlock) (halted bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, ok := r.(string)
+			if !ok || !strings.HasPrefix(msg, "UPGRADE ") ||
+				!strings.Contains(msg, fmt.Sprintf(" NEEDED at height: %d: ", u.Height)) {
+				panic(r)
+			}
+
+			halted = true
+		}
+	}()
+
+	app.BeginBlock(req)
+	return false
+}
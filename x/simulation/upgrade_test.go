@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpgradeHalts(t *testing.T) {
+	u := Upgrade{Height: 3}
+
+	testCases := []struct {
+		name      string
+		panicWith interface{}
+		expHalt   bool
+		expPanic  bool
+	}{
+		{"no panic", nil, false, false},
+		{"upgrade needed", `UPGRADE "test" NEEDED at height: 3: info`, true, false},
+		{"upgrade needed at another height", `UPGRADE "test" NEEDED at height: 30: info`, false, true},
+		{"other panic", "invariant broken", false, true},
+		{"error", errors.New("unable to write upgrade info to filesystem"), false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+			app.SetBeginBlocker(func(sdk.Context, abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				if tc.panicWith != nil {
+					panic(tc.panicWith)
+				}
+				return abci.ResponseBeginBlock{}
+			})
+			require.NoError(t, app.LoadLatestVersion())
+			app.InitChain(abci.RequestInitChain{})
+
+			req := abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+			if tc.expPanic {
+				require.Panics(t, func() { u.halts(app, req) })
+				return
+			}
+			require.Equal(t, tc.expHalt, u.halts(app, req))
+		})
+	}
+}
@@ -98,7 +98,8 @@ func TestMigrate(t *testing.T) {
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
     "slash_fraction_double_sign": "0.050000000000000000",
-    "slash_fraction_downtime": "0.010000000000000000"
+    "slash_fraction_downtime": "0.010000000000000000",
+    "slash_fraction_light_client_attack": "0"
   },
   "signing_infos": [
     {
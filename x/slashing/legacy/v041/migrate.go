@@ -0,0 +1,25 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Migrate accepts exported v0.40 x/slashing genesis state and migrates it to
+// v0.41 x/slashing genesis state. The migration includes:
+//
+// - Set the light client attack slash fraction to the double sign slash
+// fraction, which light client attacks were slashed with until v0.41. The
+// fraction is missing from v0.40 exports, and is zero in the output of the
+// v0.40 migration.
+func Migrate(oldSlashingState *types.GenesisState) *types.GenesisState {
+	params := oldSlashingState.Params
+	if params.SlashFractionLightClientAttack.IsNil() || params.SlashFractionLightClientAttack.IsZero() {
+		params.SlashFractionLightClientAttack = params.SlashFractionDoubleSign
+	}
+
+	return &types.GenesisState{
+		Params:       params,
+		SigningInfos: oldSlashingState.SigningInfos,
+		MissedBlocks: oldSlashingState.MissedBlocks,
+	}
+}
@@ -0,0 +1,50 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithJSONMarshaler(encodingConfig.Marshaler)
+
+	// A v0.40 genesis state has no light client attack slash fraction.
+	v040GenState := `{
+  "params": {
+    "downtime_jail_duration": "600s",
+    "min_signed_per_window": "0.500000000000000000",
+    "signed_blocks_window": "100",
+    "slash_fraction_double_sign": "0.020000000000000000",
+    "slash_fraction_downtime": "0.010000000000000000"
+  },
+  "signing_infos": [],
+  "missed_blocks": []
+}`
+
+	var slashingGenState types.GenesisState
+	require.NoError(t, clientCtx.JSONMarshaler.UnmarshalJSON([]byte(v040GenState), &slashingGenState))
+
+	migrated := v041slashing.Migrate(&slashingGenState)
+	require.NoError(t, types.ValidateGenesis(*migrated))
+
+	// Make sure about:
+	// - the light client attack slash fraction is the double sign one.
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), migrated.Params.SlashFractionLightClientAttack)
+
+	// A fraction set explicitly is kept.
+	slashingGenState.Params.SlashFractionLightClientAttack = sdk.NewDecWithPrec(5, 1)
+	migrated = v041slashing.Migrate(&slashingGenState)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), migrated.Params.SlashFractionLightClientAttack)
+}
@@ -0,0 +1,23 @@
+package v041
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Set the light client attack slash fraction to the double sign slash
+// fraction, which light client attacks were slashed with until v0.41.
+func MigrateStore(ctx sdk.Context, paramspace types.ParamSubspace) error {
+	if paramspace.Has(ctx, types.KeySlashFractionLightClientAttack) {
+		return nil
+	}
+
+	var slashFractionDoubleSign sdk.Dec
+	paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &slashFractionDoubleSign)
+	paramspace.Set(ctx, types.KeySlashFractionLightClientAttack, slashFractionDoubleSign)
+
+	return nil
+}
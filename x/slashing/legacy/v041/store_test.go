@@ -0,0 +1,51 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v041slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTKey := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramspace := paramstypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// The v0.40 params have no light client attack slash fraction.
+	slashFractionDoubleSign := sdk.NewDecWithPrec(2, 2)
+	paramspace.Set(ctx, types.KeySlashFractionDoubleSign, slashFractionDoubleSign)
+	require.False(t, paramspace.Has(ctx, types.KeySlashFractionLightClientAttack))
+
+	// Run migration.
+	require.NoError(t, v041slashing.MigrateStore(ctx, paramspace))
+
+	var slashFractionLightClientAttack sdk.Dec
+	paramspace.Get(ctx, types.KeySlashFractionLightClientAttack, &slashFractionLightClientAttack)
+	require.Equal(t, slashFractionDoubleSign, slashFractionLightClientAttack)
+
+	// A fraction already set is kept.
+	paramspace.Set(ctx, types.KeySlashFractionLightClientAttack, sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, v041slashing.MigrateStore(ctx, paramspace))
+	paramspace.Get(ctx, types.KeySlashFractionLightClientAttack, &slashFractionLightClientAttack)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), slashFractionLightClientAttack)
+}
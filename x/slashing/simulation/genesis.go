@@ -16,11 +16,12 @@ import (
 
 // Simulation parameter constants
 const (
-	SignedBlocksWindow      = "signed_blocks_window"
-	MinSignedPerWindow      = "min_signed_per_window"
-	DowntimeJailDuration    = "downtime_jail_duration"
-	SlashFractionDoubleSign = "slash_fraction_double_sign"
-	SlashFractionDowntime   = "slash_fraction_downtime"
+	SignedBlocksWindow             = "signed_blocks_window"
+	MinSignedPerWindow             = "min_signed_per_window"
+	DowntimeJailDuration           = "downtime_jail_duration"
+	SlashFractionDoubleSign        = "slash_fraction_double_sign"
+	SlashFractionDowntime          = "slash_fraction_downtime"
+	SlashFractionLightClientAttack = "slash_fraction_light_client_attack"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +49,11 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenSlashFractionLightClientAttack randomized SlashFractionLightClientAttack
+func GenSlashFractionLightClientAttack(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(50) + 1)))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,9 +86,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var slashFractionLightClientAttack sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionLightClientAttack, &slashFractionLightClientAttack, simState.Rand,
+		func(r *rand.Rand) { slashFractionLightClientAttack = GenSlashFractionLightClientAttack(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, slashFractionLightClientAttack,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
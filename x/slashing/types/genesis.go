@@ -48,6 +48,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
 	}
 
+	lightClientAttack := data.Params.SlashFractionLightClientAttack
+	if lightClientAttack.IsNil() || lightClientAttack.IsNegative() || lightClientAttack.GT(sdk.OneDec()) {
+		return fmt.Errorf("slashing fraction light client attack should be less than or equal to one and greater than zero, is %s", lightClientAttack)
+	}
+
 	minSign := data.Params.MinSignedPerWindow
 	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
 		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
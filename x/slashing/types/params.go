@@ -15,18 +15,20 @@ const (
 )
 
 var (
-	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
-	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultMinSignedPerWindow             = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign        = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime          = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultSlashFractionLightClientAttack = sdk.NewDec(1).Quo(sdk.NewDec(20))
 )
 
 // Parameter store keys
 var (
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeySignedBlocksWindow             = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow             = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration           = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign        = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime          = []byte("SlashFractionDowntime")
+	KeySlashFractionLightClientAttack = []byte("SlashFractionLightClientAttack")
 )
 
 // ParamKeyTable for slashing module
@@ -37,15 +39,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime, slashFractionLightClientAttack sdk.Dec,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:             signedBlocksWindow,
+		MinSignedPerWindow:             minSignedPerWindow,
+		DowntimeJailDuration:           downtimeJailDuration,
+		SlashFractionDoubleSign:        slashFractionDoubleSign,
+		SlashFractionDowntime:          slashFractionDowntime,
+		SlashFractionLightClientAttack: slashFractionLightClientAttack,
 	}
 }
 
@@ -57,6 +60,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeySlashFractionLightClientAttack, &p.SlashFractionLightClientAttack, validateSlashFractionLightClientAttack),
 	}
 }
 
@@ -64,7 +68,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultSlashFractionLightClientAttack,
 	)
 }
 
@@ -141,3 +145,19 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateSlashFractionLightClientAttack(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("light client attack slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("light client attack slash fraction too large: %s", v)
+	}
+
+	return nil
+}
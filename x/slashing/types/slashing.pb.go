@@ -122,11 +122,12 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 
 // Params represents the parameters used for by the slashing module.
 type Params struct {
-	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
-	MinSignedPerWindow      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
-	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
-	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
-	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SignedBlocksWindow             int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	MinSignedPerWindow             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	DowntimeJailDuration           time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
+	SlashFractionDoubleSign        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SlashFractionDowntime          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SlashFractionLightClientAttack github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction_light_client_attack,json=slashFractionLightClientAttack,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_light_client_attack" yaml:"slash_fraction_light_client_attack"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -186,47 +187,50 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0xcf, 0x7d, 0xf3, 0x25, 0x94, 0x4b, 0x26, 0x37, 0x25, 0x21, 0x80, 0x1d, 0x3c, 0x54, 0xe9,
+	0x50, 0x5b, 0x2d, 0x5b, 0x37, 0xdc, 0x0a, 0xf1, 0x4b, 0x50, 0xdc, 0x02, 0x12, 0x48, 0x58, 0x67,
+	0xfb, 0xe2, 0x1c, 0xb5, 0xef, 0x22, 0xdf, 0x85, 0xb6, 0x6c, 0x6c, 0x1d, 0x3b, 0x76, 0xac, 0xc4,
+	0x00, 0x7f, 0x4a, 0xc7, 0x8e, 0x88, 0x21, 0xa0, 0x74, 0x61, 0xee, 0x5f, 0x80, 0x7c, 0x67, 0xb7,
+	0x21, 0x4d, 0x91, 0x3a, 0x25, 0xef, 0xf3, 0x3e, 0xef, 0xbd, 0xcf, 0xfb, 0x71, 0x86, 0xf3, 0x01,
+	0xe3, 0x09, 0xe3, 0x36, 0x8f, 0x11, 0xef, 0x11, 0x1a, 0xd9, 0x1f, 0x97, 0x7c, 0x2c, 0xd0, 0xd2,
+	0x19, 0x60, 0xf5, 0x53, 0x26, 0x98, 0xd6, 0x50, 0x3c, 0xeb, 0x0c, 0xce, 0x79, 0xad, 0x7a, 0xc4,
+	0x22, 0x26, 0x39, 0x76, 0xf6, 0x4f, 0xd1, 0x5b, 0x7a, 0xc4, 0x58, 0x14, 0x63, 0x5b, 0x5a, 0xfe,
+	0xa0, 0x6b, 0x87, 0x83, 0x14, 0x09, 0xc2, 0x68, 0xee, 0x37, 0x26, 0xfd, 0x82, 0x24, 0x98, 0x0b,
+	0x94, 0xf4, 0x15, 0xc1, 0xdc, 0x2b, 0xc3, 0xfa, 0x6b, 0x14, 0x93, 0x10, 0x09, 0x96, 0x6e, 0x90,
+	0x88, 0x12, 0x1a, 0x3d, 0xa6, 0x5d, 0xa6, 0x35, 0xe1, 0x75, 0x14, 0x86, 0x29, 0xe6, 0xbc, 0x09,
+	0xda, 0xa0, 0x73, 0xc3, 0x2d, 0x4c, 0x6d, 0x05, 0xd6, 0xb8, 0x40, 0xa9, 0xf0, 0x7a, 0x98, 0x44,
+	0x3d, 0xd1, 0xfc, 0xaf, 0x0d, 0x3a, 0x65, 0xa7, 0x71, 0x3a, 0x34, 0x66, 0x77, 0x51, 0x12, 0xaf,
+	0x98, 0xe3, 0x5e, 0xd3, 0xad, 0x4a, 0xf3, 0x91, 0xb4, 0xb2, 0x58, 0x42, 0x43, 0xbc, 0xe3, 0xb1,
+	0x6e, 0x97, 0x63, 0xd1, 0x2c, 0x4f, 0xc6, 0x8e, 0x7b, 0x4d, 0xb7, 0x2a, 0xcd, 0x17, 0xd2, 0xd2,
+	0xde, 0xc3, 0xda, 0x07, 0x44, 0x62, 0x1c, 0x7a, 0x03, 0x2a, 0x48, 0xdc, 0xfc, 0xbf, 0x0d, 0x3a,
+	0xd5, 0xe5, 0x96, 0xa5, 0x5a, 0xb4, 0x8a, 0x16, 0xad, 0xcd, 0xa2, 0x45, 0xc7, 0x38, 0x1a, 0x1a,
+	0xa5, 0xf3, 0xdc, 0xe3, 0xd1, 0xe6, 0xfe, 0x4f, 0x03, 0xb8, 0x55, 0x05, 0xbd, 0xca, 0x10, 0x4d,
+	0x87, 0x50, 0xb0, 0xc4, 0xe7, 0x82, 0x51, 0x1c, 0x36, 0xaf, 0xb5, 0x41, 0x67, 0xc6, 0x1d, 0x43,
+	0xb4, 0x4d, 0x38, 0x97, 0x10, 0xce, 0x71, 0xe8, 0xf9, 0x31, 0x0b, 0xb6, 0xb8, 0x17, 0xb0, 0x01,
+	0x15, 0x38, 0x6d, 0x56, 0x64, 0x13, 0xed, 0xd3, 0xa1, 0x71, 0x47, 0x15, 0x9a, 0x4a, 0x33, 0xdd,
+	0x59, 0x85, 0x3b, 0x12, 0x5e, 0x55, 0xe8, 0xca, 0xcc, 0xc1, 0xa1, 0x51, 0xfa, 0x7d, 0x68, 0x00,
+	0xf3, 0x4b, 0x05, 0x56, 0xd6, 0x51, 0x8a, 0x12, 0xae, 0xbd, 0x84, 0x75, 0x4e, 0x22, 0x7a, 0x9e,
+	0x63, 0x9b, 0xd0, 0x90, 0x6d, 0xcb, 0x4d, 0x94, 0x1d, 0xe3, 0x74, 0x68, 0xdc, 0xce, 0x47, 0x3d,
+	0x85, 0x65, 0xba, 0x9a, 0x82, 0x55, 0xa1, 0x37, 0x12, 0xd4, 0x3e, 0x83, 0x4c, 0x3e, 0xf5, 0xf2,
+	0x88, 0x3e, 0x4e, 0x8b, 0xa4, 0xd9, 0xfe, 0x6a, 0xce, 0xf3, 0x6c, 0x56, 0x3f, 0x86, 0xc6, 0x7c,
+	0x44, 0x44, 0x6f, 0xe0, 0x5b, 0x01, 0x4b, 0xec, 0xfc, 0x66, 0xd5, 0xcf, 0x22, 0x0f, 0xb7, 0x6c,
+	0xb1, 0xdb, 0xc7, 0xdc, 0x5a, 0xc3, 0xc1, 0x78, 0xb3, 0x53, 0x92, 0x9a, 0xae, 0x96, 0x10, 0xba,
+	0x21, 0xe1, 0x75, 0x9c, 0xe6, 0x1a, 0x3e, 0xc1, 0x9b, 0x21, 0xdb, 0xa6, 0xd9, 0x0d, 0x7a, 0xd9,
+	0xe4, 0xbd, 0xe2, 0x5a, 0xe5, 0x1d, 0x54, 0x97, 0x6f, 0x5d, 0xd8, 0xe5, 0x5a, 0x4e, 0x70, 0x16,
+	0xf2, 0x55, 0xde, 0x55, 0x45, 0xa7, 0xa7, 0x31, 0x0f, 0xb2, 0xa5, 0xd6, 0x0b, 0xe7, 0x13, 0x44,
+	0xe2, 0x22, 0x81, 0xb6, 0x0f, 0x60, 0x4b, 0x3e, 0x2a, 0xaf, 0x9b, 0xa2, 0x20, 0x83, 0xbc, 0x90,
+	0x0d, 0xfc, 0x18, 0x4b, 0xf1, 0xf2, 0x98, 0x6a, 0xce, 0xc6, 0x95, 0x87, 0x70, 0x2f, 0xdf, 0xc3,
+	0xa5, 0x99, 0x4d, 0xb7, 0x21, 0x9d, 0x0f, 0x73, 0xdf, 0x9a, 0x74, 0x65, 0x93, 0xd1, 0xf6, 0x00,
+	0x6c, 0x5c, 0x08, 0x54, 0xd2, 0xe5, 0xf9, 0xd5, 0x9c, 0xf5, 0x2b, 0xeb, 0xd1, 0x2f, 0xd1, 0xa3,
+	0xd2, 0x9a, 0xee, 0xdc, 0x84, 0x18, 0x85, 0x6b, 0x5f, 0x01, 0x9c, 0x8c, 0x89, 0xb3, 0x07, 0xeb,
+	0x05, 0x31, 0xc1, 0x54, 0x78, 0x48, 0x08, 0x14, 0x6c, 0xc9, 0x4b, 0xaf, 0x39, 0xef, 0xae, 0xac,
+	0x6a, 0x61, 0xaa, 0xaa, 0x29, 0x15, 0x4c, 0x57, 0xff, 0x4b, 0xe0, 0xb3, 0x8c, 0xb2, 0x2a, 0x19,
+	0x0f, 0x24, 0xc1, 0x79, 0xfa, 0x6d, 0xa4, 0x83, 0xa3, 0x91, 0x0e, 0x8e, 0x47, 0x3a, 0xf8, 0x35,
+	0xd2, 0xc1, 0xfe, 0x89, 0x5e, 0x3a, 0x3e, 0xd1, 0x4b, 0xdf, 0x4f, 0xf4, 0xd2, 0xdb, 0xc5, 0x7f,
+	0x4a, 0xda, 0x39, 0xff, 0xfc, 0x4a, 0x75, 0x7e, 0x45, 0x1e, 0xda, 0xfd, 0x3f, 0x03, 0x00, 0x14,
+	0xc3, 0xc6, 0xe0, 0x9e, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -302,6 +306,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.SlashFractionLightClientAttack.Equal(that1.SlashFractionLightClientAttack) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -387,6 +394,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SlashFractionLightClientAttack.Size()
+		i -= size
+		if _, err := m.SlashFractionLightClientAttack.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -488,6 +505,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionLightClientAttack.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -872,6 +891,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLightClientAttack", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLightClientAttack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -800,6 +800,154 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// GetDelegatorBonded returns the amount of tokens a delegator has delegated,
+// whatever the status of the validators.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroInt()
+
+	k.IterateDelegations(ctx, delegator, func(_ int64, delegation types.DelegationI) bool {
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		}
+
+		return false
+	})
+
+	return bonded
+}
+
+// GetDelegatorUnbonding returns the amount of tokens a delegator has unbonding.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(k.cdc, iterator.Value())
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+
+	return unbonding
+}
+
+// TransferUnbonding moves up to wantAmt tokens unbonding from a validator from
+// one delegator to another. The entries keep their creation height and
+// completion time, so that the tokens stay liable to slashing and complete
+// their unbonding as if they had not been moved. Fewer tokens are moved if the
+// receiving delegator reaches the maximum number of entries. It returns the
+// amount of tokens moved.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		if toXfer.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = entry.InitialBalance.Sub(toXfer)
+		ubdFrom.Entries[i] = entry
+	}
+
+	switch {
+	case !modified:
+	case len(ubdFrom.Entries) == 0:
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	default:
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares shares of a delegation to a
+// validator from one delegator to another. Nothing is moved if the delegation
+// was received from a redelegation which is still liable to slashing, or if it
+// is the self-delegation of the validator operator. It returns the amount of
+// shares moved.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+
+	if fromAddr.Equals(toAddr) || valAddr.Equals(sdk.ValAddress(fromAddr)) {
+		return transferred
+	}
+
+	// slashing a redelegation unbonds from the delegation it was moved to
+	if k.HasReceivingRedelegation(ctx, fromAddr, valAddr) {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	if !transferred.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	// call the appropriate hooks before modifying the delegations
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	return transferred
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
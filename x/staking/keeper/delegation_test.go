@@ -1017,3 +1017,94 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	// the validator operator is the third address
+	validator := teststaking.NewValidator(t, valAddrs[2], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(100))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddrs[0], valAddrs[2], issuedShares))
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[0]))
+
+	// move part of the delegation
+	transferred := app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[2], sdk.NewDec(30))
+	require.Equal(t, sdk.NewDec(30), transferred)
+	require.Equal(t, sdk.NewInt(70), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[0]))
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+
+	// the validator is left as is
+	resValidator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, resValidator.DelegatorShares)
+
+	// no more than the delegation is moved, and the emptied delegation is removed
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[2], sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(70), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[2])
+	require.False(t, found)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, issuedShares, delegation.Shares)
+
+	// the self-delegation of the operator is not moved
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[2], delAddrs[0], valAddrs[2], sdk.NewDec(10))
+	require.True(t, transferred.IsZero())
+
+	// nor a delegation received from a redelegation
+	app.StakingKeeper.SetRedelegation(ctx, types.NewRedelegation(
+		delAddrs[1], valAddrs[0], valAddrs[2], 0, time.Unix(0, 0).UTC(), sdk.NewInt(5), sdk.NewDec(5),
+	))
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[0], valAddrs[2], sdk.NewDec(10))
+	require.True(t, transferred.IsZero())
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]))
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := time.Unix(100, 0).UTC()
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[1], 1, completionTime, sdk.NewInt(10))
+	ubd.AddEntry(2, completionTime.Add(time.Hour), sdk.NewInt(20))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+
+	// the first entry is moved whole, and the second one in part
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[1], sdk.NewInt(15))
+	require.Equal(t, sdk.NewInt(15), transferred)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(2), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(15), ubdFrom.Entries[0].InitialBalance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, int64(1), ubdTo.Entries[0].CreationHeight)
+	require.Equal(t, completionTime, ubdTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[1].Balance)
+
+	// the moved entries are queued for the receiving delegator
+	pairs := app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime)
+	require.Contains(t, pairs, types.DVPair{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[1].String()})
+
+	// no more than the unbonding tokens are moved, and the emptied unbonding
+	// delegation is removed
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[1], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(15), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[1])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"container/list"
 	"fmt"
+	"sync"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -30,6 +31,10 @@ type Keeper struct {
 	paramstore         paramtypes.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
+
+	// validatorCacheMtx guards the validator cache, which is read and written
+	// by concurrent queries and background invariant checks.
+	validatorCacheMtx *sync.Mutex
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -60,6 +65,7 @@ func NewKeeper(
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
+		validatorCacheMtx:  &sync.Mutex{},
 	}
 }
 
@@ -64,7 +64,7 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 
 // StakingTokenSupply staking tokens from the total supply
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
-	return k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(k.BondDenom(ctx))
+	return k.bankKeeper.GetSupply(ctx, k.BondDenom(ctx)).Amount
 }
 
 // BondedRatio the fraction of the staking tokens which are currently bonded
@@ -35,6 +35,9 @@ func (k Keeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator ty
 		return validator, false
 	}
 
+	k.validatorCacheMtx.Lock()
+	defer k.validatorCacheMtx.Unlock()
+
 	// If these amino encoded bytes are in the cache, return the cached validator
 	strValue := string(value)
 	if val, ok := k.validatorCache[strValue]; ok {
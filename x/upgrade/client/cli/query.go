@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
+		GetModuleVersionsCmd(),
 	)
 
 	return cmd
@@ -59,6 +60,41 @@ func GetCurrentPlanCmd() *cobra.Command {
 	return cmd
 }
 
+// GetModuleVersionsCmd returns the module version list from state
+func GetModuleVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module_versions [optional module_name]",
+		Short: "get the list of module versions",
+		Long: "Gets a list of module names and their respective consensus versions.\n" +
+			"Following the command with a specific module name will return only\n" +
+			"that module's information.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var params types.QueryModuleVersionsRequest
+			if len(args) == 1 {
+				params = types.QueryModuleVersionsRequest{ModuleName: args[0]}
+			}
+
+			res, err := queryClient.ModuleVersions(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAppliedPlanCmd returns information about the block at which a completed
 // upgrade was applied.
 func GetAppliedPlanCmd() *cobra.Command {
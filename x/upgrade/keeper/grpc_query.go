@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -52,3 +53,25 @@ func (k Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		UpgradedConsensusState: cs,
 	}, nil
 }
+
+// ModuleVersions implements the Query/QueryModuleVersions gRPC method
+func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		if version, ok := k.GetModuleVersionMap(ctx)[req.ModuleName]; ok {
+			// return the requested module
+			res := []*types.ModuleVersion{{Name: req.ModuleName, Version: version}}
+			return &types.QueryModuleVersionsResponse{ModuleVersions: res}, nil
+		}
+		// module requested, but not found
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "x/upgrade: QueryModuleVersions module %s not found", req.ModuleName)
+	}
+
+	// if no module requested return all module versions from state
+	mv := k.GetModuleVersions(ctx)
+	return &types.QueryModuleVersionsResponse{
+		ModuleVersions: mv,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
@@ -197,7 +199,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -250,6 +252,45 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 	}
 }
 
+// Tests that the version map is persisted on init chain and updated with the
+// version map returned by the upgrade handler.
+func (s *KeeperTestSuite) TestMigrations() {
+	initialVM := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NotEmpty(initialVM)
+	s.Require().Equal(uint64(2), initialVM["bank"])
+
+	s.app.UpgradeKeeper.SetUpgradeHandler("dummy", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm["bank"] = vm["bank"] + 1
+		return vm, nil
+	})
+	dummyPlan := types.Plan{
+		Name:   "dummy",
+		Info:   "some text here",
+		Height: 123450000,
+	}
+
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan)
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(vm["bank"], initialVM["bank"]+1)
+	s.Require().Equal(len(initialVM), len(vm))
+}
+
+// Tests that ApplyUpgrade panics, and leaves the version map untouched, when
+// the upgrade handler returns an error.
+func (s *KeeperTestSuite) TestMigrationsError() {
+	initialVM := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+
+	s.app.UpgradeKeeper.SetUpgradeHandler("failing", func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{Name: "failing", Height: 123450000})
+	})
+	s.Require().Equal(initialVM, s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx))
+	s.Require().Zero(s.app.UpgradeKeeper.GetDoneHeight(s.ctx, "failing"))
+}
+
 func (s *KeeperTestSuite) TestSetUpgradedClient() {
 	var (
 		clientState ibcexported.ClientState
@@ -166,10 +166,52 @@ func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
 
+// ModuleVersion specifies a module and its consensus version.
+type ModuleVersion struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// consensus version of the app module
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
 }
 
 func init() {
@@ -177,34 +219,36 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xd4, 0x30,
-	0x18, 0x8d, 0x69, 0x5a, 0x51, 0xdf, 0x66, 0x8e, 0x12, 0x4e, 0xc5, 0x89, 0x4e, 0x0c, 0x37, 0x80,
-	0xa3, 0x16, 0x09, 0xa1, 0x6e, 0xa4, 0x3b, 0xaa, 0x52, 0x58, 0x90, 0x50, 0xe5, 0x24, 0xbe, 0x9c,
-	0xc1, 0xb1, 0xa3, 0xd8, 0x07, 0xe4, 0x57, 0xd0, 0x9f, 0xc0, 0xcf, 0xb9, 0xb1, 0x63, 0xa7, 0x42,
-	0xef, 0x16, 0xe6, 0xfe, 0x02, 0x14, 0x3b, 0x41, 0x08, 0x3a, 0x76, 0xf2, 0xf7, 0x3d, 0xbd, 0xef,
-	0x3d, 0xfb, 0xf9, 0x83, 0x4f, 0x73, 0xa5, 0x2b, 0xa5, 0xe3, 0x65, 0x5d, 0x36, 0xb4, 0x60, 0xf1,
-	0xe7, 0x83, 0x8c, 0x19, 0x7a, 0x30, 0xf4, 0xa4, 0x6e, 0x94, 0x51, 0x68, 0xcf, 0xb1, 0xc8, 0x80,
-	0xf6, 0xac, 0xc9, 0xe3, 0x52, 0xa9, 0x52, 0xb0, 0xd8, 0xb2, 0xb2, 0xe5, 0x3c, 0xa6, 0xb2, 0x75,
-	0x23, 0x93, 0x71, 0xa9, 0x4a, 0x65, 0xcb, 0xb8, 0xab, 0x7a, 0x34, 0xfc, 0x77, 0xc0, 0xf0, 0x8a,
-	0x69, 0x43, 0xab, 0xda, 0x11, 0xa6, 0x37, 0x00, 0xfa, 0x27, 0x82, 0x4a, 0x84, 0xa0, 0x2f, 0x69,
-	0xc5, 0x02, 0x10, 0x81, 0xd9, 0x6e, 0x6a, 0x6b, 0xf4, 0x0a, 0xfa, 0x1d, 0x3f, 0xb8, 0x17, 0x81,
-	0xd9, 0xe8, 0x70, 0x42, 0x9c, 0x18, 0x19, 0xc4, 0xc8, 0xdb, 0x41, 0x2c, 0xb9, 0xbf, 0xba, 0x0a,
-	0xbd, 0xf3, 0x1f, 0x21, 0x48, 0xed, 0x04, 0xda, 0x83, 0x3b, 0x0b, 0xc6, 0xcb, 0x85, 0x09, 0xb6,
-	0x22, 0x30, 0xdb, 0x4a, 0xfb, 0xae, 0x73, 0xe1, 0x72, 0xae, 0x02, 0xdf, 0xb9, 0x74, 0x35, 0xfa,
-	0x08, 0x1f, 0xf6, 0xef, 0x2c, 0xce, 0x72, 0xc1, 0x99, 0x34, 0x67, 0xda, 0x50, 0xc3, 0x82, 0x6d,
-	0x6b, 0x3b, 0xfe, 0xcf, 0xf6, 0xb5, 0x6c, 0x93, 0xe8, 0xe6, 0x2a, 0xdc, 0x6f, 0x69, 0x25, 0x8e,
-	0xa6, 0xb7, 0x0e, 0x4f, 0xd3, 0x07, 0x03, 0x7e, 0x6c, 0xe1, 0xd3, 0x0e, 0x3d, 0xf2, 0x7f, 0x7d,
-	0x0f, 0xc1, 0xf4, 0x1b, 0x80, 0x8f, 0x4e, 0xd5, 0xdc, 0x7c, 0xa1, 0x0d, 0x7b, 0xe7, 0x58, 0x27,
-	0x8d, 0xaa, 0x95, 0xa6, 0x02, 0x8d, 0xe1, 0xb6, 0xe1, 0x46, 0x0c, 0x41, 0xb8, 0x06, 0x45, 0x70,
-	0x54, 0x30, 0x9d, 0x37, 0xbc, 0x36, 0x5c, 0x49, 0x1b, 0xc8, 0x6e, 0xfa, 0x37, 0x84, 0x5e, 0x42,
-	0xbf, 0x16, 0x54, 0xda, 0xf7, 0x8e, 0x0e, 0xf7, 0xc9, 0xed, 0x3f, 0x48, 0xba, 0xac, 0x13, 0xbf,
-	0x4b, 0x2b, 0xb5, 0xfc, 0xfe, 0x46, 0x1f, 0xe0, 0x93, 0x63, 0x2a, 0x73, 0x26, 0xee, 0xf8, 0x5a,
-	0x4e, 0x3e, 0x79, 0xb3, 0xba, 0xc6, 0xde, 0xe5, 0x35, 0xf6, 0x56, 0x6b, 0x0c, 0x2e, 0xd6, 0x18,
-	0xfc, 0x5c, 0x63, 0x70, 0xbe, 0xc1, 0xde, 0xc5, 0x06, 0x7b, 0x97, 0x1b, 0xec, 0xbd, 0x7f, 0x56,
-	0x72, 0xb3, 0x58, 0x66, 0x24, 0x57, 0x55, 0xdc, 0xaf, 0xa8, 0x3b, 0x9e, 0xeb, 0xe2, 0x53, 0xfc,
-	0xf5, 0xcf, 0xbe, 0x9a, 0xb6, 0x66, 0x3a, 0xdb, 0xb1, 0x7f, 0xf1, 0xe2, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x9e, 0x7a, 0x5d, 0xce, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x51, 0xb7, 0xb4, 0x17, 0xb1, 0x1c, 0xa1, 0x98, 0xa8, 0xd8, 0x56, 0xc4, 0x90, 0x01,
+	0x6c, 0xb5, 0x48, 0x08, 0x65, 0x23, 0x1d, 0x98, 0x40, 0x95, 0x0b, 0x0c, 0x48, 0xa8, 0xba, 0xd8,
+	0x17, 0xe7, 0xe0, 0x7c, 0xcf, 0xf2, 0x5d, 0x0a, 0xf9, 0x15, 0x74, 0x64, 0xec, 0xcf, 0xc9, 0xd8,
+	0xb1, 0x53, 0xa1, 0xc9, 0xc2, 0xdc, 0x5f, 0x80, 0x7c, 0x67, 0x23, 0x04, 0x19, 0x99, 0xfc, 0xde,
+	0xe7, 0xef, 0x7d, 0xdf, 0xdd, 0xf7, 0x0e, 0x3f, 0x4a, 0x41, 0x15, 0xa0, 0xe2, 0x59, 0x99, 0x57,
+	0x34, 0x63, 0xf1, 0xe9, 0xfe, 0x98, 0x69, 0xba, 0xdf, 0xf6, 0x51, 0x59, 0x81, 0x06, 0xb2, 0x6b,
+	0x59, 0x51, 0x8b, 0x36, 0xac, 0xde, 0x83, 0x1c, 0x20, 0x17, 0x2c, 0x36, 0xac, 0xf1, 0x6c, 0x12,
+	0x53, 0x39, 0xb7, 0x23, 0xbd, 0x6e, 0x0e, 0x39, 0x98, 0x32, 0xae, 0xab, 0x06, 0x0d, 0xfe, 0x1e,
+	0xd0, 0xbc, 0x60, 0x4a, 0xd3, 0xa2, 0xb4, 0x84, 0xfe, 0x0d, 0xc2, 0xee, 0x91, 0xa0, 0x92, 0x10,
+	0xec, 0x4a, 0x5a, 0x30, 0x0f, 0x85, 0x68, 0xb0, 0x93, 0x98, 0x9a, 0x3c, 0xc7, 0x6e, 0xcd, 0xf7,
+	0x6e, 0x85, 0x68, 0xd0, 0x39, 0xe8, 0x45, 0x56, 0x2c, 0x6a, 0xc5, 0xa2, 0x37, 0xad, 0xd8, 0x68,
+	0x7b, 0x71, 0x15, 0x38, 0x67, 0xdf, 0x03, 0x94, 0x98, 0x09, 0xb2, 0x8b, 0xb7, 0xa6, 0x8c, 0xe7,
+	0x53, 0xed, 0x6d, 0x84, 0x68, 0xb0, 0x91, 0x34, 0x5d, 0xed, 0xc2, 0xe5, 0x04, 0x3c, 0xd7, 0xba,
+	0xd4, 0x35, 0xf9, 0x88, 0xef, 0x35, 0xf7, 0xcc, 0x4e, 0x52, 0xc1, 0x99, 0xd4, 0x27, 0x4a, 0x53,
+	0xcd, 0xbc, 0x4d, 0x63, 0xdb, 0xfd, 0xc7, 0xf6, 0x85, 0x9c, 0x8f, 0xc2, 0x9b, 0xab, 0x60, 0x6f,
+	0x4e, 0x0b, 0x31, 0xec, 0xaf, 0x1d, 0xee, 0x27, 0x77, 0x5b, 0xfc, 0xd0, 0xc0, 0xc7, 0x35, 0x3a,
+	0x74, 0x7f, 0x9e, 0x07, 0xa8, 0xff, 0x15, 0xe1, 0xfb, 0xc7, 0x30, 0xd1, 0x9f, 0x69, 0xc5, 0xde,
+	0x5a, 0xd6, 0x51, 0x05, 0x25, 0x28, 0x2a, 0x48, 0x17, 0x6f, 0x6a, 0xae, 0x45, 0x1b, 0x84, 0x6d,
+	0x48, 0x88, 0x3b, 0x19, 0x53, 0x69, 0xc5, 0x4b, 0xcd, 0x41, 0x9a, 0x40, 0x76, 0x92, 0x3f, 0x21,
+	0xf2, 0x0c, 0xbb, 0xa5, 0xa0, 0xd2, 0xdc, 0xb7, 0x73, 0xb0, 0x17, 0xad, 0xdf, 0x60, 0x54, 0x67,
+	0x3d, 0x72, 0xeb, 0xb4, 0x12, 0xc3, 0x6f, 0x4e, 0xf4, 0x01, 0x3f, 0x3c, 0xa4, 0x32, 0x65, 0xe2,
+	0x3f, 0x1f, 0xab, 0x91, 0x7f, 0x89, 0xef, 0xbc, 0x82, 0x6c, 0x26, 0xd8, 0x3b, 0x56, 0x29, 0x0e,
+	0xeb, 0xb7, 0xed, 0xe1, 0xdb, 0xa7, 0xf6, 0xb7, 0x11, 0x72, 0x93, 0xb6, 0x1d, 0x6e, 0x7f, 0x3b,
+	0x0f, 0x50, 0x2d, 0x34, 0x7a, 0xbd, 0xb8, 0xf6, 0x9d, 0xcb, 0x6b, 0xdf, 0x59, 0x2c, 0x7d, 0x74,
+	0xb1, 0xf4, 0xd1, 0x8f, 0xa5, 0x8f, 0xce, 0x56, 0xbe, 0x73, 0xb1, 0xf2, 0x9d, 0xcb, 0x95, 0xef,
+	0xbc, 0x7f, 0x9c, 0x73, 0x3d, 0x9d, 0x8d, 0xa3, 0x14, 0x8a, 0xb8, 0x79, 0xeb, 0xf6, 0xf3, 0x44,
+	0x65, 0x9f, 0xe2, 0x2f, 0xbf, 0x1f, 0xbe, 0x9e, 0x97, 0x4c, 0x8d, 0xb7, 0xcc, 0x52, 0x9f, 0xfe,
+	0x1a, 0x00, 0xb9, 0x91, 0x61, 0x12, 0x17, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -300,6 +344,33 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ModuleVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleVersion)
+	if !ok {
+		that2, ok := that.(ModuleVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -446,6 +517,41 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -519,6 +625,22 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUpgrade(uint64(m.Version))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -997,6 +1119,110 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0